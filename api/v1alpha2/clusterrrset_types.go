@@ -26,6 +26,8 @@ import (
 // +kubebuilder:printcolumn:name="TTL",type="integer",JSONPath=".spec.ttl"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.syncStatus"
 // +kubebuilder:printcolumn:name="Records",type="string",JSONPath=".spec.records"
+// +kubebuilder:printcolumn:name="Last Sync",type="date",JSONPath=".status.lastSyncTime"
+// +kubebuilder:printcolumn:name="First Record",type="string",JSONPath=".spec.records[0]",priority=1
 // ClusterRRset is the Schema for the clusterrrsets API
 type ClusterRRset struct {
 	metav1.TypeMeta `json:",inline"`
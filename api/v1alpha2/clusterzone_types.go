@@ -22,6 +22,7 @@ import (
 
 // +kubebuilder:printcolumn:name="Serial",type="integer",JSONPath=".status.serial"
 // +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id"
+// +kubebuilder:printcolumn:name="RRsets",type="integer",JSONPath=".status.managedRecordsCount"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.syncStatus"
 // ClusterZone is the Schema for the clusterzones API
 type ClusterZone struct {
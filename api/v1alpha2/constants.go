@@ -1,11 +1,28 @@
 package v1alpha2
 
+import "time"
+
 const (
 	FAILED_STATUS    = "Failed"
 	PENDING_STATUS   = "Pending"
 	SUCCEEDED_STATUS = "Succeeded"
 )
 
+const (
+	TTL_HARMONIZATION_DISABLED = "Disabled"
+	TTL_HARMONIZATION_REPORT   = "Report"
+)
+
+const (
+	SERIAL_STRATEGY_DEFAULT    = "Default"
+	SERIAL_STRATEGY_INCREMENT  = "Increment"
+	SERIAL_STRATEGY_DATE_BASED = "DateBased"
+)
+
+const (
+	SOA_EDIT_API_DEFAULT = "DEFAULT"
+)
+
 const (
 	MISSING_ZONE_REASON            = "ZoneMissing"
 	MISSING_ZONE_MESSAGE           = "Missing Zone:"
@@ -15,7 +32,115 @@ const (
 	RRSET_DUPLICATED_MESSAGE       = "At least another ClusterRRset/RRset exists with the same name"
 	SYNCHRONIZATION_FAILED_REASON  = "SynchronizationFailed"
 	SYNCHRONIZATION_FAILED_MESSAGE = "Synchronization failed:"
+	PDNS_NOT_FOUND_REASON          = "PDNSNotFound"
+	PDNS_CONFLICT_REASON           = "PDNSConflict"
+	PDNS_SERVER_ERROR_REASON       = "PDNSServerError"
+	PDNS_RATE_LIMITED_REASON       = "PDNSRateLimited"
 	SUCCEEDED_REASON               = "Succeeded"
 	SUCCEEDED_MESSAGE              = "Succeeded"
 	ZONE_DUPLICATED_MESSAGE        = "At least another ClusterZone/Zone exists with the same name"
+	CNAME_CONFLICT_REASON          = "CnameConflict"
+	CNAME_CONFLICT_MESSAGE         = "A CNAME cannot coexist with other records at the same name, nor at the zone apex"
+	RRSET_OUT_OF_ZONE_REASON       = "RrsetOutOfZone"
+	RRSET_OUT_OF_ZONE_MESSAGE      = "The RRset name is not within the referenced zone"
+	LUA_NOT_ALLOWED_REASON         = "LuaRecordsNotAllowed"
+	LUA_NOT_ALLOWED_MESSAGE        = "LUA records are not allowed, the operator must be started with --allow-lua-records"
+	ZONE_NOT_ALLOWED_REASON        = "ZoneNotAllowed"
+	ZONE_NOT_ALLOWED_MESSAGE       = "The zone is not allowed on the PowerDNS API, allowed suffixes:"
+	DRY_RUN_REASON                 = "DryRun"
+	DRY_RUN_MESSAGE                = "Pending change:"
+)
+
+const (
+	DEFAULT_TSIGKEY_SECRET_KEY = "secret"
+)
+
+const (
+	// ALLOW_AXFR_FROM_AUTO_NS allows the nameservers of a zone to transfer it
+	ALLOW_AXFR_FROM_AUTO_NS = "AUTO-NS"
+)
+
+const (
+	AXFR_RETRIEVED_CONDITION     = "AxfrRetrieved"
+	AXFR_RETRIEVE_FAILED_REASON  = "RetrieveFailed"
+	AXFR_RETRIEVE_FAILED_MESSAGE = "AXFR retrieve failed:"
+)
+
+const (
+	RECTIFIED_CONDITION    = "Rectified"
+	RECTIFY_FAILED_REASON  = "RectifyFailed"
+	RECTIFY_FAILED_MESSAGE = "Zone rectify failed:"
+)
+
+const (
+	CATALOG_MEMBER_CONDITION         = "CatalogMember"
+	CATALOG_NOT_AVAILABLE_REASON     = "CatalogNotAvailable"
+	CATALOG_NOT_AVAILABLE_MESSAGE    = "Catalog zone not available:"
+	CATALOG_MEMBER_SUCCEEDED_MESSAGE = "Member of catalog:"
+)
+
+const (
+	ADOPTED_CONDITION = "Adopted"
+	ADOPTED_REASON    = "Adopted"
+	ADOPTED_MESSAGE   = "Zone already existed in PowerDNS, adopted by:"
+)
+
+const (
+	NOT_AUTHORITATIVE_CONDITION = "NotAuthoritative"
+	NOT_AUTHORITATIVE_REASON    = "UnsupportedDaemonType"
+	NOT_AUTHORITATIVE_MESSAGE   = "The PowerDNS server is not authoritative, PowerDNS is not modified, daemon type:"
+)
+
+const (
+	CLEANUP_ABANDONED_CONDITION = "CleanupAbandoned"
+	FINALIZER_TIMEOUT_REASON    = "FinalizerTimeout"
+	FINALIZER_TIMEOUT_MESSAGE   = "External resources could not be deleted from PowerDNS before the finalizer timeout, finalizer removed:"
+)
+
+const (
+	PAUSED_CONDITION = "Paused"
+	PAUSED_REASON    = "Paused"
+	PAUSED_MESSAGE   = "Reconciliation is paused by the paused annotation, PowerDNS is not modified"
+	RESUMED_REASON   = "Resumed"
+	RESUMED_MESSAGE  = "Reconciliation is resumed"
+)
+
+const (
+	CREATION_CONFLICT_CONDITION = "CreationConflict"
+	CREATION_CONFLICT_MESSAGE   = "Zone already existed in PowerDNS on creation, reconciled as an existing zone"
+)
+
+const (
+	OVERRIDDEN_REASON   = "Overridden"
+	OVERRIDDEN_MESSAGE  = "Overridden by RRset:"
+	OVERRIDES_CONDITION = "OverridesClusterRRset"
+	OVERRIDES_MESSAGE   = "Overrides ClusterRRset:"
+)
+
+const (
+	LUA_RECORD_CONDITION = "LuaRecord"
+	LUA_RECORD_MESSAGE   = "Dynamic LUA record of type:"
+)
+
+const (
+	REVERSE_MANAGED_CONDITION    = "ReverseManaged"
+	REVERSE_ZONE_MISSING_REASON  = "ReverseZoneMissing"
+	REVERSE_ZONE_MISSING_MESSAGE = "No reverse zone found for:"
+)
+
+const (
+	// DEFAULT_PREPUBLISH_DURATION is the default time between the publication of new ZSKs and their activation
+	DEFAULT_PREPUBLISH_DURATION = time.Hour
+
+	MAINTENANCE_PENDING_PHASE      = "Pending"
+	MAINTENANCE_PREPUBLISHED_PHASE = "Prepublished"
+	MAINTENANCE_SUCCEEDED_PHASE    = "Succeeded"
+	MAINTENANCE_SKIPPED_PHASE      = "Skipped"
+	MAINTENANCE_FAILED_PHASE       = "Failed"
+
+	MAINTENANCE_COMPLETED_CONDITION = "Completed"
+	MAINTENANCE_IN_PROGRESS_REASON  = "InProgress"
+	MAINTENANCE_IN_PROGRESS_MESSAGE = "Zones done:"
+	MAINTENANCE_FAILED_REASON       = "ZonesFailed"
+	MAINTENANCE_FAILED_MESSAGE      = "Zones failed:"
 )
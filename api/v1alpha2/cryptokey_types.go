@@ -0,0 +1,177 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package v1alpha2
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joeig/go-powerdns/v3"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// CryptokeySpec defines the desired state of Cryptokey
+type CryptokeySpec struct {
+	// ZoneRef reference the zone the Cryptokey is used to sign.
+	ZoneRef ZoneRef `json:"zoneRef"`
+	// Type of the key, one of "ksk", "zsk", "csk".
+	// +kubebuilder:validation:Enum:=ksk;zsk;csk
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	KeyType string `json:"keyType"`
+	// Algorithm of the key (e.g. "ECDSAP256SHA256", "ED25519"), defaults to the PowerDNS default algorithm.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +optional
+	Algorithm *string `json:"algorithm,omitempty"`
+	// Size of the key in bits, only relevant for RSA algorithms.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +optional
+	Bits *uint64 `json:"bits,omitempty"`
+	// Whether the key is used to sign the zone, defaults to true.
+	// +kubebuilder:default:=true
+	// +optional
+	Active *bool `json:"active,omitempty"`
+}
+
+// CryptokeyStatus defines the observed state of Cryptokey.
+type CryptokeyStatus struct {
+	// ID of the key in PowerDNS.
+	// +optional
+	ID *uint64 `json:"id,omitempty"`
+	// Key tag of the key.
+	// +optional
+	KeyTag *uint32 `json:"keyTag,omitempty"`
+	// Algorithm of the key.
+	// +optional
+	Algorithm *string `json:"algorithm,omitempty"`
+	// Whether the key is used to sign the zone.
+	// +optional
+	Active *bool `json:"active,omitempty"`
+	// DNSKEY record of the key.
+	// +optional
+	DNSKey *string `json:"dnskey,omitempty"`
+	// DS records of the key, to publish in the parent zone.
+	// +optional
+	DS         []string `json:"ds,omitempty"`
+	SyncStatus *string  `json:"syncStatus,omitempty"`
+	// conditions represent the current state of the Cryptokey resource.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration *int64             `json:"observedGeneration,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Namespaced
+
+// +kubebuilder:printcolumn:name="Zone",type="string",JSONPath=".spec.zoneRef.name"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.keyType"
+// +kubebuilder:printcolumn:name="KeyTag",type="integer",JSONPath=".status.keyTag"
+// +kubebuilder:printcolumn:name="Active",type="boolean",JSONPath=".status.active"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.syncStatus"
+// Cryptokey is the Schema for the cryptokeys API
+type Cryptokey struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of Cryptokey
+	// +required
+	Spec CryptokeySpec `json:"spec"`
+
+	// status defines the observed state of Cryptokey
+	// +optional
+	Status CryptokeyStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// CryptokeyList contains a list of Cryptokey
+type CryptokeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []Cryptokey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Cryptokey{}, &CryptokeyList{})
+}
+
+// IsInExpectedStatus returns true if Status.SyncStatus and Status.ObservedGeneration are, at least, at expected value
+func (c *Cryptokey) IsInExpectedStatus(
+	expectedMinimumObservedGeneration int64,
+	expectedSyncStatus string,
+	expectedConditionStatus metav1.ConditionStatus,
+) bool {
+	currentAvailableCondition := meta.FindStatusCondition(c.Status.Conditions, "Available")
+	return c.Status.ObservedGeneration != nil &&
+		*c.Status.ObservedGeneration >= expectedMinimumObservedGeneration &&
+		c.Status.SyncStatus != nil &&
+		*c.Status.SyncStatus == expectedSyncStatus &&
+		currentAvailableCondition != nil &&
+		currentAvailableCondition.Status == expectedConditionStatus
+}
+
+func (c *Cryptokey) SetMissingZone(err error) {
+	c.Status.SyncStatus = ptr.To(PENDING_STATUS)
+	c.Status.ObservedGeneration = &c.Generation
+	c.setAvailableCondition(metav1.ConditionFalse, MISSING_ZONE_REASON, MISSING_ZONE_MESSAGE+err.Error())
+}
+
+func (c *Cryptokey) SetZoneNotAvailable(zoneName string) {
+	c.Status.SyncStatus = ptr.To(FAILED_STATUS)
+	c.Status.ObservedGeneration = &c.Generation
+	c.setAvailableCondition(metav1.ConditionFalse, ZONE_NOT_AVAILABLE_REASON, ZONE_NOT_AVAILABLE_MESSAGE+zoneName)
+}
+
+func (c *Cryptokey) SetSynchronizationFailed(err error) {
+	c.Status.SyncStatus = ptr.To(FAILED_STATUS)
+	c.Status.ObservedGeneration = &c.Generation
+	c.setAvailableCondition(metav1.ConditionFalse, SynchronizationFailedReason(err), SYNCHRONIZATION_FAILED_MESSAGE+err.Error())
+}
+
+func (c *Cryptokey) SetAvailable(cryptokeyRes *powerdns.Cryptokey) {
+	c.Status.SyncStatus = ptr.To(SUCCEEDED_STATUS)
+	c.Status.ObservedGeneration = &c.Generation
+	c.Status.ID = cryptokeyRes.ID
+	c.Status.Algorithm = cryptokeyRes.Algorithm
+	c.Status.Active = cryptokeyRes.Active
+	c.Status.DNSKey = cryptokeyRes.DNSkey
+	c.Status.DS = cryptokeyRes.DS
+	c.Status.KeyTag = nil
+	// DS records are formatted as "<key tag> <algorithm> <digest type> <digest>"
+	if len(cryptokeyRes.DS) > 0 {
+		if fields := strings.Fields(cryptokeyRes.DS[0]); len(fields) > 0 {
+			if keyTag, err := strconv.ParseUint(fields[0], 10, 16); err == nil {
+				c.Status.KeyTag = ptr.To(uint32(keyTag))
+			}
+		}
+	}
+	c.setAvailableCondition(metav1.ConditionTrue, SUCCEEDED_REASON, SUCCEEDED_MESSAGE)
+}
+
+func (c *Cryptokey) setAvailableCondition(status metav1.ConditionStatus, reason, message string) {
+	condition := metav1.Condition{
+		Type:               "Available",
+		Status:             status,
+		LastTransitionTime: metav1.NewTime(time.Now().UTC()),
+		Reason:             reason,
+		Message:            message,
+	}
+	meta.SetStatusCondition(&c.Status.Conditions, condition)
+}
@@ -0,0 +1,215 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+package v1alpha2
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// DNSSECMaintenanceSpec defines the DNSSEC operations applied to the signed zones managed by the operator
+// +kubebuilder:validation:XValidation:rule="(has(self.rectify) && self.rectify) || (has(self.zskRollover) && self.zskRollover)",message="at least one of rectify or zskRollover is required"
+type DNSSECMaintenanceSpec struct {
+	// Whether or not the zones are rectified.
+	// +optional
+	Rectify *bool `json:"rectify,omitempty"`
+	// Whether or not the active ZSKs of the zones are rolled over, by pre-publication: a new inactive ZSK is published,
+	// then activated after prepublishDuration while the previous ones are deactivated (they are kept published, to be deleted afterwards).
+	// Zones whose keys are managed through Cryptokey resources are skipped.
+	// +optional
+	ZSKRollover *bool `json:"zskRollover,omitempty"`
+	// Names of the zones the maintenance is restricted to (e.g. "example.org"), all the DNSSEC signed zones when omitted.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+	// Time between the publication of the new ZSKs and their activation, so that resolvers have the new DNSKEY RRset
+	// in cache (at least the TTL of the DNSKEY records), defaults to 1h.
+	// +optional
+	PrepublishDuration *metav1.Duration `json:"prepublishDuration,omitempty"`
+}
+
+// DNSSECMaintenanceZoneStatus is the outcome of the maintenance of a zone
+type DNSSECMaintenanceZoneStatus struct {
+	// Name of the zone.
+	Name string `json:"name"`
+	// Phase of the maintenance of the zone, one of "Pending", "Prepublished", "Succeeded", "Skipped", "Failed".
+	Phase string `json:"phase"`
+	// Result of the rectify of the zone.
+	// +optional
+	Rectify *string `json:"rectify,omitempty"`
+	// ID of the ZSK published by the rollover.
+	// +optional
+	NewKeyID *uint64 `json:"newKeyID,omitempty"`
+	// Time the new ZSK has been published.
+	// +optional
+	PrepublishTime *metav1.Time `json:"prepublishTime,omitempty"`
+	// Details about the phase (e.g. the error of a failed zone).
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// DNSSECMaintenanceStatus defines the observed state of DNSSECMaintenance.
+type DNSSECMaintenanceStatus struct {
+	// Outcome of the maintenance of each zone.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Zones []DNSSECMaintenanceZoneStatus `json:"zones,omitempty"`
+	// Progress of the maintenance, as the number of zones done out of the total (e.g. "3/5").
+	// +optional
+	Progress *string `json:"progress,omitempty"`
+	// Time the maintenance has completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	SyncStatus     *string      `json:"syncStatus,omitempty"`
+	// conditions represent the current state of the DNSSECMaintenance resource.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration *int64             `json:"observedGeneration,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+
+// +kubebuilder:printcolumn:name="Rectify",type="boolean",JSONPath=".spec.rectify"
+// +kubebuilder:printcolumn:name="ZSKRollover",type="boolean",JSONPath=".spec.zskRollover"
+// +kubebuilder:printcolumn:name="Progress",type="string",JSONPath=".status.progress"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.syncStatus"
+// DNSSECMaintenance is the Schema for the dnssecmaintenances API
+type DNSSECMaintenance struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the DNSSEC operations to apply
+	// +required
+	Spec DNSSECMaintenanceSpec `json:"spec"`
+
+	// status defines the observed state of DNSSECMaintenance
+	// +optional
+	Status DNSSECMaintenanceStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// DNSSECMaintenanceList contains a list of DNSSECMaintenance
+type DNSSECMaintenanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []DNSSECMaintenance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DNSSECMaintenance{}, &DNSSECMaintenanceList{})
+}
+
+// GetPrepublishDuration returns the time between the publication of the new ZSKs and their activation
+func (d *DNSSECMaintenance) GetPrepublishDuration() time.Duration {
+	if d.Spec.PrepublishDuration != nil {
+		return d.Spec.PrepublishDuration.Duration
+	}
+	return DEFAULT_PREPUBLISH_DURATION
+}
+
+// GetZoneStatus returns the status of the maintenance of a zone, nil when the zone has not been processed yet
+func (d *DNSSECMaintenance) GetZoneStatus(name string) *DNSSECMaintenanceZoneStatus {
+	for i := range d.Status.Zones {
+		if d.Status.Zones[i].Name == name {
+			return &d.Status.Zones[i]
+		}
+	}
+	return nil
+}
+
+// SetZoneStatus adds or replaces the status of the maintenance of a zone
+func (d *DNSSECMaintenance) SetZoneStatus(zoneStatus DNSSECMaintenanceZoneStatus) {
+	if current := d.GetZoneStatus(zoneStatus.Name); current != nil {
+		*current = zoneStatus
+		return
+	}
+	d.Status.Zones = append(d.Status.Zones, zoneStatus)
+}
+
+// IsZoneDone returns true if the maintenance of the zone is over, successfully or not
+func (s DNSSECMaintenanceZoneStatus) IsZoneDone() bool {
+	return s.Phase == MAINTENANCE_SUCCEEDED_PHASE || s.Phase == MAINTENANCE_SKIPPED_PHASE || s.Phase == MAINTENANCE_FAILED_PHASE
+}
+
+// IsInExpectedStatus returns true if Status.SyncStatus and Status.ObservedGeneration are, at least, at expected value
+func (d *DNSSECMaintenance) IsInExpectedStatus(
+	expectedMinimumObservedGeneration int64,
+	expectedSyncStatus string,
+	expectedConditionStatus metav1.ConditionStatus,
+) bool {
+	currentCompletedCondition := meta.FindStatusCondition(d.Status.Conditions, MAINTENANCE_COMPLETED_CONDITION)
+	return d.Status.ObservedGeneration != nil &&
+		*d.Status.ObservedGeneration >= expectedMinimumObservedGeneration &&
+		d.Status.SyncStatus != nil &&
+		*d.Status.SyncStatus == expectedSyncStatus &&
+		currentCompletedCondition != nil &&
+		currentCompletedCondition.Status == expectedConditionStatus
+}
+
+// SetProgress reports the number of zones done out of total, the maintenance is completed when all of them are done
+func (d *DNSSECMaintenance) SetProgress(total int) {
+	done, failed := 0, 0
+	for _, z := range d.Status.Zones {
+		if z.IsZoneDone() {
+			done++
+		}
+		if z.Phase == MAINTENANCE_FAILED_PHASE {
+			failed++
+		}
+	}
+	d.Status.ObservedGeneration = &d.Generation
+	d.Status.Progress = ptr.To(fmt.Sprintf("%d/%d", done, total))
+	if done < total {
+		d.Status.SyncStatus = ptr.To(PENDING_STATUS)
+		d.Status.CompletionTime = nil
+		d.setCompletedCondition(metav1.ConditionFalse, MAINTENANCE_IN_PROGRESS_REASON, fmt.Sprintf("%s %d/%d", MAINTENANCE_IN_PROGRESS_MESSAGE, done, total))
+		return
+	}
+	if d.Status.CompletionTime == nil {
+		d.Status.CompletionTime = &metav1.Time{Time: time.Now().UTC()}
+	}
+	if failed > 0 {
+		d.Status.SyncStatus = ptr.To(FAILED_STATUS)
+		d.setCompletedCondition(metav1.ConditionTrue, MAINTENANCE_FAILED_REASON, fmt.Sprintf("%s %d/%d", MAINTENANCE_FAILED_MESSAGE, failed, total))
+		return
+	}
+	d.Status.SyncStatus = ptr.To(SUCCEEDED_STATUS)
+	d.setCompletedCondition(metav1.ConditionTrue, SUCCEEDED_REASON, SUCCEEDED_MESSAGE)
+}
+
+func (d *DNSSECMaintenance) SetSynchronizationFailed(err error) {
+	d.Status.SyncStatus = ptr.To(FAILED_STATUS)
+	d.Status.ObservedGeneration = &d.Generation
+	d.setCompletedCondition(metav1.ConditionFalse, SynchronizationFailedReason(err), SYNCHRONIZATION_FAILED_MESSAGE+err.Error())
+}
+
+func (d *DNSSECMaintenance) setCompletedCondition(status metav1.ConditionStatus, reason, message string) {
+	condition := metav1.Condition{
+		Type:               MAINTENANCE_COMPLETED_CONDITION,
+		Status:             status,
+		LastTransitionTime: metav1.NewTime(time.Now().UTC()),
+		Reason:             reason,
+		Message:            message,
+	}
+	meta.SetStatusCondition(&d.Status.Conditions, condition)
+}
@@ -13,6 +13,7 @@
 package v1alpha2
 
 import (
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -40,11 +41,22 @@ type GenericRRset interface {
 	Copy() GenericRRset
 
 	// Set Status functions
-	SetDuplicated(lastUpdateTime *metav1.Time, name string)
+	SetDuplicated(lastUpdateTime *metav1.Time, name string, conflicts []string)
+	SetCnameConflict(lastUpdateTime *metav1.Time, name string)
+	SetOutOfZone(lastUpdateTime *metav1.Time, name string)
+	SetLuaNotAllowed(lastUpdateTime *metav1.Time, name string)
+	SetLuaRecord(embeddedTypes []string)
+	SetOverridden(lastUpdateTime *metav1.Time, name string, by string)
+	SetOverrides(clusterRRsets []string)
 	SetMissingZone(err error)
 	SetZoneNotAvailable(zoneName string)
 	SetSynchronizationFailed(lastUpdateTime *metav1.Time, err error)
 	SetAvailable(lastUpdateTime *metav1.Time, name string)
+	SetReverseManaged(reverseRecords []ReverseRecord, missing []string, err error)
+	SetDryRun(err error)
+	SetPaused(paused bool)
+	SetNotAuthoritative(daemonType string)
+	SetCleanupAbandoned(err error)
 }
 
 // +kubebuilder:object:root:false
@@ -82,8 +94,32 @@ func (c *RRset) SetZoneNotAvailable(zoneName string) {
 	setZoneNotAvailable(&c.Status, c.Generation, zoneName)
 }
 
-func (c *RRset) SetDuplicated(lastUpdateTime *metav1.Time, name string) {
-	setRRsetDuplicated(&c.Status, c.Generation, lastUpdateTime, name)
+func (c *RRset) SetDuplicated(lastUpdateTime *metav1.Time, name string, conflicts []string) {
+	setRRsetDuplicated(&c.Status, c.Generation, lastUpdateTime, name, conflicts)
+}
+
+func (c *RRset) SetCnameConflict(lastUpdateTime *metav1.Time, name string) {
+	setRRsetCnameConflict(&c.Status, c.Generation, lastUpdateTime, name)
+}
+
+func (c *RRset) SetOutOfZone(lastUpdateTime *metav1.Time, name string) {
+	setRRsetOutOfZone(&c.Status, c.Generation, lastUpdateTime, name)
+}
+
+func (c *RRset) SetLuaNotAllowed(lastUpdateTime *metav1.Time, name string) {
+	setRRsetLuaNotAllowed(&c.Status, c.Generation, lastUpdateTime, name)
+}
+
+func (c *RRset) SetLuaRecord(embeddedTypes []string) {
+	setRRsetLuaRecord(&c.Status, embeddedTypes)
+}
+
+func (c *RRset) SetOverridden(lastUpdateTime *metav1.Time, name string, by string) {
+	setRRsetOverridden(&c.Status, c.Generation, lastUpdateTime, name, by)
+}
+
+func (c *RRset) SetOverrides(clusterRRsets []string) {
+	setRRsetOverrides(&c.Status, clusterRRsets)
 }
 
 func (c *RRset) SetSynchronizationFailed(lastUpdateTime *metav1.Time, err error) {
@@ -94,6 +130,26 @@ func (c *RRset) SetAvailable(lastUpdateTime *metav1.Time, name string) {
 	setRRsetAvailable(&c.Status, c.Generation, lastUpdateTime, name)
 }
 
+func (c *RRset) SetDryRun(err error) {
+	setRRsetDryRun(&c.Status, c.Generation, err)
+}
+
+func (c *RRset) SetPaused(paused bool) {
+	setPaused(&c.Status.Conditions, paused)
+}
+
+func (c *RRset) SetNotAuthoritative(daemonType string) {
+	setNotAuthoritative(&c.Status.Conditions, daemonType)
+}
+
+func (c *RRset) SetCleanupAbandoned(err error) {
+	setCleanupAbandoned(&c.Status.Conditions, err)
+}
+
+func (c *RRset) SetReverseManaged(reverseRecords []ReverseRecord, missing []string, err error) {
+	setRRsetReverseManaged(&c.Status, reverseRecords, missing, err)
+}
+
 // +kubebuilder:object:root:false
 // +kubebuilder:object:generate:false
 var _ GenericRRset = &ClusterRRset{}
@@ -130,8 +186,32 @@ func (c *ClusterRRset) SetZoneNotAvailable(zoneName string) {
 	setZoneNotAvailable(&c.Status, c.Generation, zoneName)
 }
 
-func (c *ClusterRRset) SetDuplicated(lastUpdateTime *metav1.Time, name string) {
-	setRRsetDuplicated(&c.Status, c.Generation, lastUpdateTime, name)
+func (c *ClusterRRset) SetDuplicated(lastUpdateTime *metav1.Time, name string, conflicts []string) {
+	setRRsetDuplicated(&c.Status, c.Generation, lastUpdateTime, name, conflicts)
+}
+
+func (c *ClusterRRset) SetCnameConflict(lastUpdateTime *metav1.Time, name string) {
+	setRRsetCnameConflict(&c.Status, c.Generation, lastUpdateTime, name)
+}
+
+func (c *ClusterRRset) SetOutOfZone(lastUpdateTime *metav1.Time, name string) {
+	setRRsetOutOfZone(&c.Status, c.Generation, lastUpdateTime, name)
+}
+
+func (c *ClusterRRset) SetLuaNotAllowed(lastUpdateTime *metav1.Time, name string) {
+	setRRsetLuaNotAllowed(&c.Status, c.Generation, lastUpdateTime, name)
+}
+
+func (c *ClusterRRset) SetLuaRecord(embeddedTypes []string) {
+	setRRsetLuaRecord(&c.Status, embeddedTypes)
+}
+
+func (c *ClusterRRset) SetOverridden(lastUpdateTime *metav1.Time, name string, by string) {
+	setRRsetOverridden(&c.Status, c.Generation, lastUpdateTime, name, by)
+}
+
+func (c *ClusterRRset) SetOverrides(clusterRRsets []string) {
+	setRRsetOverrides(&c.Status, clusterRRsets)
 }
 
 func (c *ClusterRRset) SetSynchronizationFailed(lastUpdateTime *metav1.Time, err error) {
@@ -142,6 +222,26 @@ func (c *ClusterRRset) SetAvailable(lastUpdateTime *metav1.Time, name string) {
 	setRRsetAvailable(&c.Status, c.Generation, lastUpdateTime, name)
 }
 
+func (c *ClusterRRset) SetDryRun(err error) {
+	setRRsetDryRun(&c.Status, c.Generation, err)
+}
+
+func (c *ClusterRRset) SetPaused(paused bool) {
+	setPaused(&c.Status.Conditions, paused)
+}
+
+func (c *ClusterRRset) SetNotAuthoritative(daemonType string) {
+	setNotAuthoritative(&c.Status.Conditions, daemonType)
+}
+
+func (c *ClusterRRset) SetCleanupAbandoned(err error) {
+	setCleanupAbandoned(&c.Status.Conditions, err)
+}
+
+func (c *ClusterRRset) SetReverseManaged(reverseRecords []ReverseRecord, missing []string, err error) {
+	setRRsetReverseManaged(&c.Status, reverseRecords, missing, err)
+}
+
 func setMissingZone(status *RRsetStatus, generation int64, err error) {
 	status.SyncStatus = ptr.To(PENDING_STATUS)
 	status.ObservedGeneration = &generation
@@ -155,6 +255,19 @@ func setMissingZone(status *RRsetStatus, generation int64, err error) {
 	meta.SetStatusCondition(&status.Conditions, condition)
 }
 
+func setRRsetDryRun(status *RRsetStatus, generation int64, err error) {
+	status.SyncStatus = ptr.To(PENDING_STATUS)
+	status.ObservedGeneration = &generation
+	condition := metav1.Condition{
+		Type:               "Available",
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.NewTime(time.Now().UTC()),
+		Reason:             DRY_RUN_REASON,
+		Message:            DRY_RUN_MESSAGE + err.Error(),
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
 func setZoneNotAvailable(status *RRsetStatus, generation int64, zoneName string) {
 	status.SyncStatus = ptr.To(FAILED_STATUS)
 	status.ObservedGeneration = &generation
@@ -168,7 +281,8 @@ func setZoneNotAvailable(status *RRsetStatus, generation int64, zoneName string)
 	meta.SetStatusCondition(&status.Conditions, condition)
 }
 
-func setRRsetDuplicated(status *RRsetStatus, generation int64, lastUpdateTime *metav1.Time, name string) {
+// setRRsetDuplicated reports a RRset sharing its DNS name and type with other resources, listed in the message
+func setRRsetDuplicated(status *RRsetStatus, generation int64, lastUpdateTime *metav1.Time, name string, conflicts []string) {
 	status.SyncStatus = ptr.To(FAILED_STATUS)
 	status.ObservedGeneration = &generation
 	status.LastUpdateTime = lastUpdateTime
@@ -180,6 +294,102 @@ func setRRsetDuplicated(status *RRsetStatus, generation int64, lastUpdateTime *m
 		Reason:             DUPLICATED_REASON,
 		Message:            RRSET_DUPLICATED_MESSAGE,
 	}
+	if len(conflicts) > 0 {
+		condition.Message += ": " + strings.Join(conflicts, ", ")
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+func setRRsetCnameConflict(status *RRsetStatus, generation int64, lastUpdateTime *metav1.Time, name string) {
+	status.SyncStatus = ptr.To(FAILED_STATUS)
+	status.ObservedGeneration = &generation
+	status.LastUpdateTime = lastUpdateTime
+	status.DnsEntryName = &name
+	condition := metav1.Condition{
+		Type:               "Available",
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: *lastUpdateTime,
+		Reason:             CNAME_CONFLICT_REASON,
+		Message:            CNAME_CONFLICT_MESSAGE,
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+func setRRsetOutOfZone(status *RRsetStatus, generation int64, lastUpdateTime *metav1.Time, name string) {
+	status.SyncStatus = ptr.To(FAILED_STATUS)
+	status.ObservedGeneration = &generation
+	status.LastUpdateTime = lastUpdateTime
+	status.DnsEntryName = &name
+	condition := metav1.Condition{
+		Type:               "Available",
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: *lastUpdateTime,
+		Reason:             RRSET_OUT_OF_ZONE_REASON,
+		Message:            RRSET_OUT_OF_ZONE_MESSAGE,
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+func setRRsetLuaNotAllowed(status *RRsetStatus, generation int64, lastUpdateTime *metav1.Time, name string) {
+	status.SyncStatus = ptr.To(FAILED_STATUS)
+	status.ObservedGeneration = &generation
+	status.LastUpdateTime = lastUpdateTime
+	status.DnsEntryName = &name
+	condition := metav1.Condition{
+		Type:               "Available",
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: *lastUpdateTime,
+		Reason:             LUA_NOT_ALLOWED_REASON,
+		Message:            LUA_NOT_ALLOWED_MESSAGE,
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// setRRsetLuaRecord reports the types of the records dynamically generated by a LUA RRset, no type removes the condition
+func setRRsetLuaRecord(status *RRsetStatus, embeddedTypes []string) {
+	if len(embeddedTypes) == 0 {
+		meta.RemoveStatusCondition(&status.Conditions, LUA_RECORD_CONDITION)
+		return
+	}
+	condition := metav1.Condition{
+		Type:               LUA_RECORD_CONDITION,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(time.Now().UTC()),
+		Reason:             SUCCEEDED_REASON,
+		Message:            LUA_RECORD_MESSAGE + strings.Join(embeddedTypes, ", "),
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// setRRsetOverridden reports a ClusterRRset suppressed by a RRset with the same DNS name, its records are not managed
+func setRRsetOverridden(status *RRsetStatus, generation int64, lastUpdateTime *metav1.Time, name string, by string) {
+	status.SyncStatus = ptr.To(PENDING_STATUS)
+	status.ObservedGeneration = &generation
+	status.LastUpdateTime = lastUpdateTime
+	status.DnsEntryName = &name
+	condition := metav1.Condition{
+		Type:               "Available",
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: *lastUpdateTime,
+		Reason:             OVERRIDDEN_REASON,
+		Message:            OVERRIDDEN_MESSAGE + by,
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// setRRsetOverrides reports the ClusterRRsets overridden by a RRset, no ClusterRRset removes the condition
+func setRRsetOverrides(status *RRsetStatus, clusterRRsets []string) {
+	if len(clusterRRsets) == 0 {
+		meta.RemoveStatusCondition(&status.Conditions, OVERRIDES_CONDITION)
+		return
+	}
+	condition := metav1.Condition{
+		Type:               OVERRIDES_CONDITION,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(time.Now().UTC()),
+		Reason:             SUCCEEDED_REASON,
+		Message:            OVERRIDES_MESSAGE + strings.Join(clusterRRsets, ", "),
+	}
 	meta.SetStatusCondition(&status.Conditions, condition)
 }
 
@@ -187,11 +397,13 @@ func setRRsetSynchronizationFailed(status *RRsetStatus, generation int64, lastUp
 	status.SyncStatus = ptr.To(FAILED_STATUS)
 	status.ObservedGeneration = &generation
 	status.LastUpdateTime = lastUpdateTime
+	status.FailureCount = ptr.To(ptr.Deref(status.FailureCount, 0) + 1)
+	status.LastFailureTime = &metav1.Time{Time: time.Now().UTC()}
 	condition := metav1.Condition{
 		Type:               "Available",
 		Status:             metav1.ConditionFalse,
 		LastTransitionTime: *lastUpdateTime,
-		Reason:             SYNCHRONIZATION_FAILED_REASON,
+		Reason:             SynchronizationFailedReason(err),
 		Message:            SYNCHRONIZATION_FAILED_MESSAGE + err.Error(),
 	}
 	meta.SetStatusCondition(&status.Conditions, condition)
@@ -201,6 +413,8 @@ func setRRsetAvailable(status *RRsetStatus, generation int64, lastUpdateTime *me
 	status.SyncStatus = ptr.To(SUCCEEDED_STATUS)
 	status.ObservedGeneration = &generation
 	status.LastUpdateTime = lastUpdateTime
+	status.FailureCount = nil
+	status.LastFailureTime = nil
 	status.DnsEntryName = &name
 	condition := metav1.Condition{
 		Type:               "Available",
@@ -211,3 +425,31 @@ func setRRsetAvailable(status *RRsetStatus, generation int64, lastUpdateTime *me
 	}
 	meta.SetStatusCondition(&status.Conditions, condition)
 }
+
+func setRRsetReverseManaged(status *RRsetStatus, reverseRecords []ReverseRecord, missing []string, err error) {
+	status.ReverseRecords = reverseRecords
+	condition := metav1.Condition{
+		Type:               REVERSE_MANAGED_CONDITION,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(time.Now().UTC()),
+		Reason:             SUCCEEDED_REASON,
+		Message:            SUCCEEDED_MESSAGE,
+	}
+	switch {
+	case err != nil:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = SYNCHRONIZATION_FAILED_REASON
+		condition.Message = SYNCHRONIZATION_FAILED_MESSAGE + err.Error()
+	case len(missing) > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = REVERSE_ZONE_MISSING_REASON
+		condition.Message = REVERSE_ZONE_MISSING_MESSAGE + strings.Join(missing, ", ")
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// ZoneRefKey returns the key of the zone referenced by RRsets and ClusterRRsets in the "RRset.ZoneRef" and
+// "ClusterRRset.ZoneRef" field indexers, as "<kind>/<name>"
+func ZoneRefKey(zoneRef ZoneRef) string {
+	return zoneRef.Kind + "/" + zoneRef.Name
+}
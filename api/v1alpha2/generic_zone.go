@@ -13,6 +13,7 @@
 package v1alpha2
 
 import (
+	"strings"
 	"time"
 
 	"github.com/joeig/go-powerdns/v3"
@@ -43,8 +44,18 @@ type GenericZone interface {
 
 	// Set Status functions
 	SetDuplicated()
+	SetNotAllowed(allowedSuffixes []string)
 	SetSynchronizationFailed(err error)
 	SetAvailable(zoneRes *powerdns.Zone)
+	SetAxfrRetrieved(result string, err error)
+	SetRectified(result string, err error)
+	SetCatalogMember(catalog string, err error)
+	SetCreationConflict()
+	SetAdopted(owner string)
+	SetDryRun(err error)
+	SetPaused(paused bool)
+	SetNotAuthoritative(daemonType string)
+	SetCleanupAbandoned(err error)
 }
 
 // +kubebuilder:object:root:false
@@ -79,6 +90,10 @@ func (c *Zone) SetDuplicated() {
 	setZoneDuplicated(&c.Status, c.Generation)
 }
 
+func (c *Zone) SetNotAllowed(allowedSuffixes []string) {
+	setZoneNotAllowed(&c.Status, c.Generation, allowedSuffixes)
+}
+
 func (c *Zone) SetSynchronizationFailed(err error) {
 	setZoneSynchronizationFailed(&c.Status, c.Generation, err)
 }
@@ -87,6 +102,42 @@ func (c *Zone) SetAvailable(zoneRes *powerdns.Zone) {
 	setZoneAvailable(&c.Status, c.Generation, zoneRes)
 }
 
+func (c *Zone) SetAxfrRetrieved(result string, err error) {
+	setZoneAxfrRetrieved(&c.Status, result, err)
+}
+
+func (c *Zone) SetRectified(result string, err error) {
+	setZoneRectified(&c.Status, result, err)
+}
+
+func (c *Zone) SetCatalogMember(catalog string, err error) {
+	setZoneCatalogMember(&c.Status, catalog, err)
+}
+
+func (c *Zone) SetCreationConflict() {
+	setZoneCreationConflict(&c.Status)
+}
+
+func (c *Zone) SetAdopted(owner string) {
+	setZoneAdopted(&c.Status, owner)
+}
+
+func (c *Zone) SetDryRun(err error) {
+	setZoneDryRun(&c.Status, c.Generation, err)
+}
+
+func (c *Zone) SetPaused(paused bool) {
+	setPaused(&c.Status.Conditions, paused)
+}
+
+func (c *Zone) SetNotAuthoritative(daemonType string) {
+	setNotAuthoritative(&c.Status.Conditions, daemonType)
+}
+
+func (c *Zone) SetCleanupAbandoned(err error) {
+	setCleanupAbandoned(&c.Status.Conditions, err)
+}
+
 // +kubebuilder:object:root:false
 // +kubebuilder:object:generate:false
 var _ GenericZone = &ClusterZone{}
@@ -119,6 +170,10 @@ func (c *ClusterZone) SetDuplicated() {
 	setZoneDuplicated(&c.Status, c.Generation)
 }
 
+func (c *ClusterZone) SetNotAllowed(allowedSuffixes []string) {
+	setZoneNotAllowed(&c.Status, c.Generation, allowedSuffixes)
+}
+
 func (c *ClusterZone) SetSynchronizationFailed(err error) {
 	setZoneSynchronizationFailed(&c.Status, c.Generation, err)
 }
@@ -127,6 +182,42 @@ func (c *ClusterZone) SetAvailable(zoneRes *powerdns.Zone) {
 	setZoneAvailable(&c.Status, c.Generation, zoneRes)
 }
 
+func (c *ClusterZone) SetAxfrRetrieved(result string, err error) {
+	setZoneAxfrRetrieved(&c.Status, result, err)
+}
+
+func (c *ClusterZone) SetRectified(result string, err error) {
+	setZoneRectified(&c.Status, result, err)
+}
+
+func (c *ClusterZone) SetCatalogMember(catalog string, err error) {
+	setZoneCatalogMember(&c.Status, catalog, err)
+}
+
+func (c *ClusterZone) SetCreationConflict() {
+	setZoneCreationConflict(&c.Status)
+}
+
+func (c *ClusterZone) SetAdopted(owner string) {
+	setZoneAdopted(&c.Status, owner)
+}
+
+func (c *ClusterZone) SetDryRun(err error) {
+	setZoneDryRun(&c.Status, c.Generation, err)
+}
+
+func (c *ClusterZone) SetPaused(paused bool) {
+	setPaused(&c.Status.Conditions, paused)
+}
+
+func (c *ClusterZone) SetNotAuthoritative(daemonType string) {
+	setNotAuthoritative(&c.Status.Conditions, daemonType)
+}
+
+func (c *ClusterZone) SetCleanupAbandoned(err error) {
+	setCleanupAbandoned(&c.Status.Conditions, err)
+}
+
 func setZoneDuplicated(status *ZoneStatus, generation int64) {
 	status.SyncStatus = ptr.To(FAILED_STATUS)
 	status.ObservedGeneration = &generation
@@ -140,22 +231,53 @@ func setZoneDuplicated(status *ZoneStatus, generation int64) {
 	meta.SetStatusCondition(&status.Conditions, condition)
 }
 
+// setZoneNotAllowed reports a zone which cannot be created, its name is outside the suffixes allowed on the PowerDNS API
+func setZoneNotAllowed(status *ZoneStatus, generation int64, allowedSuffixes []string) {
+	status.SyncStatus = ptr.To(FAILED_STATUS)
+	status.ObservedGeneration = &generation
+	condition := metav1.Condition{
+		Type:               "Available",
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		Reason:             ZONE_NOT_ALLOWED_REASON,
+		Message:            ZONE_NOT_ALLOWED_MESSAGE + strings.Join(allowedSuffixes, ", "),
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
 func setZoneSynchronizationFailed(status *ZoneStatus, generation int64, err error) {
 	status.SyncStatus = ptr.To(FAILED_STATUS)
 	status.ObservedGeneration = &generation
+	status.FailureCount = ptr.To(ptr.Deref(status.FailureCount, 0) + 1)
+	status.LastFailureTime = &metav1.Time{Time: time.Now().UTC()}
 	condition := metav1.Condition{
 		Type:               "Available",
 		Status:             metav1.ConditionFalse,
 		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
-		Reason:             SYNCHRONIZATION_FAILED_REASON,
+		Reason:             SynchronizationFailedReason(err),
 		Message:            SYNCHRONIZATION_FAILED_MESSAGE + err.Error(),
 	}
 	meta.SetStatusCondition(&status.Conditions, condition)
 }
 
+func setZoneDryRun(status *ZoneStatus, generation int64, err error) {
+	status.SyncStatus = ptr.To(PENDING_STATUS)
+	status.ObservedGeneration = &generation
+	condition := metav1.Condition{
+		Type:               "Available",
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		Reason:             DRY_RUN_REASON,
+		Message:            DRY_RUN_MESSAGE + err.Error(),
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
 func setZoneAvailable(status *ZoneStatus, generation int64, zoneRes *powerdns.Zone) {
 	status.SyncStatus = ptr.To(SUCCEEDED_STATUS)
 	status.ObservedGeneration = &generation
+	status.FailureCount = nil
+	status.LastFailureTime = nil
 	status.ID = zoneRes.ID
 	status.Name = zoneRes.Name
 	status.Kind = ptr.To(string(ptr.Deref(zoneRes.Kind, "")))
@@ -164,7 +286,9 @@ func setZoneAvailable(status *ZoneStatus, generation int64, zoneRes *powerdns.Zo
 	status.EditedSerial = zoneRes.EditedSerial
 	status.Masters = zoneRes.Masters
 	status.DNSsec = zoneRes.DNSsec
+	status.Presigned = zoneRes.Presigned
 	status.Catalog = zoneRes.Catalog
+	status.Account = zoneRes.Account
 	condition := metav1.Condition{
 		Type:               "Available",
 		Status:             metav1.ConditionTrue,
@@ -174,3 +298,130 @@ func setZoneAvailable(status *ZoneStatus, generation int64, zoneRes *powerdns.Zo
 	}
 	meta.SetStatusCondition(&status.Conditions, condition)
 }
+
+// setZoneAxfrRetrieved records the outcome of the last AXFR retrieve, its time is the LastTransitionTime of the condition
+func setZoneAxfrRetrieved(status *ZoneStatus, result string, err error) {
+	condition := metav1.Condition{
+		Type:               AXFR_RETRIEVED_CONDITION,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		Reason:             SUCCEEDED_REASON,
+		Message:            result,
+	}
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = AXFR_RETRIEVE_FAILED_REASON
+		condition.Message = AXFR_RETRIEVE_FAILED_MESSAGE + err.Error()
+	}
+	// Force a new LastTransitionTime on each retrieve
+	meta.RemoveStatusCondition(&status.Conditions, AXFR_RETRIEVED_CONDITION)
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// setZoneRectified records the outcome of the last rectify, its time is the LastTransitionTime of the condition
+func setZoneRectified(status *ZoneStatus, result string, err error) {
+	condition := metav1.Condition{
+		Type:               RECTIFIED_CONDITION,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		Reason:             SUCCEEDED_REASON,
+		Message:            result,
+	}
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = RECTIFY_FAILED_REASON
+		condition.Message = RECTIFY_FAILED_MESSAGE + err.Error()
+	}
+	// Force a new LastTransitionTime on each rectify
+	meta.RemoveStatusCondition(&status.Conditions, RECTIFIED_CONDITION)
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// setZoneCatalogMember records the membership of the zone in its catalog, an empty catalog removes the condition
+func setZoneCatalogMember(status *ZoneStatus, catalog string, err error) {
+	if catalog == "" {
+		meta.RemoveStatusCondition(&status.Conditions, CATALOG_MEMBER_CONDITION)
+		return
+	}
+	condition := metav1.Condition{
+		Type:               CATALOG_MEMBER_CONDITION,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		Reason:             SUCCEEDED_REASON,
+		Message:            CATALOG_MEMBER_SUCCEEDED_MESSAGE + catalog,
+	}
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = CATALOG_NOT_AVAILABLE_REASON
+		condition.Message = CATALOG_NOT_AVAILABLE_MESSAGE + err.Error()
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// setZoneCreationConflict records that the zone already existed in PowerDNS when the operator tried to create it
+func setZoneCreationConflict(status *ZoneStatus) {
+	condition := metav1.Condition{
+		Type:               CREATION_CONFLICT_CONDITION,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		Reason:             PDNS_CONFLICT_REASON,
+		Message:            CREATION_CONFLICT_MESSAGE,
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// setPaused sets the Paused condition while the reconciliation is paused, and removes it once resumed
+func setPaused(conditions *[]metav1.Condition, paused bool) {
+	if !paused {
+		meta.RemoveStatusCondition(conditions, PAUSED_CONDITION)
+		return
+	}
+	condition := metav1.Condition{
+		Type:               PAUSED_CONDITION,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		Reason:             PAUSED_REASON,
+		Message:            PAUSED_MESSAGE,
+	}
+	meta.SetStatusCondition(conditions, condition)
+}
+
+// setNotAuthoritative reports the daemon type of a PowerDNS server which is not authoritative, an empty daemon type removes the condition
+func setNotAuthoritative(conditions *[]metav1.Condition, daemonType string) {
+	if daemonType == "" {
+		meta.RemoveStatusCondition(conditions, NOT_AUTHORITATIVE_CONDITION)
+		return
+	}
+	condition := metav1.Condition{
+		Type:               NOT_AUTHORITATIVE_CONDITION,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		Reason:             NOT_AUTHORITATIVE_REASON,
+		Message:            NOT_AUTHORITATIVE_MESSAGE + daemonType,
+	}
+	meta.SetStatusCondition(conditions, condition)
+}
+
+// setCleanupAbandoned reports that the finalizer was removed after the finalizer timeout, without deleting the external resources
+func setCleanupAbandoned(conditions *[]metav1.Condition, err error) {
+	condition := metav1.Condition{
+		Type:               CLEANUP_ABANDONED_CONDITION,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		Reason:             FINALIZER_TIMEOUT_REASON,
+		Message:            FINALIZER_TIMEOUT_MESSAGE + err.Error(),
+	}
+	meta.SetStatusCondition(conditions, condition)
+}
+
+// setZoneAdopted records that the zone already existed in PowerDNS and has been adopted by owner
+func setZoneAdopted(status *ZoneStatus, owner string) {
+	condition := metav1.Condition{
+		Type:               ADOPTED_CONDITION,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+		Reason:             ADOPTED_REASON,
+		Message:            ADOPTED_MESSAGE + owner,
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
@@ -0,0 +1,58 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package v1alpha2
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/joeig/go-powerdns/v3"
+)
+
+// PDNSErrorStatusCode returns the HTTP status code of a PowerDNS API error, 0 if err is not a PowerDNS API error
+func PDNSErrorStatusCode(err error) int {
+	var pErr *powerdns.Error
+	if errors.As(err, &pErr) && pErr != nil {
+		return pErr.StatusCode
+	}
+	var vErr powerdns.Error
+	if errors.As(err, &vErr) {
+		return vErr.StatusCode
+	}
+	return 0
+}
+
+// SynchronizationFailedReason returns the condition reason matching the status code of a PowerDNS API error,
+// SYNCHRONIZATION_FAILED_REASON for other errors
+func SynchronizationFailedReason(err error) string {
+	statusCode := PDNSErrorStatusCode(err)
+	switch {
+	case statusCode == http.StatusNotFound:
+		return PDNS_NOT_FOUND_REASON
+	case statusCode == http.StatusConflict:
+		return PDNS_CONFLICT_REASON
+	case statusCode == http.StatusTooManyRequests:
+		return PDNS_RATE_LIMITED_REASON
+	case statusCode >= 500 && statusCode < 600:
+		return PDNS_SERVER_ERROR_REASON
+	}
+	return SYNCHRONIZATION_FAILED_REASON
+}
+
+// IsSynchronizationFailedReason returns true if reason is one of the synchronization failure reasons
+func IsSynchronizationFailedReason(reason string) bool {
+	switch reason {
+	case SYNCHRONIZATION_FAILED_REASON, PDNS_NOT_FOUND_REASON, PDNS_CONFLICT_REASON, PDNS_SERVER_ERROR_REASON, PDNS_RATE_LIMITED_REASON:
+		return true
+	}
+	return false
+}
@@ -17,23 +17,151 @@ import (
 )
 
 // RRsetSpec defines the desired state of RRset
+// +kubebuilder:validation:XValidation:rule="has(self.records) || has(self.mx) || has(self.srv) || has(self.svcb) || has(self.caa)",message="one of records, mx, srv, svcb or caa is required"
+// +kubebuilder:validation:XValidation:rule="[has(self.records), has(self.mx), has(self.srv), has(self.svcb), has(self.caa)].filter(x, x).size() <= 1",message="records, mx, srv, svcb and caa are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.mx) || self.type == 'MX'",message="mx requires type MX"
+// +kubebuilder:validation:XValidation:rule="!has(self.srv) || self.type == 'SRV'",message="srv requires type SRV"
+// +kubebuilder:validation:XValidation:rule="!has(self.svcb) || self.type == 'SVCB' || self.type == 'HTTPS'",message="svcb requires type SVCB or HTTPS"
+// +kubebuilder:validation:XValidation:rule="!has(self.caa) || self.type == 'CAA'",message="caa requires type CAA"
+// +kubebuilder:validation:XValidation:rule="!has(self.manageReverse) || !self.manageReverse || self.type == 'A' || self.type == 'AAAA'",message="manageReverse requires type A or AAAA"
+// +kubebuilder:validation:XValidation:rule="!has(self.setPTR) || !self.setPTR || self.type == 'A' || self.type == 'AAAA'",message="setPTR requires type A or AAAA"
+// +kubebuilder:validation:XValidation:rule="!has(self.setPTR) || !self.setPTR || !has(self.manageReverse) || !self.manageReverse",message="setPTR and manageReverse are mutually exclusive"
 type RRsetSpec struct {
 	// Type of the record (e.g. "A", "PTR", "MX").
+	// "A_AAAA" holds both IPv4 and IPv6 addresses, synchronized as an A and an AAAA RRsets in PowerDNS.
 	Type string `json:"type"`
-	// Name of the record
+	// Name of the record, a wildcard name has a single leading "*" label (e.g. "*" or "*.sub").
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:XValidation:rule="!self.contains('*') || self.matches('^[*]([.][^*]+)?$')",message="only a single leading '*' label is allowed"
 	Name string `json:"name"`
 	// DNS TTL of the records, in seconds.
-	TTL uint32 `json:"ttl"`
+	// When omitted (or 0), the zone default TTL is used, then the operator default TTL of the record type, then the operator default TTL.
+	// +optional
+	TTL uint32 `json:"ttl,omitempty"`
 	// All records in this Resource Record Set.
-	Records []string `json:"records"`
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	Records []string `json:"records,omitempty"`
+	// MX records in a structured form, rendered as records. Only for type MX, exclusive with records.
+	// +optional
+	MX []MXRecord `json:"mx,omitempty"`
+	// SRV records in a structured form, rendered as records. Only for type SRV, exclusive with records.
+	// +optional
+	SRV []SRVRecord `json:"srv,omitempty"`
+	// SVCB records in a structured form, rendered as records. Only for type SVCB or HTTPS, exclusive with records.
+	// +optional
+	SVCB []SVCBRecord `json:"svcb,omitempty"`
+	// CAA records in a structured form, rendered as records. Only for type CAA, exclusive with records.
+	// +optional
+	CAA []CAARecord `json:"caa,omitempty"`
+	// Records which exist in PowerDNS but are not served, in their presentation format (as rendered for mx, srv, svcb and caa).
+	// Each of them must be one of the records of the RRset.
+	// +optional
+	DisabledRecords []string `json:"disabledRecords,omitempty"`
+	// SetPTR asks PowerDNS to create or update the PTR records of the addresses in the matching reverse zone
+	// when the RRset is changed (set-ptr). Only for type A or AAAA, exclusive with manageReverse.
+	// +optional
+	SetPTR *bool `json:"setPTR,omitempty"`
 	// Comment on RRSet.
 	// +optional
 	Comment *string `json:"comment,omitempty"`
+	// Comments on RRSet, with an optional account. The comment field, when set, is merged as the first one.
+	// +optional
+	Comments []Comment `json:"comments,omitempty"`
+	// ManageReverse maintains the PTR records of the addresses in the matching reverse Zone/ClusterZone.
+	// Only for type A or AAAA.
+	// +optional
+	ManageReverse *bool `json:"manageReverse,omitempty"`
+	// AllowNamespaceOverride lets a RRset with the same name and type override this ClusterRRset, instead of both
+	// being reported as duplicated. Only for ClusterRRsets, ignored on RRsets.
+	// +optional
+	AllowNamespaceOverride *bool `json:"allowNamespaceOverride,omitempty"`
+	// Interval of the periodic resynchronization of the RRset with PowerDNS (e.g. "10m"), so that changes made
+	// out-of-band in PowerDNS are corrected. When omitted, the operator default applies (--sync-interval).
+	// +optional
+	SyncInterval *metav1.Duration `json:"syncInterval,omitempty"`
 	// ZoneRef reference the zone the RRSet depends on.
 	ZoneRef ZoneRef `json:"zoneRef"`
 }
 
+// Comment is a PowerDNS comment on a RRSet
+type Comment struct {
+	// Content of the comment.
+	// +kubebuilder:validation:MinLength=1
+	Content string `json:"content"`
+	// Account the comment is attributed to, "powerdns-operator" when omitted.
+	// +optional
+	Account *string `json:"account,omitempty"`
+}
+
+// MXRecord is a MX record in a structured form
+type MXRecord struct {
+	// Preference of the mail exchanger, lowest is preferred.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Preference uint16 `json:"preference"`
+	// Exchange is the hostname of the mail exchanger.
+	// +kubebuilder:validation:MinLength=1
+	Exchange string `json:"exchange"`
+}
+
+// SRVRecord is a SRV record in a structured form
+type SRVRecord struct {
+	// Priority of the target host, lowest is preferred.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Priority uint16 `json:"priority"`
+	// Weight of the target host among targets with the same priority.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Weight uint16 `json:"weight"`
+	// Port of the service on the target host.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Port uint16 `json:"port"`
+	// Target is the hostname of the target host.
+	// +kubebuilder:validation:MinLength=1
+	Target string `json:"target"`
+}
+
+// SVCBRecord is a SVCB or HTTPS record in a structured form (RFC 9460)
+type SVCBRecord struct {
+	// Priority of the record, 0 is the alias mode (without params), others are the service mode, lowest is preferred.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Priority uint16 `json:"priority"`
+	// Target is the hostname of the service endpoint, "." means the owner name of the record.
+	// +kubebuilder:validation:MinLength=1
+	Target string `json:"target"`
+	// Params are the SvcParams of the record by key (e.g. alpn: "h2,h3", port: "443"),
+	// a key without value (e.g. no-default-alpn) has an empty value.
+	// +optional
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// CAARecord is a CAA record in a structured form (RFC 8659)
+type CAARecord struct {
+	// Flag of the record, 128 is the issuer critical flag.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Flag uint8 `json:"flag"`
+	// Tag of the property: issue, issuewild or iodef.
+	// +kubebuilder:validation:Enum:=issue;issuewild;iodef
+	Tag string `json:"tag"`
+	// Value of the property, unquoted: the issuer domain name with optional parameters (e.g. "letsencrypt.org"),
+	// ";" to forbid any issuer, or the iodef URL (e.g. "mailto:security@example.org").
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
+
+// ReverseRecord is a PTR record maintained in a reverse zone
+type ReverseRecord struct {
+	// Name of the PTR record.
+	Name string `json:"name"`
+	// ZoneRef reference the reverse zone of the PTR record.
+	ZoneRef ZoneRef `json:"zoneRef"`
+}
+
 type ZoneRef struct {
 	// Name of the zone.
 	Name string `json:"name"`
@@ -45,8 +173,11 @@ type ZoneRef struct {
 // RRsetStatus defines the observed state of RRset.
 type RRsetStatus struct {
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
-	DnsEntryName   *string      `json:"dnsEntryName,omitempty"`
-	SyncStatus     *string      `json:"syncStatus,omitempty"`
+	// LastSyncTime is the last time the records were successfully synchronized with PowerDNS, changed or not.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	DnsEntryName *string      `json:"dnsEntryName,omitempty"`
+	SyncStatus   *string      `json:"syncStatus,omitempty"`
 	// conditions represent the current state of the RRset resource.
 	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
 	//
@@ -61,6 +192,18 @@ type RRsetStatus struct {
 	// +optional
 	Conditions         []metav1.Condition `json:"conditions,omitempty"`
 	ObservedGeneration *int64             `json:"observedGeneration,omitempty"`
+	// ReverseRecords are the PTR records maintained when manageReverse is enabled.
+	// +optional
+	ReverseRecords []ReverseRecord `json:"reverseRecords,omitempty"`
+	// FailureCount is the number of consecutive synchronization failures, used to compute the retry backoff.
+	// +optional
+	FailureCount *int32 `json:"failureCount,omitempty"`
+	// Time of the last synchronization failure.
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+	// ZoneRef is the zone the records were last synchronized in, they are deleted from it when spec.zoneRef changes.
+	// +optional
+	ZoneRef *ZoneRef `json:"zoneRef,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -75,6 +218,8 @@ type RRsetStatus struct {
 // +kubebuilder:printcolumn:name="TTL",type="integer",JSONPath=".spec.ttl"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.syncStatus"
 // +kubebuilder:printcolumn:name="Records",type="string",JSONPath=".spec.records"
+// +kubebuilder:printcolumn:name="Last Sync",type="date",JSONPath=".status.lastSyncTime"
+// +kubebuilder:printcolumn:name="First Record",type="string",JSONPath=".spec.records[0]",priority=1
 // RRset is the Schema for the rrsets API
 type RRset struct {
 	metav1.TypeMeta `json:",inline"`
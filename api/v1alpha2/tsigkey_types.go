@@ -0,0 +1,173 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package v1alpha2
+
+import (
+	"time"
+
+	"github.com/joeig/go-powerdns/v3"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// TSIGKeySpec defines the desired state of TSIGKey
+type TSIGKeySpec struct {
+	// Algorithm of the key.
+	// +kubebuilder:validation:Enum:=hmac-md5;hmac-sha1;hmac-sha224;hmac-sha256;hmac-sha384;hmac-sha512
+	// +kubebuilder:default:=hmac-sha256
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+	// SecretRef reference the Secret holding the key (base64 encoded, as expected by PowerDNS).
+	// When the Secret or its key does not exist, the key is generated by PowerDNS and written to the Secret.
+	// Defaults to the "secret" key of a Secret named after the TSIGKey.
+	// +optional
+	SecretRef *SecretKeyRef `json:"secretRef,omitempty"`
+}
+
+// SecretKeyRef reference a key of a Secret in the namespace of the resource
+type SecretKeyRef struct {
+	// Name of the Secret.
+	Name string `json:"name"`
+	// Key of the Secret holding the value, defaults to "secret".
+	// +optional
+	Key *string `json:"key,omitempty"`
+}
+
+// TSIGKeyStatus defines the observed state of TSIGKey.
+type TSIGKeyStatus struct {
+	// ID of the key in PowerDNS.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// Name of the key in PowerDNS, "<name>.<namespace>" of the TSIGKey.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Algorithm of the key.
+	// +optional
+	Algorithm *string `json:"algorithm,omitempty"`
+	// Name of the Secret holding the key.
+	// +optional
+	SecretName *string `json:"secretName,omitempty"`
+	SyncStatus *string `json:"syncStatus,omitempty"`
+	// conditions represent the current state of the TSIGKey resource.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration *int64             `json:"observedGeneration,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Namespaced
+
+// +kubebuilder:printcolumn:name="Algorithm",type="string",JSONPath=".status.algorithm"
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".status.secretName"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.syncStatus"
+// TSIGKey is the Schema for the tsigkeys API
+type TSIGKey struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of TSIGKey
+	// +optional
+	Spec TSIGKeySpec `json:"spec,omitzero"`
+
+	// status defines the observed state of TSIGKey
+	// +optional
+	Status TSIGKeyStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// TSIGKeyList contains a list of TSIGKey
+type TSIGKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []TSIGKey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TSIGKey{}, &TSIGKeyList{})
+}
+
+// TSIGKeyPDNSName returns the name of the key in PowerDNS of the TSIGKey name in namespace, qualified with the namespace
+// as the keys of PowerDNS are not namespaced
+func TSIGKeyPDNSName(namespace, name string) string {
+	return name + "." + namespace
+}
+
+// GetPDNSName returns the name of the key in PowerDNS
+func (t *TSIGKey) GetPDNSName() string {
+	return TSIGKeyPDNSName(t.Namespace, t.Name)
+}
+
+// GetSecretName returns the name of the Secret holding the key
+func (t *TSIGKey) GetSecretName() string {
+	if t.Spec.SecretRef != nil {
+		return t.Spec.SecretRef.Name
+	}
+	return t.Name
+}
+
+// GetSecretKey returns the key of the Secret holding the key
+func (t *TSIGKey) GetSecretKey() string {
+	if t.Spec.SecretRef != nil && t.Spec.SecretRef.Key != nil {
+		return *t.Spec.SecretRef.Key
+	}
+	return DEFAULT_TSIGKEY_SECRET_KEY
+}
+
+// IsInExpectedStatus returns true if Status.SyncStatus and Status.ObservedGeneration are, at least, at expected value
+func (t *TSIGKey) IsInExpectedStatus(
+	expectedMinimumObservedGeneration int64,
+	expectedSyncStatus string,
+	expectedConditionStatus metav1.ConditionStatus,
+) bool {
+	currentAvailableCondition := meta.FindStatusCondition(t.Status.Conditions, "Available")
+	return t.Status.ObservedGeneration != nil &&
+		*t.Status.ObservedGeneration >= expectedMinimumObservedGeneration &&
+		t.Status.SyncStatus != nil &&
+		*t.Status.SyncStatus == expectedSyncStatus &&
+		currentAvailableCondition != nil &&
+		currentAvailableCondition.Status == expectedConditionStatus
+}
+
+func (t *TSIGKey) SetSynchronizationFailed(err error) {
+	t.Status.SyncStatus = ptr.To(FAILED_STATUS)
+	t.Status.ObservedGeneration = &t.Generation
+	t.setAvailableCondition(metav1.ConditionFalse, SynchronizationFailedReason(err), SYNCHRONIZATION_FAILED_MESSAGE+err.Error())
+}
+
+func (t *TSIGKey) SetAvailable(tsigKeyRes *powerdns.TSIGKey) {
+	t.Status.SyncStatus = ptr.To(SUCCEEDED_STATUS)
+	t.Status.ObservedGeneration = &t.Generation
+	t.Status.ID = tsigKeyRes.ID
+	t.Status.Name = tsigKeyRes.Name
+	t.Status.Algorithm = tsigKeyRes.Algorithm
+	t.Status.SecretName = ptr.To(t.GetSecretName())
+	t.setAvailableCondition(metav1.ConditionTrue, SUCCEEDED_REASON, SUCCEEDED_MESSAGE)
+}
+
+func (t *TSIGKey) setAvailableCondition(status metav1.ConditionStatus, reason, message string) {
+	condition := metav1.Condition{
+		Type:               "Available",
+		Status:             status,
+		LastTransitionTime: metav1.NewTime(time.Now().UTC()),
+		Reason:             reason,
+		Message:            message,
+	}
+	meta.SetStatusCondition(&t.Status.Conditions, condition)
+}
@@ -17,22 +17,198 @@ import (
 )
 
 // ZoneSpec defines the desired state of Zone
+// +kubebuilder:validation:XValidation:rule="self.kind == 'Slave' || (has(self.manageNS) && !self.manageNS) || (has(self.nameservers) && size(self.nameservers) > 0)",message="nameservers are required unless kind is Slave or manageNS is false"
+// +kubebuilder:validation:XValidation:rule="!has(self.nsec3Params) || (has(self.dnssec) && self.dnssec)",message="nsec3Params requires dnssec to be enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.presigned) || !self.presigned || (has(self.dnssec) && self.dnssec)",message="presigned requires dnssec to be enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.serialStrategy) || self.serialStrategy == 'Default' || !has(self.soa_edit_api)",message="serialStrategy Increment or DateBased cannot be combined with soa_edit_api"
+// +kubebuilder:validation:XValidation:rule="!has(self.serialStrategy) || self.serialStrategy == 'Default' || (self.kind != 'Slave' && self.kind != 'Consumer')",message="serialStrategy Increment or DateBased requires a zone of kind Native, Master or Producer"
 type ZoneSpec struct {
 	// Kind of the zone, one of "Native", "Master", "Slave", "Producer", "Consumer".
 	// +kubebuilder:validation:Enum:=Native;Master;Slave;Producer;Consumer
 	Kind string `json:"kind"`
-	// List of the nameservers of the zone.
-	// +kubebuilder:validation:MinItems=1
+	// List of the nameservers of the zone, required unless kind is "Slave" (the NS records are then transferred from the masters)
+	// or manageNS is false.
 	// +kubebuilder:validation:items:Pattern=`^([a-zA-Z0-9-]+\.)*[a-zA-Z0-9-]+$`
-	Nameservers []string `json:"nameservers"`
+	// +optional
+	Nameservers []string `json:"nameservers,omitempty"`
+	// Whether or not the apex NS records are managed by the operator from "nameservers", defaults to true.
+	// When false, the apex NS records are not reconciled and can be owned by an RRset of type NS.
+	// +optional
+	ManageNS *bool `json:"manageNS,omitempty"`
+	// List of the masters (IP address with optional port, e.g. "192.0.2.1" or "192.0.2.1:5300") the zone is transferred from,
+	// only relevant when kind is "Slave".
+	// +optional
+	Masters []string `json:"masters,omitempty"`
+	// List of additional addresses (IP address with optional port, e.g. "192.0.2.1", "192.0.2.1:5300" or "[2001:db8::1]:5300")
+	// notified on changes of the zone, besides its nameservers (ALSO-NOTIFY metadata), e.g. hidden secondaries.
+	// +optional
+	AlsoNotify []string `json:"alsoNotify,omitempty"`
+	// List of the networks (IP address or CIDR, e.g. "192.0.2.0/24") allowed to perform zone transfers (AXFR),
+	// "AUTO-NS" allows the nameservers of the zone (ALLOW-AXFR-FROM metadata). An empty list removes the metadata.
+	// When omitted, the ALLOW-AXFR-FROM metadata is removed, unless it is set through "metadata".
+	// +optional
+	AllowAXFRFrom []string `json:"allowAXFRFrom,omitempty"`
 	// The catalog this zone is a member of
 	// +optional
 	Catalog *string `json:"catalog,omitempty"`
-	// The SOA-EDIT-API metadata item, one of "DEFAULT", "INCREASE", "EPOCH", defaults to "DEFAULT"
+	// The account of the zone in PowerDNS, an opaque string used for multi-tenancy (e.g. chargeback).
+	// When omitted, the account is not managed and the one set in PowerDNS is kept.
+	// +optional
+	Account *string `json:"account,omitempty"`
+	// Comment on the zone, set on its apex SOA record with the "powerdns-operator" account.
+	// When omitted, the comments of the SOA record are not managed.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+	// The SOA-EDIT-API metadata item, one of "DEFAULT", "INCREASE", "EPOCH", defaults to "DEFAULT".
+	// It cannot be set along with the "Increment" and "DateBased" serial strategies.
 	// +kubebuilder:validation:Enum:=DEFAULT;INCREASE;EPOCH
-	// +kubebuilder:default:="DEFAULT"
 	// +optional
 	SOAEditAPI *string `json:"soa_edit_api,omitempty"`
+	// Management of the SOA serial, one of "Default", "Increment", "DateBased", defaults to "Default".
+	// With "Default", the serial is changed by PowerDNS according to soa_edit_api. Otherwise, SOA-EDIT-API is disabled
+	// in PowerDNS and the operator sets the serial when the records of the zone change: incremented by one with "Increment",
+	// in the "YYYYMMDDNN" form with "DateBased" (incremented by one when the serial of the day is already reached).
+	// +kubebuilder:validation:Enum:=Default;Increment;DateBased
+	// +optional
+	SerialStrategy *string `json:"serialStrategy,omitempty"`
+	// TTL harmonization policy across the RRsets managed in the zone, one of "Disabled", "Report".
+	// With "Report", RRsets of the same type having different TTLs are reported in status.
+	// +kubebuilder:validation:Enum:=Disabled;Report
+	// +optional
+	TTLHarmonization *string `json:"ttlHarmonization,omitempty"`
+	// Whether or not this zone is DNSSEC signed. When omitted, the zone is created unsigned
+	// and its DNSSEC state is not managed afterwards (e.g. when signed through Cryptokeys).
+	// +optional
+	DNSSEC *bool `json:"dnssec,omitempty"`
+	// Whether or not the zone is presigned: it is signed outside of PowerDNS and imported with its signatures,
+	// PowerDNS serves them as is. Requires dnssec, the keys of a presigned zone are not managed (no Cryptokey, no ZSK rollover).
+	// When omitted, the presigned state is not managed.
+	// +optional
+	Presigned *bool `json:"presigned,omitempty"`
+	// Whether or not PowerDNS rectifies the zone on each change made through its API (API-RECTIFY metadata),
+	// the zone is also rectified by the operator after a change of its DNSSEC signing.
+	// When omitted, the API-RECTIFY metadata is not managed and the PowerDNS default applies (default-api-rectify).
+	// +optional
+	AutoRectify *bool `json:"autoRectify,omitempty"`
+	// NSEC3 parameters of the zone, in the "<algorithm> <flags> <iterations> <salt>" form (e.g. "1 0 0 -").
+	// Only allowed on DNSSEC signed zones, NSEC is used when omitted.
+	// +kubebuilder:validation:Pattern=`^1 [01] [0-9]+ ([0-9a-fA-F]+|-)$`
+	// +optional
+	Nsec3Params *string `json:"nsec3Params,omitempty"`
+	// Names of the TSIGKeys allowed to perform zone transfers (AXFR).
+	// TSIGKeys must exist in the namespace of a Zone, a ClusterZone references them as "<namespace>/<name>".
+	// +optional
+	TSIGAllowAXFR []string `json:"tsigAllowAXFR,omitempty"`
+	// Names of the TSIGKeys allowed to perform dynamic updates (DNS UPDATE).
+	// TSIGKeys must exist in the namespace of a Zone, a ClusterZone references them as "<namespace>/<name>".
+	// +optional
+	TSIGAllowDNSUpdate []string `json:"tsigAllowDNSUpdate,omitempty"`
+	// Whether or not the secondaries are notified (DNS NOTIFY) as soon as RRsets of the zone change,
+	// instead of waiting for the refresh of the SOA. Notifies of a burst of changes are coalesced.
+	// +optional
+	NotifyOnChange *bool `json:"notifyOnChange,omitempty"`
+	// Default TTL, in seconds, of the RRsets of the zone which do not specify a TTL.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DefaultTTL *uint32 `json:"defaultTTL,omitempty"`
+	// TTL, in seconds, of the NS records of the zone. When omitted, the TTL of the existing NS records is kept
+	// (1500 seconds when they are created by the operator).
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2147483647
+	// +optional
+	NameserverTTL *uint32 `json:"nameserverTTL,omitempty"`
+	// Interval of the periodic resynchronization of the zone with PowerDNS (e.g. "10m"), so that changes made
+	// out-of-band in PowerDNS are corrected. When omitted, the operator default applies (--sync-interval).
+	// +optional
+	SyncInterval *metav1.Duration `json:"syncInterval,omitempty"`
+	// SOA parameters of the zone, written in the apex SOA record. Omitted parameters keep the values set by PowerDNS.
+	// +optional
+	SOA *SOASpec `json:"soa,omitempty"`
+	// Glue records (IPv4 and/or IPv6 addresses) of in-bailiwick nameservers, indexed by nameserver name.
+	// Each nameserver must be listed in "nameservers" and be part of the zone.
+	// +optional
+	NameserverGlue map[string][]string `json:"nameserverGlue,omitempty"`
+	// Metadata of the zone (e.g. "ALLOW-AXFR-FROM", "SOA-EDIT"), values indexed by metadata kind.
+	// When set, the metadata of the zone in PowerDNS is made to match it exactly, except the kinds managed through
+	// dedicated fields ("ALSO-NOTIFY", "TSIG-ALLOW-AXFR", "TSIG-ALLOW-DNSUPDATE", "NSEC3PARAM", "SOA-EDIT-API", "API-RECTIFY",
+	// "X-POWERDNS-OPERATOR") and the kinds read-only
+	// in PowerDNS API ("PRESIGNED", "NSEC3NARROW", "LUA-AXFR-SCRIPT"). When omitted, the metadata is not managed.
+	// +optional
+	Metadata map[string][]string `json:"metadata,omitempty"`
+	// Whether or not the zone is adopted when it already exists in PowerDNS on its first synchronization.
+	// An adopted zone is marked as managed by the operator with the "X-POWERDNS-OPERATOR" metadata,
+	// its records are kept and the Adopted condition is reported.
+	// +optional
+	Adopt *bool `json:"adopt,omitempty"`
+	// RRsets created with the zone, as RRsets (ClusterRRsets for a ClusterZone) owned by the zone and deleted with it.
+	// Each of them is applied once: it is skipped when a RRset with the same name and type already exists,
+	// and it is not recreated when deleted afterwards.
+	// +optional
+	DefaultRecords []RRsetTemplate `json:"defaultRecords,omitempty"`
+}
+
+// RRsetTemplate defines a RRset created with a zone
+type RRsetTemplate struct {
+	// Type of the record (e.g. "A", "MX", "TXT").
+	Type string `json:"type"`
+	// Name of the record, relative to the zone or fully qualified with a trailing "." (e.g. "www" or "example.com.").
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// DNS TTL of the records, in seconds. When omitted, the default TTL of the zone applies.
+	// +optional
+	TTL uint32 `json:"ttl,omitempty"`
+	// All records in this Resource Record Set.
+	// +kubebuilder:validation:MinItems=1
+	Records []string `json:"records"`
+	// Comment on RRSet.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// SOASpec defines the parameters of the SOA record of a zone, the serial is managed by PowerDNS (see SOAEditAPI)
+type SOASpec struct {
+	// Primary nameserver of the zone (MNAME).
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z0-9-]+\.)*[a-zA-Z0-9-]+\.?$`
+	// +optional
+	MName *string `json:"mname,omitempty"`
+	// Mailbox of the person responsible for the zone (RNAME), in the DNS form (e.g. "hostmaster.example.org").
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z0-9-]+\.)*[a-zA-Z0-9-]+\.?$`
+	// +optional
+	RName *string `json:"rname,omitempty"`
+	// Interval, in seconds, before the secondaries check for a new serial.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Refresh *uint32 `json:"refresh,omitempty"`
+	// Interval, in seconds, before the secondaries retry a failed refresh.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Retry *uint32 `json:"retry,omitempty"`
+	// Time, in seconds, after which the secondaries stop answering when the primary is unreachable.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Expire *uint32 `json:"expire,omitempty"`
+	// TTL, in seconds, of negative answers (MINIMUM field).
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	NegativeTTL *uint32 `json:"negativeTTL,omitempty"`
+}
+
+// TTLInconsistency reports the distinct TTLs found among RRsets of the same type in a zone
+type TTLInconsistency struct {
+	// Type of the records (e.g. "A", "MX")
+	Type string `json:"type"`
+	// Distinct TTLs found among the RRsets of this type
+	TTLs []uint32 `json:"ttls"`
+}
+
+// ZoneExport references the ConfigMap holding the BIND format export of a zone
+type ZoneExport struct {
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+	// Name of the ConfigMap, named after the zone.
+	ConfigMap string `json:"configMap"`
+	// Serial of the zone when exported, the export is regenerated when the serial changes.
+	Serial uint32 `json:"serial"`
 }
 
 // ZoneStatus defines the observed state of Zone.
@@ -55,16 +231,66 @@ type ZoneStatus struct {
 	// The SOA serial as seen in query responses.
 	// +optional
 	EditedSerial *uint32 `json:"edited_serial,omitempty"`
+	// The SOA serial last set by the operator (only with "Increment" or "DateBased" serial strategy).
+	// +optional
+	AppliedSerial *uint32 `json:"appliedSerial,omitempty"`
+	// Digest of the records of the zone, but the SOA serial, when the serial was last set by the operator.
+	// The serial is set again when the digest changes.
+	// +optional
+	RecordsDigest *string `json:"recordsDigest,omitempty"`
 	// List of IP addresses configured as a master for this zone ("Slave" type zones only).
 	// +optional
 	Masters []string `json:"masters,omitempty"`
+	// List of the additional addresses notified on changes of the zone (ALSO-NOTIFY metadata).
+	// +optional
+	AlsoNotify []string `json:"alsoNotify,omitempty"`
 	// Whether or not this zone is DNSSEC signed.
 	// +optional
 	DNSsec *bool `json:"dnssec,omitempty"`
+	// Whether or not this zone is presigned.
+	// +optional
+	Presigned *bool `json:"presigned,omitempty"`
 	// The catalog this zone is a member of.
 	// +optional
-	Catalog    *string `json:"catalog,omitempty"`
-	SyncStatus *string `json:"syncStatus,omitempty"`
+	Catalog *string `json:"catalog,omitempty"`
+	// The account of the zone in PowerDNS.
+	// +optional
+	Account *string `json:"account,omitempty"`
+	// RRset types managed in the zone with inconsistent TTLs (only with "Report" TTL harmonization policy).
+	// +optional
+	TTLInconsistencies []TTLInconsistency `json:"ttlInconsistencies,omitempty"`
+	// RRsets managed by the operator in the zone, as "<FQDN> <type>" (e.g. "www.example.org. A"),
+	// omitted when the zone has more than 100 RRsets (see managedRecordsCount).
+	// +optional
+	ManagedRecords []string `json:"managedRecords,omitempty"`
+	// Number of RRsets managed by the operator in the zone.
+	// +optional
+	ManagedRecordsCount *int32 `json:"managedRecordsCount,omitempty"`
+	// NSEC3 parameters in use, when the zone is DNSSEC signed with NSEC3.
+	// +optional
+	Nsec3Params *string `json:"nsec3Params,omitempty"`
+	// Time of the last NOTIFY sent on RRsets changes (see notifyOnChange).
+	// +optional
+	LastNotifyTime *metav1.Time `json:"lastNotifyTime,omitempty"`
+	// Export of the zone in BIND format, when requested with the "dns.cav.enablers.ob/export" annotation.
+	// +optional
+	Export *ZoneExport `json:"export,omitempty"`
+	// Glue records (A/AAAA) managed for in-bailiwick nameservers, indexed by nameserver name.
+	// +optional
+	NameserverGlue map[string][]string `json:"nameserverGlue,omitempty"`
+	// Metadata of the zone in PowerDNS, values indexed by metadata kind.
+	// +optional
+	Metadata map[string][]string `json:"metadata,omitempty"`
+	// Zones listed as members of the catalog ("Producer" ClusterZones only), from the zones referencing it in spec.catalog.
+	// +optional
+	CatalogMembers []string `json:"catalogMembers,omitempty"`
+	// FailureCount is the number of consecutive synchronization failures, used to compute the retry backoff.
+	// +optional
+	FailureCount *int32 `json:"failureCount,omitempty"`
+	// Time of the last synchronization failure.
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+	SyncStatus      *string      `json:"syncStatus,omitempty"`
 	// conditions represent the current state of the Zone resource.
 	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
 	//
@@ -88,6 +314,7 @@ type ZoneStatus struct {
 
 // +kubebuilder:printcolumn:name="Serial",type="integer",JSONPath=".status.serial"
 // +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id"
+// +kubebuilder:printcolumn:name="RRsets",type="integer",JSONPath=".status.managedRecordsCount"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.syncStatus"
 // Zone is the Schema for the zones API
 type Zone struct {
@@ -20,6 +20,21 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAARecord) DeepCopyInto(out *CAARecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAARecord.
+func (in *CAARecord) DeepCopy() *CAARecord {
+	if in == nil {
+		return nil
+	}
+	out := new(CAARecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterRRset) DeepCopyInto(out *ClusterRRset) {
 	*out = *in
@@ -139,7 +154,27 @@ func (in *ClusterZoneList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RRset) DeepCopyInto(out *RRset) {
+func (in *Comment) DeepCopyInto(out *Comment) {
+	*out = *in
+	if in.Account != nil {
+		in, out := &in.Account, &out.Account
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Comment.
+func (in *Comment) DeepCopy() *Comment {
+	if in == nil {
+		return nil
+	}
+	out := new(Comment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cryptokey) DeepCopyInto(out *Cryptokey) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -147,18 +182,18 @@ func (in *RRset) DeepCopyInto(out *RRset) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RRset.
-func (in *RRset) DeepCopy() *RRset {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cryptokey.
+func (in *Cryptokey) DeepCopy() *Cryptokey {
 	if in == nil {
 		return nil
 	}
-	out := new(RRset)
+	out := new(Cryptokey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RRset) DeepCopyObject() runtime.Object {
+func (in *Cryptokey) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -166,31 +201,31 @@ func (in *RRset) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RRsetList) DeepCopyInto(out *RRsetList) {
+func (in *CryptokeyList) DeepCopyInto(out *CryptokeyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RRset, len(*in))
+		*out = make([]Cryptokey, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RRsetList.
-func (in *RRsetList) DeepCopy() *RRsetList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptokeyList.
+func (in *CryptokeyList) DeepCopy() *CryptokeyList {
 	if in == nil {
 		return nil
 	}
-	out := new(RRsetList)
+	out := new(CryptokeyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RRsetList) DeepCopyObject() runtime.Object {
+func (in *CryptokeyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -198,43 +233,69 @@ func (in *RRsetList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RRsetSpec) DeepCopyInto(out *RRsetSpec) {
+func (in *CryptokeySpec) DeepCopyInto(out *CryptokeySpec) {
 	*out = *in
-	if in.Records != nil {
-		in, out := &in.Records, &out.Records
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Comment != nil {
-		in, out := &in.Comment, &out.Comment
+	out.ZoneRef = in.ZoneRef
+	if in.Algorithm != nil {
+		in, out := &in.Algorithm, &out.Algorithm
 		*out = new(string)
 		**out = **in
 	}
-	out.ZoneRef = in.ZoneRef
+	if in.Bits != nil {
+		in, out := &in.Bits, &out.Bits
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RRsetSpec.
-func (in *RRsetSpec) DeepCopy() *RRsetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptokeySpec.
+func (in *CryptokeySpec) DeepCopy() *CryptokeySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RRsetSpec)
+	out := new(CryptokeySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RRsetStatus) DeepCopyInto(out *RRsetStatus) {
+func (in *CryptokeyStatus) DeepCopyInto(out *CryptokeyStatus) {
 	*out = *in
-	if in.LastUpdateTime != nil {
-		in, out := &in.LastUpdateTime, &out.LastUpdateTime
-		*out = (*in).DeepCopy()
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(uint64)
+		**out = **in
 	}
-	if in.DnsEntryName != nil {
-		in, out := &in.DnsEntryName, &out.DnsEntryName
+	if in.KeyTag != nil {
+		in, out := &in.KeyTag, &out.KeyTag
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Algorithm != nil {
+		in, out := &in.Algorithm, &out.Algorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNSKey != nil {
+		in, out := &in.DNSKey, &out.DNSKey
 		*out = new(string)
 		**out = **in
 	}
+	if in.DS != nil {
+		in, out := &in.DS, &out.DS
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.SyncStatus != nil {
 		in, out := &in.SyncStatus, &out.SyncStatus
 		*out = new(string)
@@ -254,18 +315,18 @@ func (in *RRsetStatus) DeepCopyInto(out *RRsetStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RRsetStatus.
-func (in *RRsetStatus) DeepCopy() *RRsetStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptokeyStatus.
+func (in *CryptokeyStatus) DeepCopy() *CryptokeyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RRsetStatus)
+	out := new(CryptokeyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Zone) DeepCopyInto(out *Zone) {
+func (in *DNSSECMaintenance) DeepCopyInto(out *DNSSECMaintenance) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -273,18 +334,18 @@ func (in *Zone) DeepCopyInto(out *Zone) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Zone.
-func (in *Zone) DeepCopy() *Zone {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECMaintenance.
+func (in *DNSSECMaintenance) DeepCopy() *DNSSECMaintenance {
 	if in == nil {
 		return nil
 	}
-	out := new(Zone)
+	out := new(DNSSECMaintenance)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Zone) DeepCopyObject() runtime.Object {
+func (in *DNSSECMaintenance) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -292,31 +353,31 @@ func (in *Zone) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ZoneList) DeepCopyInto(out *ZoneList) {
+func (in *DNSSECMaintenanceList) DeepCopyInto(out *DNSSECMaintenanceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Zone, len(*in))
+		*out = make([]DNSSECMaintenance, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneList.
-func (in *ZoneList) DeepCopy() *ZoneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECMaintenanceList.
+func (in *DNSSECMaintenanceList) DeepCopy() *DNSSECMaintenanceList {
 	if in == nil {
 		return nil
 	}
-	out := new(ZoneList)
+	out := new(DNSSECMaintenanceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ZoneList) DeepCopyObject() runtime.Object {
+func (in *DNSSECMaintenanceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -324,98 +385,1042 @@ func (in *ZoneList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ZoneRef) DeepCopyInto(out *ZoneRef) {
+func (in *DNSSECMaintenanceSpec) DeepCopyInto(out *DNSSECMaintenanceSpec) {
 	*out = *in
+	if in.Rectify != nil {
+		in, out := &in.Rectify, &out.Rectify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ZSKRollover != nil {
+		in, out := &in.ZSKRollover, &out.ZSKRollover
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrepublishDuration != nil {
+		in, out := &in.PrepublishDuration, &out.PrepublishDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneRef.
-func (in *ZoneRef) DeepCopy() *ZoneRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECMaintenanceSpec.
+func (in *DNSSECMaintenanceSpec) DeepCopy() *DNSSECMaintenanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ZoneRef)
+	out := new(DNSSECMaintenanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ZoneSpec) DeepCopyInto(out *ZoneSpec) {
+func (in *DNSSECMaintenanceStatus) DeepCopyInto(out *DNSSECMaintenanceStatus) {
 	*out = *in
-	if in.Nameservers != nil {
-		in, out := &in.Nameservers, &out.Nameservers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]DNSSECMaintenanceZoneStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Catalog != nil {
-		in, out := &in.Catalog, &out.Catalog
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
 		*out = new(string)
 		**out = **in
 	}
-	if in.SOAEditAPI != nil {
-		in, out := &in.SOAEditAPI, &out.SOAEditAPI
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SyncStatus != nil {
+		in, out := &in.SyncStatus, &out.SyncStatus
 		*out = new(string)
 		**out = **in
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSpec.
-func (in *ZoneSpec) DeepCopy() *ZoneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECMaintenanceStatus.
+func (in *DNSSECMaintenanceStatus) DeepCopy() *DNSSECMaintenanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ZoneSpec)
+	out := new(DNSSECMaintenanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ZoneStatus) DeepCopyInto(out *ZoneStatus) {
+func (in *DNSSECMaintenanceZoneStatus) DeepCopyInto(out *DNSSECMaintenanceZoneStatus) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.Rectify != nil {
+		in, out := &in.Rectify, &out.Rectify
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.NewKeyID != nil {
+		in, out := &in.NewKeyID, &out.NewKeyID
+		*out = new(uint64)
 		**out = **in
 	}
-	if in.Kind != nil {
-		in, out := &in.Kind, &out.Kind
+	if in.PrepublishTime != nil {
+		in, out := &in.PrepublishTime, &out.PrepublishTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
 		*out = new(string)
 		**out = **in
 	}
-	if in.Serial != nil {
-		in, out := &in.Serial, &out.Serial
-		*out = new(uint32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECMaintenanceZoneStatus.
+func (in *DNSSECMaintenanceZoneStatus) DeepCopy() *DNSSECMaintenanceZoneStatus {
+	if in == nil {
+		return nil
 	}
-	if in.NotifiedSerial != nil {
-		in, out := &in.NotifiedSerial, &out.NotifiedSerial
-		*out = new(uint32)
-		**out = **in
+	out := new(DNSSECMaintenanceZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MXRecord) DeepCopyInto(out *MXRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MXRecord.
+func (in *MXRecord) DeepCopy() *MXRecord {
+	if in == nil {
+		return nil
 	}
-	if in.EditedSerial != nil {
-		in, out := &in.EditedSerial, &out.EditedSerial
-		*out = new(uint32)
-		**out = **in
+	out := new(MXRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RRset) DeepCopyInto(out *RRset) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RRset.
+func (in *RRset) DeepCopy() *RRset {
+	if in == nil {
+		return nil
 	}
-	if in.Masters != nil {
-		in, out := &in.Masters, &out.Masters
+	out := new(RRset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RRset) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RRsetList) DeepCopyInto(out *RRsetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RRset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RRsetList.
+func (in *RRsetList) DeepCopy() *RRsetList {
+	if in == nil {
+		return nil
+	}
+	out := new(RRsetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RRsetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RRsetSpec) DeepCopyInto(out *RRsetSpec) {
+	*out = *in
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.DNSsec != nil {
-		in, out := &in.DNSsec, &out.DNSsec
+	if in.MX != nil {
+		in, out := &in.MX, &out.MX
+		*out = make([]MXRecord, len(*in))
+		copy(*out, *in)
+	}
+	if in.SRV != nil {
+		in, out := &in.SRV, &out.SRV
+		*out = make([]SRVRecord, len(*in))
+		copy(*out, *in)
+	}
+	if in.SVCB != nil {
+		in, out := &in.SVCB, &out.SVCB
+		*out = make([]SVCBRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CAA != nil {
+		in, out := &in.CAA, &out.CAA
+		*out = make([]CAARecord, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisabledRecords != nil {
+		in, out := &in.DisabledRecords, &out.DisabledRecords
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SetPTR != nil {
+		in, out := &in.SetPTR, &out.SetPTR
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Catalog != nil {
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comments != nil {
+		in, out := &in.Comments, &out.Comments
+		*out = make([]Comment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManageReverse != nil {
+		in, out := &in.ManageReverse, &out.ManageReverse
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowNamespaceOverride != nil {
+		in, out := &in.AllowNamespaceOverride, &out.AllowNamespaceOverride
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SyncInterval != nil {
+		in, out := &in.SyncInterval, &out.SyncInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	out.ZoneRef = in.ZoneRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RRsetSpec.
+func (in *RRsetSpec) DeepCopy() *RRsetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RRsetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RRsetStatus) DeepCopyInto(out *RRsetStatus) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DnsEntryName != nil {
+		in, out := &in.DnsEntryName, &out.DnsEntryName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SyncStatus != nil {
+		in, out := &in.SyncStatus, &out.SyncStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReverseRecords != nil {
+		in, out := &in.ReverseRecords, &out.ReverseRecords
+		*out = make([]ReverseRecord, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureCount != nil {
+		in, out := &in.FailureCount, &out.FailureCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ZoneRef != nil {
+		in, out := &in.ZoneRef, &out.ZoneRef
+		*out = new(ZoneRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RRsetStatus.
+func (in *RRsetStatus) DeepCopy() *RRsetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RRsetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RRsetTemplate) DeepCopyInto(out *RRsetTemplate) {
+	*out = *in
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RRsetTemplate.
+func (in *RRsetTemplate) DeepCopy() *RRsetTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(RRsetTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReverseRecord) DeepCopyInto(out *ReverseRecord) {
+	*out = *in
+	out.ZoneRef = in.ZoneRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReverseRecord.
+func (in *ReverseRecord) DeepCopy() *ReverseRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ReverseRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SOASpec) DeepCopyInto(out *SOASpec) {
+	*out = *in
+	if in.MName != nil {
+		in, out := &in.MName, &out.MName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RName != nil {
+		in, out := &in.RName, &out.RName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Refresh != nil {
+		in, out := &in.Refresh, &out.Refresh
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Expire != nil {
+		in, out := &in.Expire, &out.Expire
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.NegativeTTL != nil {
+		in, out := &in.NegativeTTL, &out.NegativeTTL
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SOASpec.
+func (in *SOASpec) DeepCopy() *SOASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SOASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SRVRecord) DeepCopyInto(out *SRVRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SRVRecord.
+func (in *SRVRecord) DeepCopy() *SRVRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(SRVRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SVCBRecord) DeepCopyInto(out *SVCBRecord) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SVCBRecord.
+func (in *SVCBRecord) DeepCopy() *SVCBRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(SVCBRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRef.
+func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TSIGKey) DeepCopyInto(out *TSIGKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TSIGKey.
+func (in *TSIGKey) DeepCopy() *TSIGKey {
+	if in == nil {
+		return nil
+	}
+	out := new(TSIGKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TSIGKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TSIGKeyList) DeepCopyInto(out *TSIGKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TSIGKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TSIGKeyList.
+func (in *TSIGKeyList) DeepCopy() *TSIGKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TSIGKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TSIGKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TSIGKeySpec) DeepCopyInto(out *TSIGKeySpec) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretKeyRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TSIGKeySpec.
+func (in *TSIGKeySpec) DeepCopy() *TSIGKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TSIGKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TSIGKeyStatus) DeepCopyInto(out *TSIGKeyStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Algorithm != nil {
+		in, out := &in.Algorithm, &out.Algorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SyncStatus != nil {
+		in, out := &in.SyncStatus, &out.SyncStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TSIGKeyStatus.
+func (in *TSIGKeyStatus) DeepCopy() *TSIGKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TSIGKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TTLInconsistency) DeepCopyInto(out *TTLInconsistency) {
+	*out = *in
+	if in.TTLs != nil {
+		in, out := &in.TTLs, &out.TTLs
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TTLInconsistency.
+func (in *TTLInconsistency) DeepCopy() *TTLInconsistency {
+	if in == nil {
+		return nil
+	}
+	out := new(TTLInconsistency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Zone) DeepCopyInto(out *Zone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Zone.
+func (in *Zone) DeepCopy() *Zone {
+	if in == nil {
+		return nil
+	}
+	out := new(Zone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Zone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneExport) DeepCopyInto(out *ZoneExport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneExport.
+func (in *ZoneExport) DeepCopy() *ZoneExport {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneList) DeepCopyInto(out *ZoneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Zone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneList.
+func (in *ZoneList) DeepCopy() *ZoneList {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZoneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneRef) DeepCopyInto(out *ZoneRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneRef.
+func (in *ZoneRef) DeepCopy() *ZoneRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSpec) DeepCopyInto(out *ZoneSpec) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManageNS != nil {
+		in, out := &in.ManageNS, &out.ManageNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Masters != nil {
+		in, out := &in.Masters, &out.Masters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlsoNotify != nil {
+		in, out := &in.AlsoNotify, &out.AlsoNotify
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowAXFRFrom != nil {
+		in, out := &in.AllowAXFRFrom, &out.AllowAXFRFrom
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Catalog != nil {
+		in, out := &in.Catalog, &out.Catalog
+		*out = new(string)
+		**out = **in
+	}
+	if in.Account != nil {
+		in, out := &in.Account, &out.Account
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.SOAEditAPI != nil {
+		in, out := &in.SOAEditAPI, &out.SOAEditAPI
+		*out = new(string)
+		**out = **in
+	}
+	if in.SerialStrategy != nil {
+		in, out := &in.SerialStrategy, &out.SerialStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTLHarmonization != nil {
+		in, out := &in.TTLHarmonization, &out.TTLHarmonization
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSSEC != nil {
+		in, out := &in.DNSSEC, &out.DNSSEC
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Presigned != nil {
+		in, out := &in.Presigned, &out.Presigned
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoRectify != nil {
+		in, out := &in.AutoRectify, &out.AutoRectify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Nsec3Params != nil {
+		in, out := &in.Nsec3Params, &out.Nsec3Params
+		*out = new(string)
+		**out = **in
+	}
+	if in.TSIGAllowAXFR != nil {
+		in, out := &in.TSIGAllowAXFR, &out.TSIGAllowAXFR
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TSIGAllowDNSUpdate != nil {
+		in, out := &in.TSIGAllowDNSUpdate, &out.TSIGAllowDNSUpdate
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotifyOnChange != nil {
+		in, out := &in.NotifyOnChange, &out.NotifyOnChange
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultTTL != nil {
+		in, out := &in.DefaultTTL, &out.DefaultTTL
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.NameserverTTL != nil {
+		in, out := &in.NameserverTTL, &out.NameserverTTL
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SyncInterval != nil {
+		in, out := &in.SyncInterval, &out.SyncInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SOA != nil {
+		in, out := &in.SOA, &out.SOA
+		*out = new(SOASpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NameserverGlue != nil {
+		in, out := &in.NameserverGlue, &out.NameserverGlue
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultRecords != nil {
+		in, out := &in.DefaultRecords, &out.DefaultRecords
+		*out = make([]RRsetTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSpec.
+func (in *ZoneSpec) DeepCopy() *ZoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneStatus) DeepCopyInto(out *ZoneStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+	if in.Serial != nil {
+		in, out := &in.Serial, &out.Serial
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.NotifiedSerial != nil {
+		in, out := &in.NotifiedSerial, &out.NotifiedSerial
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.EditedSerial != nil {
+		in, out := &in.EditedSerial, &out.EditedSerial
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.AppliedSerial != nil {
+		in, out := &in.AppliedSerial, &out.AppliedSerial
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RecordsDigest != nil {
+		in, out := &in.RecordsDigest, &out.RecordsDigest
+		*out = new(string)
+		**out = **in
+	}
+	if in.Masters != nil {
+		in, out := &in.Masters, &out.Masters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlsoNotify != nil {
+		in, out := &in.AlsoNotify, &out.AlsoNotify
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSsec != nil {
+		in, out := &in.DNSsec, &out.DNSsec
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Presigned != nil {
+		in, out := &in.Presigned, &out.Presigned
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Catalog != nil {
 		in, out := &in.Catalog, &out.Catalog
 		*out = new(string)
 		**out = **in
 	}
+	if in.Account != nil {
+		in, out := &in.Account, &out.Account
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTLInconsistencies != nil {
+		in, out := &in.TTLInconsistencies, &out.TTLInconsistencies
+		*out = make([]TTLInconsistency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManagedRecords != nil {
+		in, out := &in.ManagedRecords, &out.ManagedRecords
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedRecordsCount != nil {
+		in, out := &in.ManagedRecordsCount, &out.ManagedRecordsCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Nsec3Params != nil {
+		in, out := &in.Nsec3Params, &out.Nsec3Params
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastNotifyTime != nil {
+		in, out := &in.LastNotifyTime, &out.LastNotifyTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(ZoneExport)
+		**out = **in
+	}
+	if in.NameserverGlue != nil {
+		in, out := &in.NameserverGlue, &out.NameserverGlue
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.CatalogMembers != nil {
+		in, out := &in.CatalogMembers, &out.CatalogMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureCount != nil {
+		in, out := &in.FailureCount, &out.FailureCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
+	}
 	if in.SyncStatus != nil {
 		in, out := &in.SyncStatus, &out.SyncStatus
 		*out = new(string)
@@ -14,22 +14,25 @@ package main
 import (
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"flag"
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
+	uberzap "go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -40,6 +43,7 @@ import (
 
 	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
 	"github.com/powerdns-operator/powerdns-operator/internal/controller"
+	webhookdnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/internal/webhook/v1alpha2"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -62,13 +66,28 @@ func main() {
 	var webhookCertPath, webhookCertName, webhookCertKey string
 	var enableLeaderElection bool
 	var probeAddr string
+	var pprofAddr string
+	var enableWriteCanary bool
+	var dryRun bool
+	var requirePDNSOnStart bool
+	var allowLuaRecords bool
+	var logLevel string
+	var writeCanaryZone string
+	var writeCanaryInterval time.Duration
+	var statisticsInterval time.Duration
+	var statisticsNames string
+	var notifyWindow time.Duration
+	var rrsetBatchWindow time.Duration
+	var enableWebhooks bool
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var apiTransport controller.PDNSTransportConfig
 	var tlsOpts []func(*tls.Config)
 
 	// Get environment variables for PowerDNS API configuration
 	apiURL := os.Getenv("PDNS_API_URL")
 	apiKey := os.Getenv("PDNS_API_KEY")
+	apiKeyFile := os.Getenv("PDNS_API_KEY_FILE")
 	apiVhost := os.Getenv("PDNS_API_VHOST")
 	if apiVhost == "" {
 		apiVhost = "localhost"
@@ -81,6 +100,63 @@ func main() {
 		}
 	}
 	apiCAPath := os.Getenv("PDNS_API_CA_PATH")
+	apiClientCertPath := os.Getenv("PDNS_API_CLIENT_CERT_PATH")
+	apiClientKeyPath := os.Getenv("PDNS_API_CLIENT_KEY_PATH")
+	apiTLSMinVersion := os.Getenv("PDNS_API_TLS_MIN_VERSION")
+	apiTLSCipherSuites := os.Getenv("PDNS_API_TLS_CIPHER_SUITES")
+	apiAllowedZoneSuffixesStr := os.Getenv("PDNS_API_ALLOWED_ZONE_SUFFIXES")
+	defaultTTLByTypeStr := os.Getenv("PDNS_DEFAULT_TTL_BY_TYPE")
+	zoneSuffixPolicyStr := os.Getenv("PDNS_ZONE_SUFFIX_POLICY")
+	enableWebhooks = os.Getenv("ENABLE_WEBHOOKS") == "true"
+
+	// Parse retry backoff of failed resources from environment variables (durations, e.g. "30s")
+	var retryBackoff controller.RetryBackoff
+	if retryBase, err := time.ParseDuration(os.Getenv("PDNS_RETRY_BASE")); err == nil {
+		retryBackoff.Base = retryBase
+	}
+	retryBackoff.Max = 10 * time.Minute
+	if retryMax, err := time.ParseDuration(os.Getenv("PDNS_RETRY_MAX")); err == nil {
+		retryBackoff.Max = retryMax
+	}
+
+	// Parse the finalizer timeout of deleted resources from environment variable (duration, e.g. "1h")
+	var finalizerTimeout time.Duration
+	if timeout, err := time.ParseDuration(os.Getenv("PDNS_FINALIZER_TIMEOUT")); err == nil {
+		finalizerTimeout = timeout
+	}
+
+	// Parse default resynchronization interval from environment variable (duration, e.g. "10m")
+	var syncInterval time.Duration
+	if interval, err := time.ParseDuration(os.Getenv("PDNS_SYNC_INTERVAL")); err == nil {
+		syncInterval = interval
+	}
+
+	// Parse the window spreading the first reconciles after startup from environment variable (duration, e.g. "30s")
+	startupJitterWindow := 30 * time.Second
+	if window, err := time.ParseDuration(os.Getenv("PDNS_STARTUP_JITTER_WINDOW")); err == nil {
+		startupJitterWindow = window
+	}
+
+	// Parse the workqueue rate limiters of the controllers from environment variables (durations, e.g. "10ms")
+	rateLimiter := controller.RateLimiter{
+		BaseDelay: controller.DEFAULT_RATE_LIMITER_BASE_DELAY,
+		MaxDelay:  controller.DEFAULT_RATE_LIMITER_MAX_DELAY,
+	}
+	if baseDelay, err := time.ParseDuration(os.Getenv("PDNS_RATE_LIMITER_BASE_DELAY")); err == nil {
+		rateLimiter.BaseDelay = baseDelay
+	}
+	if maxDelay, err := time.ParseDuration(os.Getenv("PDNS_RATE_LIMITER_MAX_DELAY")); err == nil {
+		rateLimiter.MaxDelay = maxDelay
+	}
+	rateLimiterByControllerStr := os.Getenv("PDNS_RATE_LIMITER_BY_CONTROLLER")
+
+	// Parse TTL policy of the RRsets from environment variables (in seconds), enforced by the webhooks
+	var ttlPolicy webhookdnsv1alpha2.TTLPolicy
+	for env, value := range map[string]*uint32{"PDNS_TTL_MIN": &ttlPolicy.Min, "PDNS_TTL_MAX": &ttlPolicy.Max, "PDNS_TTL_DEFAULT": &ttlPolicy.Default} {
+		if ttl, err := strconv.ParseUint(os.Getenv(env), 10, 32); err == nil {
+			*value = uint32(ttl)
+		}
+	}
 
 	// Parse PowerDNS API timeout from environment variable (in seconds)
 	apiTimeoutStr := os.Getenv("PDNS_API_TIMEOUT")
@@ -94,6 +170,36 @@ func main() {
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.StringVar(&pprofAddr, "pprof-bind-address", "0", "The address the pprof profiling endpoint binds to. "+
+		"Use a local address such as 127.0.0.1:6060, or leave as 0 to disable the profiling endpoint.")
+	flag.BoolVar(&dryRun, "dry-run", false,
+		"If set, no change is made on PowerDNS: the changes are only logged and reported in the status of the resources.")
+	flag.BoolVar(&dryRun, "read-only", false, "Alias of --dry-run.")
+	flag.BoolVar(&allowLuaRecords, "allow-lua-records", false,
+		"If set, RRsets of type LUA are allowed. LUA records run code on the PowerDNS server, they are rejected otherwise.")
+	flag.BoolVar(&requirePDNSOnStart, "require-pdns-on-start", false,
+		"If set, the operator exits when PowerDNS API is not reachable at startup. Otherwise it starts anyway, "+
+			"is reported as not ready and retries the connection in the background.")
+	flag.BoolVar(&enableWriteCanary, "enable-write-canary", false,
+		"If set, a canary TXT record is periodically created and deleted in --write-canary-zone "+
+			"to verify the PowerDNS API credentials allow writes.")
+	flag.StringVar(&writeCanaryZone, "write-canary-zone", "", "The existing zone in which the canary record is written.")
+	flag.DurationVar(&writeCanaryInterval, "write-canary-interval", 5*time.Minute, "The interval between two canary writes.")
+	flag.DurationVar(&statisticsInterval, "pdns-statistics-interval", 0,
+		"The interval between two fetches of the PowerDNS server statistics, exposed as pdns_server_statistic metrics. "+
+			"0 disables it.")
+	flag.StringVar(&statisticsNames, "pdns-statistics", "",
+		"Comma-separated list of the PowerDNS server statistics exposed (e.g. udp-queries,packetcache-hit), all when empty.")
+	flag.Func("ttl-min", "The lowest TTL, in seconds, accepted by the RRset webhooks (0 for no bound).", parseTTLFlag(&ttlPolicy.Min))
+	flag.Func("ttl-max", "The highest TTL, in seconds, accepted by the RRset webhooks (0 for no bound).", parseTTLFlag(&ttlPolicy.Max))
+	flag.Func("ttl-default", "The TTL, in seconds, set by the RRset webhooks on RRsets without TTL "+
+		"(0 to inherit the zone default).", parseTTLFlag(&ttlPolicy.Default))
+	flag.DurationVar(&notifyWindow, "notify-window", 5*time.Second,
+		"The window during which NOTIFY of a zone with notifyOnChange are coalesced after RRsets changes.")
+	flag.DurationVar(&rrsetBatchWindow, "rrset-batch-window", 0,
+		"The window during which RRsets changes of a zone are grouped into a single PowerDNS API call. 0 disables batching.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", enableWebhooks,
+		"If set, the validating admission webhooks are served (requires the webhook certificates).")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
@@ -108,25 +214,92 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
-	flag.StringVar(&apiURL, "pdns-api-url", apiURL, "The URL of the PowerDNS API")
+	flag.StringVar(&apiURL, "pdns-api-url", apiURL,
+		"The URL of the PowerDNS API, or a comma-separated list of URLs of the same API tried in order when unreachable")
 	flag.StringVar(&apiKey, "pdns-api-key", apiKey, "The API key to authenticate with the PowerDNS API")
+	flag.StringVar(&apiKeyFile, "pdns-api-key-file", apiKeyFile,
+		"The path to a file holding the API key to authenticate with the PowerDNS API, read again when it changes")
 	flag.StringVar(&apiVhost, "pdns-api-vhost", apiVhost, "The vhost of the PowerDNS API")
+	flag.StringVar(&apiAllowedZoneSuffixesStr, "pdns-api-allowed-zone-suffixes", apiAllowedZoneSuffixesStr,
+		"Comma-separated list of the zone suffixes the PowerDNS API key is scoped to (e.g. a.example.com,b.example.com): "+
+			"Zones and ClusterZones outside of them are not created. Empty allows any zone.")
 	flag.IntVar(&apiTimeoutSeconds, "pdns-api-timeout", apiTimeoutSeconds,
 		"The timeout for PowerDNS API requests (in seconds)")
 	flag.BoolVar(&apiInsecure, "pdns-api-insecure", apiInsecure,
 		"Enable insecure connections to PowerDNS API")
 	flag.StringVar(&apiCAPath, "pdns-api-ca-path", apiCAPath, "The path to certificate authority")
-
+	flag.StringVar(&apiClientCertPath, "pdns-api-client-cert-path", apiClientCertPath,
+		"The path to the client certificate presented to PowerDNS API (mutual TLS)")
+	flag.StringVar(&apiClientKeyPath, "pdns-api-client-key-path", apiClientKeyPath,
+		"The path to the key of the client certificate presented to PowerDNS API (mutual TLS)")
+	flag.StringVar(&apiTLSMinVersion, "pdns-api-tls-min-version", apiTLSMinVersion,
+		"The minimum TLS version accepted by PowerDNS API connection (1.2 or 1.3)")
+	flag.StringVar(&apiTLSCipherSuites, "pdns-api-tls-cipher-suites", apiTLSCipherSuites,
+		"Comma-separated list of cipher suites accepted by PowerDNS API connection")
+	flag.IntVar(&apiTransport.MaxIdleConnsPerHost, "pdns-api-max-idle-conns", 10,
+		"The number of idle (keep-alive) connections kept with each PowerDNS API endpoint")
+	flag.DurationVar(&apiTransport.IdleConnTimeout, "pdns-api-idle-conn-timeout", 90*time.Second,
+		"The time an idle connection with PowerDNS API is kept before being closed (0 for no limit)")
+	flag.BoolVar(&apiTransport.HTTP2, "pdns-api-http2", false,
+		"If set, HTTP/2 is used with PowerDNS API when supported (TLS only)")
+	flag.StringVar(&defaultTTLByTypeStr, "default-ttl-by-type", defaultTTLByTypeStr,
+		"Comma-separated list of TYPE=TTL pairs used as default TTL of RRsets without TTL (e.g. A=60,NS=86400). "+
+			"\"*\" applies to the other types, 3600 otherwise.")
+	flag.StringVar(&zoneSuffixPolicyStr, "zone-suffix-policy", zoneSuffixPolicyStr,
+		"Comma-separated list of NAMESPACE=SUFFIX pairs restricting the domains of the Zones and RRsets of a namespace, "+
+			"enforced by the webhooks (e.g. team-a=a.example.com,team-b=b.example.com). \"*\" applies to the other namespaces.")
+	flag.DurationVar(&retryBackoff.Base, "retry-base-delay", retryBackoff.Base,
+		"The initial delay before retrying the synchronization of a failed resource, doubled on each consecutive failure. "+
+			"0 disables the retries: failed resources are only reconciled again when modified.")
+	flag.DurationVar(&retryBackoff.Max, "retry-max-delay", retryBackoff.Max,
+		"The maximum delay between two retries of the synchronization of a failed resource.")
+	flag.DurationVar(&finalizerTimeout, "finalizer-timeout", finalizerTimeout,
+		"The delay after which the finalizer of a deleted Zone or RRset is removed even if it cannot be deleted from PowerDNS "+
+			"(e.g. PowerDNS permanently unreachable), leaving its records in PowerDNS. 0 disables it: the deletion waits for PowerDNS.")
+	flag.DurationVar(&syncInterval, "sync-interval", syncInterval,
+		"The default interval of the periodic resynchronization of Zones and RRsets with PowerDNS, "+
+			"correcting changes made out-of-band. 0 disables it: resources are only reconciled on Kubernetes events.")
+	flag.DurationVar(&startupJitterWindow, "startup-jitter-window", startupJitterWindow,
+		"The window over which the first reconciles of the Zones and RRsets already synchronized are spread after startup, "+
+			"so that a restart does not send their PowerDNS API calls at once. 0 disables it.")
+	flag.DurationVar(&rateLimiter.BaseDelay, "rate-limiter-base-delay", rateLimiter.BaseDelay,
+		"The delay before requeuing a failed reconcile, doubled on each consecutive failure of the same resource.")
+	flag.DurationVar(&rateLimiter.MaxDelay, "rate-limiter-max-delay", rateLimiter.MaxDelay,
+		"The maximum delay before requeuing a failed reconcile.")
+	flag.StringVar(&rateLimiterByControllerStr, "rate-limiter-by-controller", rateLimiterByControllerStr,
+		"Comma-separated list of CONTROLLER=BASE:MAX requeue delays of the failed reconciles overriding "+
+			"--rate-limiter-base-delay and --rate-limiter-max-delay for a controller (e.g. zone=10ms:5m,rrset=50ms:10m).")
+
+	flag.StringVar(&logLevel, "log-level", "",
+		"The log level: debug, info, error or a verbosity (e.g. 2). It can be changed at runtime on the secured "+
+			"/log-level endpoint of the metrics server. Takes precedence over --zap-log-level.")
 	opts := zap.Options{
 		Development: false,
 	}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
+	// The log level is atomic so that it can be changed at runtime, starting from --zap-log-level if set
+	atomicLevel := uberzap.NewAtomicLevelAt(zapcore.InfoLevel)
+	if opts.Development {
+		atomicLevel.SetLevel(zapcore.DebugLevel)
+	}
+	if level, ok := opts.Level.(uberzap.AtomicLevel); ok {
+		atomicLevel = level
+	}
+	opts.Level = atomicLevel
 	ctrl.SetLogger(zap.New(
 		zap.UseFlagOptions(&opts),
 		zap.StacktraceLevel(zapcore.PanicLevel), // Only stacktrace at panic level
 	))
+	if logLevel != "" {
+		level, err := controller.ParseLogLevel(logLevel)
+		if err != nil {
+			setupLog.Error(err, "Invalid --log-level flag")
+			os.Exit(1)
+		}
+		atomicLevel.SetLevel(level)
+	}
 
 	// Validate mandatory configuration
 	if apiURL == "" {
@@ -135,11 +308,54 @@ func main() {
 	}
 	setupLog.Info("PowerDNS API URL", "url", apiURL)
 
-	if apiKey == "" {
-		setupLog.Error(nil, "PDNS_API_KEY environment variable or --pdns-api-key flag is required")
+	if (apiKey == "") == (apiKeyFile == "") {
+		setupLog.Error(nil, "exactly one of PDNS_API_KEY/--pdns-api-key or PDNS_API_KEY_FILE/--pdns-api-key-file is required")
 		os.Exit(1)
 	}
+	if apiKeyFile != "" {
+		key, err := controller.ReadAPIKeyFile(apiKeyFile)
+		if err != nil {
+			setupLog.Error(err, "unable to read the PowerDNS API key")
+			os.Exit(1)
+		}
+		apiKey = key
+		setupLog.Info("PowerDNS API key read from file", "apiKeyFile", apiKeyFile)
+	}
 	setupLog.Info("PowerDNS API vhost", "vhost", apiVhost)
+	var apiAllowedZoneSuffixes []string
+	for _, suffix := range strings.Split(apiAllowedZoneSuffixesStr, ",") {
+		if suffix = strings.TrimSpace(suffix); suffix != "" {
+			apiAllowedZoneSuffixes = append(apiAllowedZoneSuffixes, suffix)
+		}
+	}
+	if len(apiAllowedZoneSuffixes) > 0 {
+		setupLog.Info("Zones created on the PowerDNS API are restricted", "allowedZoneSuffixes", apiAllowedZoneSuffixes)
+	}
+
+	defaultTTLByType, err := controller.ParseTTLByType(defaultTTLByTypeStr)
+	if err != nil {
+		setupLog.Error(err, "invalid --default-ttl-by-type value")
+		os.Exit(1)
+	}
+	rateLimiterByController, err := controller.ParseRateLimiterByController(rateLimiterByControllerStr, rateLimiter)
+	if err != nil {
+		setupLog.Error(err, "invalid rate limiter configuration")
+		os.Exit(1)
+	}
+	zoneSuffixPolicy, err := webhookdnsv1alpha2.ParseZoneSuffixPolicy(zoneSuffixPolicyStr)
+	if err != nil {
+		setupLog.Error(err, "invalid --zone-suffix-policy value")
+		os.Exit(1)
+	}
+	if len(zoneSuffixPolicy) > 0 && !enableWebhooks {
+		setupLog.Info("the zone suffix policy is only enforced with webhooks enabled")
+	}
+
+	// The profiling endpoint is never served alongside the metrics endpoint
+	if pprofAddr != "0" && pprofAddr != "" && pprofAddr == metricsAddr {
+		setupLog.Error(nil, "--pprof-bind-address must differ from --metrics-bind-address")
+		os.Exit(1)
+	}
 
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
@@ -177,10 +393,17 @@ func main() {
 	// More info:
 	// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.23.3/pkg/metrics/server
 	// - https://book.kubebuilder.io/reference/metrics.html
+	// GET returns the current log level, PUT changes it, e.g. {"level":"debug"}. The level can only be changed
+	// when the metrics server authenticates and authorizes the callers, it is otherwise read-only
+	logLevelHandler := http.Handler(atomicLevel)
+	if !secureMetrics {
+		logLevelHandler = readOnlyHandler(atomicLevel)
+	}
 	metricsServerOptions := metricsserver.Options{
 		BindAddress:   metricsAddr,
 		SecureServing: secureMetrics,
 		TLSOpts:       tlsOpts,
+		ExtraHandlers: map[string]http.Handler{"/log-level": logLevelHandler},
 	}
 
 	if secureMetrics {
@@ -213,8 +436,16 @@ func main() {
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
+		PprofBindAddress:       pprofAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "6bc048b3.cav.enablers.ob",
+		// The Secrets are read from the Kubernetes API rather than cached, the TSIGKey controller
+		// only watches the metadata of the Secrets
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				DisableFor: []client.Object{&corev1.Secret{}},
+			},
+		},
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -233,90 +464,282 @@ func main() {
 	}
 
 	// Initialize a http.Client to communicate with PowerDNS API
-	var httpClient *http.Client
-	tlsConfig := &tls.Config{
-		InsecureSkipVerify: apiInsecure,
-	}
 	if apiInsecure {
 		setupLog.Info("the communication with PowerDNS API is set as insecure")
 	}
-
+	var cipherSuites []string
+	if apiTLSCipherSuites != "" {
+		cipherSuites = strings.Split(apiTLSCipherSuites, ",")
+	}
+	// Several PowerDNS API endpoints can be given, the next one is used when the active one is unreachable
+	apiEndpoints := strings.Split(apiURL, ",")
+	httpClient, err := controller.NewHTTPClient(controller.PDNSHTTPConfig{
+		TLS: controller.PDNSTLSConfig{
+			Insecure:     apiInsecure,
+			CAPath:       apiCAPath,
+			MinVersion:   apiTLSMinVersion,
+			CipherSuites: cipherSuites,
+			CertPath:     apiClientCertPath,
+			KeyPath:      apiClientKeyPath,
+		},
+		Transport:  apiTransport,
+		Endpoints:  apiEndpoints,
+		APIKeyFile: apiKeyFile,
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to configure the connection with PowerDNS API")
+		os.Exit(1)
+	}
 	if apiCAPath != "" {
-		caCert, err := os.ReadFile(apiCAPath)
-		if err != nil {
-			setupLog.Error(err, "unable to load CA certificate")
-			os.Exit(1)
-		}
-		caCertPool := x509.NewCertPool()
-		ok := caCertPool.AppendCertsFromPEM(caCert)
-		if !ok {
-			setupLog.Error(err, "unable to parse CA certificate")
-			os.Exit(1)
-		}
 		setupLog.Info("CA certificate parsed successfully", "apiCAPath", apiCAPath)
-		tlsConfig.RootCAs = caCertPool
+	}
+	if apiTLSMinVersion != "" {
+		setupLog.Info("PowerDNS API TLS minimum version", "version", apiTLSMinVersion)
+	}
+	if apiClientCertPath != "" {
+		setupLog.Info("PowerDNS API client certificate loaded", "apiClientCertPath", apiClientCertPath)
+	}
+	if len(apiEndpoints) > 1 {
+		setupLog.Info("PowerDNS API endpoints failover enabled", "endpoints", apiEndpoints)
 	}
 
-	tr := &http.Transport{TLSClientConfig: tlsConfig}
-	httpClient = &http.Client{Transport: tr}
-
-	pdnsClient, err := PDNSClientInitializer(apiURL, apiKey, apiVhost, apiTimeoutSeconds,
-		httpClient)
+	pdnsClient, err := PDNSClientInitializer(strings.TrimSpace(apiEndpoints[0]), apiKey, apiVhost, apiTimeoutSeconds,
+		httpClient, requirePDNSOnStart)
 	if err != nil {
 		setupLog.Error(err, "unable to initialize connection with PowerDNS server")
 		os.Exit(1)
 	}
-	if err = (&controller.ZoneReconciler{
+
+	// Record the duration and the errors of PowerDNS API calls in Prometheus metrics
+	pdnsAPI := controller.NewInstrumentedPdnsClienter(controller.PdnsClienter{
+		Records:    pdnsClient.Records,
+		Zones:      controller.NewZonesClient(pdnsClient, apiKey, httpClient),
+		Cryptokeys: controller.NewCryptokeysClient(pdnsClient, apiKey, httpClient),
+		Metadata:   pdnsClient.Metadata,
+		TSIGKeys:   pdnsClient.TSIGKeys,
+		Statistics: pdnsClient.Statistics,
+	})
+	if dryRun {
+		setupLog.Info("dry-run mode enabled, no change is made on PowerDNS")
+		pdnsAPI = controller.NewDryRunPdnsClienter(pdnsAPI)
+	}
+	// RRsets changes are optionally grouped by zone to reduce PowerDNS API calls
+	rrsetRecords := pdnsAPI.Records
+	if rrsetBatchWindow > 0 {
+		setupLog.Info("RRsets changes are batched", "window", rrsetBatchWindow)
+		rrsetRecords = &controller.RecordsBatcher{Records: pdnsAPI.Records, Window: rrsetBatchWindow}
+	}
+	zoneNotifier := &controller.ZoneNotifier{
 		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
 		PDNSClient: controller.PdnsClienter{
-			Records: pdnsClient.Records,
-			Zones:   pdnsClient.Zones,
+			Zones: pdnsAPI.Zones,
+		},
+		Window: notifyWindow,
+	}
+	if err = (&controller.ZoneReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorder("zone-controller"),
+		RetryBackoff:     retryBackoff,
+		FinalizerTimeout: finalizerTimeout,
+		SyncInterval:     syncInterval,
+		StartupJitter:    controller.NewStartupJitter(startupJitterWindow),
+		PDNSClient: controller.PdnsClienter{
+			Records:  pdnsAPI.Records,
+			Zones:    pdnsAPI.Zones,
+			Metadata: pdnsAPI.Metadata,
 		},
+		RateLimiter:         rateLimiterByController[controller.ZONE_CONTROLLER_NAME],
+		AllowedZoneSuffixes: apiAllowedZoneSuffixes,
+		DefaultTTLByType:    defaultTTLByType,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Zone")
 		os.Exit(1)
 	}
 	if err = (&controller.RRsetReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorder("rrset-controller"),
+		RetryBackoff:     retryBackoff,
+		FinalizerTimeout: finalizerTimeout,
+		SyncInterval:     syncInterval,
+		StartupJitter:    controller.NewStartupJitter(startupJitterWindow),
 		PDNSClient: controller.PdnsClienter{
-			Records: pdnsClient.Records,
-			Zones:   pdnsClient.Zones,
+			Records:  rrsetRecords,
+			Zones:    pdnsAPI.Zones,
+			Metadata: pdnsAPI.Metadata,
 		},
+		DefaultTTLByType: defaultTTLByType,
+		AllowLuaRecords:  allowLuaRecords,
+		Notifier:         zoneNotifier,
+		RateLimiter:      rateLimiterByController[controller.RRSET_CONTROLLER_NAME],
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "RRset")
 		os.Exit(1)
 	}
 	if err = (&controller.ClusterZoneReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorder("clusterzone-controller"),
+		RetryBackoff:     retryBackoff,
+		FinalizerTimeout: finalizerTimeout,
+		SyncInterval:     syncInterval,
+		StartupJitter:    controller.NewStartupJitter(startupJitterWindow),
 		PDNSClient: controller.PdnsClienter{
-			Records: pdnsClient.Records,
-			Zones:   pdnsClient.Zones,
+			Records:  pdnsAPI.Records,
+			Zones:    pdnsAPI.Zones,
+			Metadata: pdnsAPI.Metadata,
 		},
+		RateLimiter:         rateLimiterByController[controller.CLUSTERZONE_CONTROLLER_NAME],
+		AllowedZoneSuffixes: apiAllowedZoneSuffixes,
+		DefaultTTLByType:    defaultTTLByType,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ClusterZone")
 		os.Exit(1)
 	}
 	if err = (&controller.ClusterRRsetReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorder("clusterrrset-controller"),
+		RetryBackoff:     retryBackoff,
+		FinalizerTimeout: finalizerTimeout,
+		SyncInterval:     syncInterval,
+		StartupJitter:    controller.NewStartupJitter(startupJitterWindow),
+		PDNSClient: controller.PdnsClienter{
+			Records:  rrsetRecords,
+			Zones:    pdnsAPI.Zones,
+			Metadata: pdnsAPI.Metadata,
+		},
+		DefaultTTLByType: defaultTTLByType,
+		AllowLuaRecords:  allowLuaRecords,
+		Notifier:         zoneNotifier,
+		RateLimiter:      rateLimiterByController[controller.CLUSTERRRSET_CONTROLLER_NAME],
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterRRset")
+		os.Exit(1)
+	}
+	if err = (&controller.CryptokeyReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
 		PDNSClient: controller.PdnsClienter{
-			Records: pdnsClient.Records,
-			Zones:   pdnsClient.Zones,
+			Records:    pdnsAPI.Records,
+			Zones:      pdnsAPI.Zones,
+			Cryptokeys: pdnsAPI.Cryptokeys,
+			Metadata:   pdnsAPI.Metadata,
 		},
+		RateLimiter: rateLimiterByController[controller.CRYPTOKEY_CONTROLLER_NAME],
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ClusterRRset")
+		setupLog.Error(err, "unable to create controller", "controller", "Cryptokey")
 		os.Exit(1)
 	}
+	if err = (&controller.TSIGKeyReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		APIReader: mgr.GetAPIReader(),
+		PDNSClient: controller.PdnsClienter{
+			TSIGKeys: pdnsAPI.TSIGKeys,
+		},
+		RateLimiter: rateLimiterByController[controller.TSIGKEY_CONTROLLER_NAME],
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TSIGKey")
+		os.Exit(1)
+	}
+	if err = (&controller.DNSSECMaintenanceReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		PDNSClient: controller.PdnsClienter{
+			Zones:      pdnsAPI.Zones,
+			Cryptokeys: pdnsAPI.Cryptokeys,
+		},
+		RateLimiter: rateLimiterByController[controller.DNSSEC_MAINTENANCE_CONTROLLER_NAME],
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DNSSECMaintenance")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err = ttlPolicy.Validate(); err != nil {
+			setupLog.Error(err, "invalid TTL policy")
+			os.Exit(1)
+		}
+		if err = webhookdnsv1alpha2.SetupRRsetWebhookWithManager(mgr, ttlPolicy, zoneSuffixPolicy, allowLuaRecords); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "RRset")
+			os.Exit(1)
+		}
+		if err = webhookdnsv1alpha2.SetupClusterRRsetWebhookWithManager(mgr, ttlPolicy, allowLuaRecords); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ClusterRRset")
+			os.Exit(1)
+		}
+		if err = webhookdnsv1alpha2.SetupZoneWebhookWithManager(mgr, zoneSuffixPolicy); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Zone")
+			os.Exit(1)
+		}
+		if err = webhookdnsv1alpha2.SetupClusterZoneWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ClusterZone")
+			os.Exit(1)
+		}
+	}
+	if dryRun {
+		// The operator Pod is given by the downward API (see config/manager/manager.yaml), its name defaults to the hostname
+		podName := os.Getenv("POD_NAME")
+		if podName == "" {
+			podName, _ = os.Hostname()
+		}
+		if err = (&controller.DryRunNotice{
+			Recorder:     mgr.GetEventRecorder("powerdns-operator"),
+			PodName:      podName,
+			PodNamespace: controller.GetOperatorNamespace(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to set up dry-run notice")
+			os.Exit(1)
+		}
+	}
+	if enableWriteCanary && dryRun {
+		setupLog.Info("the PowerDNS API write canary is disabled in dry-run mode")
+	}
+	if enableWriteCanary && !dryRun {
+		if err = (&controller.WriteCanary{
+			PDNSClient: controller.PdnsClienter{
+				Records: pdnsAPI.Records,
+				Zones:   pdnsAPI.Zones,
+			},
+			Zone:     writeCanaryZone,
+			Interval: writeCanaryInterval,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to set up PowerDNS API write canary")
+			os.Exit(1)
+		}
+	}
+	if statisticsInterval > 0 {
+		var names []string
+		for _, name := range strings.Split(statisticsNames, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+		if err = (&controller.ServerStatistics{
+			PDNSClient: controller.PdnsClienter{
+				Statistics: pdnsAPI.Statistics,
+			},
+			Names:    names,
+			Interval: statisticsInterval,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to set up PowerDNS server statistics")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
 	}
-	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+	// Readiness reflects the connectivity with PowerDNS API, liveness is a simple ping
+	pdnsReadinessCheck := &controller.PDNSReadinessCheck{
+		Servers: pdnsClient.Servers,
+		VHost:   apiVhost,
+		Timeout: time.Duration(apiTimeoutSeconds) * time.Second,
+	}
+	if err := mgr.AddReadyzCheck("readyz", pdnsReadinessCheck.Check); err != nil {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
@@ -328,27 +751,79 @@ func main() {
 	}
 }
 
+// parseTTLFlag returns the parsing function of a TTL flag, in seconds
+func parseTTLFlag(ttl *uint32) func(string) error {
+	return func(value string) error {
+		parsed, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return err
+		}
+		*ttl = uint32(parsed)
+		return nil
+	}
+}
+
+// readOnlyHandler serves the GET requests with handler and rejects the other methods
+func readOnlyHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed, the metrics server is not secured (--metrics-secure)", http.StatusMethodNotAllowed)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// PDNSClientInitializer initializes the PowerDNS client and tests the connectivity with PowerDNS API.
+// When PowerDNS is not reachable, an error is returned if requireOnStart is set, otherwise the connectivity
+// test is retried in the background so that the operator starts during a PowerDNS outage.
 func PDNSClientInitializer(baseURL string, key string, vhost string, timeoutSeconds int,
-	httpClient *http.Client) (*powerdns.Client, error) {
+	httpClient *http.Client, requireOnStart bool) (*powerdns.Client, error) {
 	client := powerdns.New(baseURL, vhost, powerdns.WithAPIKey(key), powerdns.WithHTTPClient(httpClient))
 
-	// Test connectivity by attempting to get server information
+	err := testPDNSConnectivity(client, baseURL, vhost, timeoutSeconds)
+	if err == nil {
+		return client, nil
+	}
+	if requireOnStart {
+		return nil, err
+	}
+	setupLog.Error(err, "PowerDNS API is not reachable, starting anyway and retrying in the background")
+	go func() {
+		delay := 5 * time.Second
+		for err != nil {
+			time.Sleep(delay)
+			delay = min(2*delay, 5*time.Minute)
+			err = testPDNSConnectivity(client, baseURL, vhost, timeoutSeconds)
+		}
+	}()
+	return client, nil
+}
+
+// testPDNSConnectivity gets the PowerDNS server information and logs it
+func testPDNSConnectivity(client *powerdns.Client, baseURL string, vhost string, timeoutSeconds int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
 	server, err := client.Servers.Get(ctx, vhost)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Log server information for operational visibility
 	if server.Version != nil {
 		setupLog.Info("Connected to PowerDNS server", "version", *server.Version)
+		if err := controller.CheckPDNSVersion(*server.Version); err != nil {
+			setupLog.Info("Warning: "+err.Error(), "minimumVersion", controller.MIN_PDNS_VERSION)
+		}
 	}
 	if server.DaemonType != nil {
 		setupLog.Info("PowerDNS daemon type", "type", *server.DaemonType)
-		// Validate that we're connecting to an Authoritative server (not Recursor)
-		if *server.DaemonType != "authoritative" {
+		// Validate that we're connecting to an Authoritative server (not Recursor),
+		// Zones and RRsets report a NotAuthoritative condition otherwise
+		controller.SetPDNSDaemonType(*server.DaemonType)
+		if *server.DaemonType != controller.AUTHORITATIVE_DAEMON_TYPE {
 			setupLog.Info("Warning: PowerDNS Operator is designed for Authoritative servers", "daemon_type", *server.DaemonType)
 		}
 	}
@@ -359,5 +834,5 @@ func PDNSClientInitializer(baseURL string, key string, vhost string, timeoutSeco
 	// Log successful connection with key details
 	setupLog.Info("PowerDNS connectivity test successful", "url", baseURL, "vhost", vhost)
 
-	return client, nil
+	return nil
 }
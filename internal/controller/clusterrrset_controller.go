@@ -13,17 +13,24 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
 )
@@ -33,6 +40,24 @@ type ClusterRRsetReconciler struct {
 	client.Client
 	Scheme     *runtime.Scheme
 	PDNSClient PdnsClienter
+	Recorder   events.EventRecorder
+	// RetryBackoff defines when a resource in SynchronizationFailed status is synchronized again
+	RetryBackoff RetryBackoff
+	// FinalizerTimeout is the delay after which the finalizer of a deleted RRset is removed even if its records
+	// cannot be deleted from PowerDNS, 0 disables it
+	FinalizerTimeout time.Duration
+	// SyncInterval is the default interval of the periodic resynchronization with PowerDNS, 0 disables it
+	SyncInterval time.Duration
+	// StartupJitter spreads the first reconcile of the synchronized resources after the start of the operator
+	StartupJitter *StartupJitter
+	// DefaultTTLByType is the default TTL per record type, used when neither the RRset nor its Zone define a TTL
+	DefaultTTLByType map[string]uint32
+	// AllowLuaRecords enables the LUA records, RRsets of type LUA are rejected otherwise
+	AllowLuaRecords bool
+	// Notifier sends DNS NOTIFY on RRsets changes of zones with notifyOnChange, nil disables notifies
+	Notifier *ZoneNotifier
+	// RateLimiter defines the requeue delays of the failed reconciles, the controller-runtime default when zero
+	RateLimiter RateLimiter
 }
 
 func init() {
@@ -43,6 +68,7 @@ func init() {
 //+kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=clusterrrsets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=clusterrrsets/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=clusterrrsets/finalizers,verbs=update
+//+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 func (r *ClusterRRsetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
@@ -66,6 +92,14 @@ func (r *ClusterRRsetReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 	log.V(1).Info("ClusterRRset situation", "isModified", isModified, "isDeleted", isDeleted, "lastUpdateTime", lastUpdateTime)
 
+	// Resources already synchronized are reconciled progressively after the start of the operator
+	if !isModified && !isDeleted && rrset.Status.ObservedGeneration != nil {
+		if delay := r.StartupJitter.Delay(string(rrset.GetUID()), time.Now()); delay > 0 {
+			log.V(1).Info("Delaying first reconcile of ClusterRRset", "delay", delay)
+			return ctrl.Result{RequeueAfter: delay}, nil
+		}
+	}
+
 	// Position metrics finalizer as soon as possible
 	if !isDeleted {
 		log.V(1).Info("ClusterRRset not deleted", "RRset.Name", rrset.Name)
@@ -86,14 +120,18 @@ func (r *ClusterRRsetReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	original := rrset.DeepCopy()
 	// Ensure we update the status in case of early return
 	defer func() {
-		if err := r.Status().Patch(ctx, rrset, client.MergeFrom(original)); err != nil {
+		recordAvailableEvent(r.Recorder, rrset, original.Status.Conditions, rrset.Status.Conditions)
+		recordPausedEvent(r.Recorder, rrset, original.Status.Conditions, rrset.Status.Conditions)
+		recordCleanupAbandonedEvent(r.Recorder, rrset, original.Status.Conditions, rrset.Status.Conditions)
+		// A deleted RRset is gone once its finalizers are removed, e.g. short-lived ACME challenge records
+		if err := r.Status().Patch(ctx, rrset, client.MergeFrom(original)); client.IgnoreNotFound(err) != nil {
 			log.Error(err, "unable to patch ClusterRRSet status")
 		}
 	}()
 
 	// When updating a ClusterRRset, if 'Status' is not changed, 'LastTransitionTime' will not be updated
-	// So we delete condition to force new 'LastTransitionTime'
-	if !isDeleted && isModified {
+	// So we delete condition to force new 'LastTransitionTime', except for a paused resource which keeps its status
+	if !isDeleted && isModified && !isPaused(rrset) {
 		log.V(1).Info("Removing Available condition from ClusterRRset")
 		meta.RemoveStatusCondition(&rrset.Status.Conditions, "Available")
 	}
@@ -162,37 +200,98 @@ func (r *ClusterRRsetReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 		if isDeleted {
 			log.V(1).Info("ClusterRRset is deleted, removing metrics finalizer")
+			finalizerRemoved := false
 			if controllerutil.ContainsFinalizer(rrset, METRICS_FINALIZER_NAME) {
 				controllerutil.RemoveFinalizer(rrset, METRICS_FINALIZER_NAME)
 				// Remove resource metrics
 				removeRrsetMetrics(rrset)
+				finalizerRemoved = true
+			}
+			// The records cannot be deleted from a zone in failed status, the resources finalizer is kept until the finalizer timeout
+			if controllerutil.ContainsFinalizer(rrset, RESOURCES_FINALIZER_NAME) && finalizerTimedOut(rrset.DeletionTimestamp, r.FinalizerTimeout, time.Now()) {
+				log.Info("Finalizer timeout exceeded, removing finalizer without deleting the records from PowerDNS")
+				controllerutil.RemoveFinalizer(rrset, RESOURCES_FINALIZER_NAME)
+				rrset.SetCleanupAbandoned(fmt.Errorf("zone %s is in failed status", zone.GetName()))
+				finalizerRemoved = true
+			}
+			if finalizerRemoved {
 				if err := r.Update(ctx, rrset); err != nil {
 					log.Error(err, "Failed to remove finalizer")
 					return ctrl.Result{}, err
 				}
 			}
+			if controllerutil.ContainsFinalizer(rrset, RESOURCES_FINALIZER_NAME) && r.FinalizerTimeout > 0 {
+				return ctrl.Result{RequeueAfter: time.Until(rrset.DeletionTimestamp.Add(r.FinalizerTimeout))}, nil
+			}
 		}
 
 		return ctrl.Result{}, nil
 	}
 
-	return rrsetReconcile(ctx, rrset, zone, isModified, isDeleted, lastUpdateTime, r.Scheme, r.Client, r.PDNSClient, log)
+	result, synced, err := rrsetReconcile(ctx, rrset, zone, isModified, isDeleted, lastUpdateTime, r.DefaultTTLByType, r.AllowLuaRecords, r.Notifier, r.RetryBackoff, r.FinalizerTimeout, r.Scheme, r.Client, r.PDNSClient, log)
+	err = dryRunReconcile(rrset, err)
+	result, err = rateLimitedResult(result, err)
+	result = resyncResult(result, err, isDeleted, getSyncInterval(rrset.Spec.SyncInterval, r.SyncInterval))
+	return observeReconcile(CLUSTERRRSET_CONTROLLER_NAME, synced, result, err)
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClusterRRsetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// We use indexer to ensure that only one ClusterRRset/RRset exists for DNS entry
 	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &dnsv1alpha2.ClusterRRset{}, "ClusterRRset.Entry.Name", func(rawObj client.Object) []string {
-		// grab the ClusterRRset object, extract its name...
+		// grab the ClusterRRset object, extract its name(s), a dual-stack ClusterRRset has a DNS entry per address family
+		if rawObj.(*dnsv1alpha2.ClusterRRset).Status.SyncStatus == nil || *rawObj.(*dnsv1alpha2.ClusterRRset).Status.SyncStatus == dnsv1alpha2.SUCCEEDED_STATUS {
+			return getRRsetEntries(rawObj.(*dnsv1alpha2.ClusterRRset))
+		}
+		return []string{""}
+	}); err != nil {
+		return err
+	}
+	// We use indexer to find ClusterRRsets of any type with the same DNS entry (CNAME conflicts)
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &dnsv1alpha2.ClusterRRset{}, "ClusterRRset.Entry.FQDN", func(rawObj client.Object) []string {
 		var RRsetName string
 		if rawObj.(*dnsv1alpha2.ClusterRRset).Status.SyncStatus == nil || *rawObj.(*dnsv1alpha2.ClusterRRset).Status.SyncStatus == dnsv1alpha2.SUCCEEDED_STATUS {
-			RRsetName = getRRsetName(rawObj.(*dnsv1alpha2.ClusterRRset)) + "/" + rawObj.(*dnsv1alpha2.ClusterRRset).Spec.Type
+			RRsetName = getRRsetName(rawObj.(*dnsv1alpha2.ClusterRRset))
 		}
 		return []string{RRsetName}
 	}); err != nil {
 		return err
 	}
+	// We use indexer to find ClusterRRsets related to a Zone/ClusterZone
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &dnsv1alpha2.ClusterRRset{}, "ClusterRRset.ZoneRef", func(rawObj client.Object) []string {
+		return []string{dnsv1alpha2.ZoneRefKey(rawObj.(*dnsv1alpha2.ClusterRRset).Spec.ZoneRef)}
+	}); err != nil {
+		return err
+	}
+	// We use indexer to find ClusterRRsets which may be overridden by a RRset with the same DNS entry, whatever their status
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &dnsv1alpha2.ClusterRRset{}, "ClusterRRset.Entry.Override", func(rawObj client.Object) []string {
+		if ptr.Deref(rawObj.(*dnsv1alpha2.ClusterRRset).Spec.AllowNamespaceOverride, false) {
+			return getRRsetEntries(rawObj.(*dnsv1alpha2.ClusterRRset))
+		}
+		return []string{""}
+	}); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&dnsv1alpha2.ClusterRRset{}).
+		Watches(&dnsv1alpha2.RRset{}, handler.EnqueueRequestsFromMapFunc(r.findOverriddenClusterRRsets), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(r.RateLimiter.controllerOptions()).
 		Complete(r)
 }
+
+// findOverriddenClusterRRsets returns the ClusterRRsets which may be overridden by the RRset, so that they are
+// suppressed when the RRset is created, and restored when it is deleted
+func (r *ClusterRRsetReconciler) findOverriddenClusterRRsets(ctx context.Context, rrset client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, key := range getRRsetEntries(rrset.(*dnsv1alpha2.RRset)) {
+		var clusterRRsets dnsv1alpha2.ClusterRRsetList
+		if err := r.List(ctx, &clusterRRsets, client.MatchingFields{"ClusterRRset.Entry.Override": key}); err != nil {
+			log.FromContext(ctx).Error(err, "unable to find ClusterRRsets related to the RRset", "RRset.Name", rrset.GetName())
+			return nil
+		}
+		for _, clusterRRset := range clusterRRsets.Items {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&clusterRRset)})
+		}
+	}
+	return requests
+}
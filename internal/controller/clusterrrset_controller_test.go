@@ -19,7 +19,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -240,4 +242,92 @@ var _ = Describe("ClusterRRset Controller", func() {
 			}, timeout, interval).Should(BeTrue())
 		})
 	})
+
+	Context("When creating a RRset overriding a ClusterRRset with same FQDN", func() {
+		It("should suppress the ClusterRRset until the RRset is deleted", Label("rrset-creation", "override-clusterrrset"), func() {
+			ctx := context.Background()
+			// Specific test variables
+			overrideResourceName := "override.example6.org"
+			overrideResourceNamespace := "example6"
+			overrideResourceDNSName := "override"
+			clusterRecords := []string{"127.0.0.21"}
+			namespaceRecords := []string{"127.0.0.22"}
+			DnsFqdn := overrideResourceDNSName + "." + zoneName + "."
+			clusterLookupKey := types.NamespacedName{Name: overrideResourceName}
+			namespaceLookupKey := types.NamespacedName{Name: overrideResourceName, Namespace: overrideResourceNamespace}
+
+			By("Creating a ClusterRRset allowing namespace override")
+			clusterResource := &dnsv1alpha2.ClusterRRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: overrideResourceName,
+				},
+			}
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, clusterResource, func() error {
+				clusterResource.Spec = dnsv1alpha2.RRsetSpec{
+					Type:                   resourceType,
+					Name:                   overrideResourceDNSName,
+					TTL:                    resourceTTL,
+					Records:                clusterRecords,
+					AllowNamespaceOverride: ptr.To(true),
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneRef,
+						Kind: resourceZoneKind,
+					},
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, clusterLookupKey, clusterResource)
+				return err == nil && clusterResource.IsInExpectedStatus(FIRST_GENERATION, dnsv1alpha2.SUCCEEDED_STATUS, metav1.ConditionTrue)
+			}, timeout, interval).Should(BeTrue())
+			Expect(getMockedRecordsForType(DnsFqdn, resourceType)).To(Equal(clusterRecords))
+
+			By("Creating a RRset with the same FQDN")
+			namespaceResource := &dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      overrideResourceName,
+					Namespace: overrideResourceNamespace,
+				},
+			}
+			_, err = controllerutil.CreateOrUpdate(ctx, k8sClient, namespaceResource, func() error {
+				namespaceResource.Spec = dnsv1alpha2.RRsetSpec{
+					Type:    resourceType,
+					Name:    overrideResourceDNSName,
+					TTL:     resourceTTL,
+					Records: namespaceRecords,
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneRef,
+						Kind: resourceZoneKind,
+					},
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, namespaceLookupKey, namespaceResource)
+				return err == nil && namespaceResource.IsInExpectedStatus(FIRST_GENERATION, dnsv1alpha2.SUCCEEDED_STATUS, metav1.ConditionTrue)
+			}, timeout, interval).Should(BeTrue())
+			Expect(meta.IsStatusConditionTrue(namespaceResource.Status.Conditions, dnsv1alpha2.OVERRIDES_CONDITION)).To(BeTrue(), "RRset should report the override")
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, clusterLookupKey, clusterResource)
+				condition := meta.FindStatusCondition(clusterResource.Status.Conditions, "Available")
+				return err == nil && condition != nil && condition.Reason == dnsv1alpha2.OVERRIDDEN_REASON
+			}, timeout, interval).Should(BeTrue(), "ClusterRRset should be reported as overridden")
+			Expect(getMockedRecordsForType(DnsFqdn, resourceType)).To(Equal(namespaceRecords))
+
+			By("Deleting the RRset")
+			Expect(k8sClient.Delete(ctx, namespaceResource)).To(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, clusterLookupKey, clusterResource)
+				return err == nil && ptr.Deref(clusterResource.Status.SyncStatus, "") == dnsv1alpha2.SUCCEEDED_STATUS
+			}, timeout, interval).Should(BeTrue(), "ClusterRRset should be restored")
+			Eventually(func() []string {
+				return getMockedRecordsForType(DnsFqdn, resourceType)
+			}, timeout, interval).Should(Equal(clusterRecords))
+
+			By("Deleting the ClusterRRset")
+			Expect(k8sClient.Delete(ctx, clusterResource)).To(Succeed())
+		})
+	})
 })
@@ -13,14 +13,23 @@ package controller
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
 )
@@ -30,6 +39,22 @@ type ClusterZoneReconciler struct {
 	client.Client
 	Scheme     *runtime.Scheme
 	PDNSClient PdnsClienter
+	Recorder   events.EventRecorder
+	// RetryBackoff defines when a resource in SynchronizationFailed status is synchronized again
+	RetryBackoff RetryBackoff
+	// FinalizerTimeout is the delay after which the finalizer of a deleted zone is removed even if it
+	// cannot be deleted from PowerDNS, 0 disables it
+	FinalizerTimeout time.Duration
+	// SyncInterval is the default interval of the periodic resynchronization with PowerDNS, 0 disables it
+	SyncInterval time.Duration
+	// StartupJitter spreads the first reconcile of the synchronized resources after the start of the operator
+	StartupJitter *StartupJitter
+	// AllowedZoneSuffixes restricts the zones created on the PowerDNS API to these suffixes and their subdomains, any zone when empty
+	AllowedZoneSuffixes []string
+	// DefaultTTLByType is the default TTL per record type, used to resolve the TTL of the RRsets which do not define one
+	DefaultTTLByType map[string]uint32
+	// RateLimiter defines the requeue delays of the failed reconciles, the controller-runtime default when zero
+	RateLimiter RateLimiter
 }
 
 func init() {
@@ -40,6 +65,7 @@ func init() {
 //+kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=clusterzones,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=clusterzones/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=clusterzones/finalizers,verbs=update
+//+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 func (r *ClusterZoneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
@@ -59,6 +85,14 @@ func (r *ClusterZoneReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	isDeleted := !zone.DeletionTimestamp.IsZero()
 	log.V(1).Info("ClusterZone situation", "isModified", isModified, "isDeleted", isDeleted)
 
+	// Resources already synchronized are reconciled progressively after the start of the operator
+	if !isModified && !isDeleted && zone.Status.ObservedGeneration != nil {
+		if delay := r.StartupJitter.Delay(string(zone.GetUID()), time.Now()); delay > 0 {
+			log.V(1).Info("Delaying first reconcile of ClusterZone", "delay", delay)
+			return ctrl.Result{RequeueAfter: delay}, nil
+		}
+	}
+
 	// Position metrics finalizer as soon as possible
 	if !isDeleted {
 		log.V(1).Info("ClusterZone not deleted", "ClusterZone.Name", zone.Name)
@@ -78,20 +112,27 @@ func (r *ClusterZoneReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	original := zone.DeepCopy()
 	// Ensure we update the status in case of early return
 	defer func() {
+		recordAvailableEvent(r.Recorder, zone, original.Status.Conditions, zone.Status.Conditions)
+		recordPausedEvent(r.Recorder, zone, original.Status.Conditions, zone.Status.Conditions)
+		recordCleanupAbandonedEvent(r.Recorder, zone, original.Status.Conditions, zone.Status.Conditions)
 		if err := r.Status().Patch(ctx, zone, client.MergeFrom(original)); err != nil {
 			log.Error(err, "unable to patch ClusterZone status")
 		}
 	}()
 
 	// When updating a ClusterZone, if 'Status' is not changed, 'LastTransitionTime' will not be updated
-	// So we delete condition to force new 'LastTransitionTime'
-	if !isDeleted && isModified {
+	// So we delete condition to force new 'LastTransitionTime', except for a paused resource which keeps its status
+	if !isDeleted && isModified && !isPaused(zone) {
 		log.V(1).Info("Removing Available condition from ClusterZone")
 		isModified = true
 		meta.RemoveStatusCondition(&zone.Status.Conditions, "Available")
 	}
 
-	return zoneReconcile(ctx, zone, isModified, isDeleted, r.Client, r.PDNSClient, log)
+	result, synced, err := zoneReconcile(ctx, zone, isModified, isDeleted, r.RetryBackoff, r.FinalizerTimeout, r.AllowedZoneSuffixes, r.DefaultTTLByType, r.Scheme, r.Client, r.PDNSClient, log)
+	err = dryRunReconcile(zone, err)
+	result, err = rateLimitedResult(result, err)
+	result = resyncResult(result, err, isDeleted, getSyncInterval(zone.Spec.SyncInterval, r.SyncInterval))
+	return observeReconcile(CLUSTERZONE_CONTROLLER_NAME, synced, result, err)
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -107,9 +148,52 @@ func (r *ClusterZoneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}); err != nil {
 		return err
 	}
+	// We use indexers to find the members of a catalog ClusterZone, current and previously listed
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &dnsv1alpha2.Zone{}, "Zone.Spec.Catalog", func(rawObj client.Object) []string {
+		return []string{getCatalogIndexKey(rawObj.(*dnsv1alpha2.Zone))}
+	}); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &dnsv1alpha2.ClusterZone{}, "ClusterZone.Spec.Catalog", func(rawObj client.Object) []string {
+		return []string{getCatalogIndexKey(rawObj.(*dnsv1alpha2.ClusterZone))}
+	}); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &dnsv1alpha2.ClusterZone{}, "ClusterZone.Status.CatalogMembers", func(rawObj client.Object) []string {
+		return rawObj.(*dnsv1alpha2.ClusterZone).Status.CatalogMembers
+	}); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&dnsv1alpha2.ClusterZone{}).
 		Owns(&dnsv1alpha2.ClusterRRset{}).
 		Owns(&dnsv1alpha2.RRset{}).
+		Watches(&dnsv1alpha2.Zone{}, handler.EnqueueRequestsFromMapFunc(r.findCatalogsForZone), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&dnsv1alpha2.ClusterZone{}, handler.EnqueueRequestsFromMapFunc(r.findCatalogsForZone), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(r.RateLimiter.controllerOptions()).
 		Complete(r)
 }
+
+// getCatalogIndexKey returns the name of the catalog ClusterZone referenced by a zone
+func getCatalogIndexKey(zone dnsv1alpha2.GenericZone) string {
+	return strings.TrimSuffix(strings.ToLower(ptr.Deref(zone.GetSpec().Catalog, "")), ".")
+}
+
+// findCatalogsForZone returns the catalog ClusterZones referenced by the zone, and those currently listing it,
+// so that the members of a catalog follow the creation, modification and deletion of the zones
+func (r *ClusterZoneReconciler) findCatalogsForZone(ctx context.Context, obj client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	if catalog := getCatalogIndexKey(obj.(dnsv1alpha2.GenericZone)); catalog != "" {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: catalog}})
+	}
+
+	var catalogs dnsv1alpha2.ClusterZoneList
+	if err := r.List(ctx, &catalogs, client.MatchingFields{"ClusterZone.Status.CatalogMembers": makeCanonical(obj.GetName())}); err != nil {
+		log.FromContext(ctx).Error(err, "unable to find catalogs related to the zone", "Zone.Name", obj.GetName())
+		return requests
+	}
+	for _, catalog := range catalogs.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&catalog)})
+	}
+	return requests
+}
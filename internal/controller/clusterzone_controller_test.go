@@ -19,9 +19,12 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -157,6 +160,20 @@ var _ = Describe("ClusterZone Controller", func() {
 				err := k8sClient.Get(ctx, typeNamespacedName, updatedZone)
 				return err == nil && updatedZone.IsInExpectedStatus(FIRST_GENERATION, dnsv1alpha2.FAILED_STATUS, metav1.ConditionFalse)
 			}, timeout, interval).Should(BeTrue())
+
+			By("Getting the Duplicated event of the resource")
+			Eventually(func() bool {
+				eventList := &eventsv1.EventList{}
+				if err := k8sClient.List(ctx, eventList, client.InNamespace(recreationResourceNamespace)); err != nil {
+					return false
+				}
+				for _, event := range eventList.Items {
+					if event.Regarding.Name == recreationResourceName && event.Type == corev1.EventTypeWarning && event.Reason == dnsv1alpha2.DUPLICATED_REASON {
+						return true
+					}
+				}
+				return false
+			}, timeout, interval).Should(BeTrue())
 		})
 	})
 })
@@ -13,24 +13,145 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/joeig/go-powerdns/v3"
 	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// recordAvailableEvent emits an Event when the Available condition of obj changed during the reconcile:
+// a Normal event when the resource is synchronized, a Warning event otherwise (synchronization failed, duplicated, ...)
+// The event reason is the reason of the condition
+func recordAvailableEvent(recorder events.EventRecorder, obj runtime.Object, previous, current []metav1.Condition) {
+	if recorder == nil {
+		return
+	}
+	condition := meta.FindStatusCondition(current, "Available")
+	if condition == nil {
+		return
+	}
+	previousCondition := meta.FindStatusCondition(previous, "Available")
+	if previousCondition != nil && previousCondition.Reason == condition.Reason && previousCondition.Message == condition.Message {
+		return
+	}
+	eventType := corev1.EventTypeWarning
+	if condition.Status == metav1.ConditionTrue {
+		eventType = corev1.EventTypeNormal
+	}
+	recorder.Eventf(obj, nil, eventType, condition.Reason, "Reconcile", "%s", condition.Message)
+}
+
+// recordPausedEvent emits an Event when the reconciliation of obj is paused or resumed (paused annotation)
+func recordPausedEvent(recorder events.EventRecorder, obj runtime.Object, previous, current []metav1.Condition) {
+	if recorder == nil {
+		return
+	}
+	wasPaused := meta.IsStatusConditionTrue(previous, dnsv1alpha2.PAUSED_CONDITION)
+	paused := meta.IsStatusConditionTrue(current, dnsv1alpha2.PAUSED_CONDITION)
+	switch {
+	case paused && !wasPaused:
+		recorder.Eventf(obj, nil, corev1.EventTypeNormal, dnsv1alpha2.PAUSED_REASON, "Reconcile", "%s", dnsv1alpha2.PAUSED_MESSAGE)
+	case !paused && wasPaused:
+		recorder.Eventf(obj, nil, corev1.EventTypeNormal, dnsv1alpha2.RESUMED_REASON, "Reconcile", "%s", dnsv1alpha2.RESUMED_MESSAGE)
+	}
+}
+
+// recordCleanupAbandonedEvent emits a Warning event when the finalizer of obj is removed after the finalizer timeout,
+// without deleting its external resources
+func recordCleanupAbandonedEvent(recorder events.EventRecorder, obj runtime.Object, previous, current []metav1.Condition) {
+	if recorder == nil {
+		return
+	}
+	condition := meta.FindStatusCondition(current, dnsv1alpha2.CLEANUP_ABANDONED_CONDITION)
+	if condition == nil || meta.FindStatusCondition(previous, dnsv1alpha2.CLEANUP_ABANDONED_CONDITION) != nil {
+		return
+	}
+	recorder.Eventf(obj, nil, corev1.EventTypeWarning, condition.Reason, "Delete", "%s", condition.Message)
+}
+
+// finalizerTimedOut returns true when the deletion of a resource started at least timeout ago,
+// its finalizer is then removed even if its external resources cannot be deleted. A zero timeout never expires
+func finalizerTimedOut(deletionTimestamp *metav1.Time, timeout time.Duration, now time.Time) bool {
+	return timeout > 0 && deletionTimestamp != nil && now.Sub(deletionTimestamp.Time) >= timeout
+}
+
+// dryRunReconcile reports in the resource status the change blocked in dry-run mode, which is not an error
+func dryRunReconcile(obj interface{ SetDryRun(err error) }, err error) error {
+	var dryRunErr *DryRunError
+	if errors.As(err, &dryRunErr) {
+		obj.SetDryRun(dryRunErr)
+		return nil
+	}
+	return err
+}
+
+// getLastSyncTime returns the sync time of a RRset synchronized at now,
+// the previous one is kept when it is more recent than LAST_SYNC_TIME_RESOLUTION
+func getLastSyncTime(previous *metav1.Time, now time.Time) *metav1.Time {
+	if previous != nil && now.Sub(previous.Time) < LAST_SYNC_TIME_RESOLUTION {
+		return previous
+	}
+	return &metav1.Time{Time: now.UTC()}
+}
+
+// getSyncInterval returns the resynchronization interval of a resource, the operator default when not defined
+func getSyncInterval(interval *metav1.Duration, defaultInterval time.Duration) time.Duration {
+	if interval != nil {
+		return interval.Duration
+	}
+	return defaultInterval
+}
+
+// resyncResult requeues a successfully reconciled resource after its resynchronization interval,
+// so that drift made out-of-band in PowerDNS is detected without a Kubernetes event
+func resyncResult(result ctrl.Result, err error, isDeleted bool, interval time.Duration) ctrl.Result {
+	if err != nil || isDeleted || interval <= 0 || !result.IsZero() {
+		return result
+	}
+	return ctrl.Result{RequeueAfter: interval}
+}
+
+// zoneLogValues returns the structured log fields identifying a Zone/ClusterZone
+func zoneLogValues(gz dnsv1alpha2.GenericZone) []any {
+	return []any{"zone", makeCanonical(gz.GetObjectMeta().Name)}
+}
+
+// rrsetLogValues returns the structured log fields identifying a RRset/ClusterRRset, the same in all log lines
+// so that the logs of a record can be filtered whichever the resource kind
+func rrsetLogValues(gr dnsv1alpha2.GenericRRset) []any {
+	return []any{
+		"zone", makeCanonical(gr.GetSpec().ZoneRef.Name),
+		"rrset", gr.GetName(),
+		"fqdn", getRRsetName(gr),
+		"type", gr.GetSpec().Type,
+	}
+}
+
+// zoneReconcile synchronizes a Zone/ClusterZone with PowerDNS, it also reports whether the synchronization
+// succeeded: early returns (paused zone, retry backoff, ...) do not synchronize anything
+//
 //nolint:unparam // Always return ctrl.Result{} is ok
-func zoneReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, isModified bool, isDeleted bool, cl client.Client, PDNSClient PdnsClienter, log logr.Logger) (ctrl.Result, error) {
+func zoneReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, isModified bool, isDeleted bool, retryBackoff RetryBackoff, finalizerTimeout time.Duration, allowedZoneSuffixes []string, defaultTTLByType map[string]uint32, scheme *runtime.Scheme, cl client.Client, PDNSClient PdnsClienter, log logr.Logger) (ctrl.Result, bool, error) {
+	log = log.WithValues(zoneLogValues(gz)...)
+	ctx = logf.IntoContext(ctx, log)
 	isInFailedStatus := (gz.GetStatus().SyncStatus != nil && *gz.GetStatus().SyncStatus == dnsv1alpha2.FAILED_STATUS)
 
 	// examine DeletionTimestamp to determine if object is under deletion
@@ -41,7 +162,7 @@ func zoneReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, isModified b
 		if !controllerutil.ContainsFinalizer(gz, RESOURCES_FINALIZER_NAME) {
 			controllerutil.AddFinalizer(gz, RESOURCES_FINALIZER_NAME)
 			if err := cl.Update(ctx, gz); err != nil {
-				return ctrl.Result{}, err
+				return ctrl.Result{}, false, err
 			}
 		}
 	} else {
@@ -49,10 +170,26 @@ func zoneReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, isModified b
 		finalizerRemoved := false
 		if controllerutil.ContainsFinalizer(gz, RESOURCES_FINALIZER_NAME) {
 			// our finalizer is present, so lets handle any external dependency
-			if err := deleteZoneExternalResources(ctx, gz, PDNSClient, log); err != nil {
+			var rrsets []dnsv1alpha2.GenericRRset
+			if deletesManagedRecords(gz) {
+				var err error
+				if rrsets, err = getZoneRRsets(ctx, gz, cl); err != nil {
+					log.Error(err, "unable to find RRsets related to the Zone")
+					return ctrl.Result{}, false, err
+				}
+			}
+			if err := deleteZoneExternalResources(ctx, gz, rrsets, PDNSClient, log); err != nil {
 				// if fail to delete the external resource, return with error
-				// so that it can be retried
-				return ctrl.Result{}, err
+				// so that it can be retried, until the finalizer timeout
+				if !finalizerTimedOut(gz.GetDeletionTimestamp(), finalizerTimeout, time.Now()) {
+					return ctrl.Result{}, false, err
+				}
+				log.Error(err, "Finalizer timeout exceeded, removing finalizer without deleting the zone from PowerDNS")
+				gz.SetCleanupAbandoned(err)
+			}
+			// The RRsets owned by the zone are deleted now, instead of waiting for the garbage collection
+			if err := deleteOwnedRRsets(ctx, gz, cl, log); err != nil {
+				return ctrl.Result{}, false, err
 			}
 			// remove our finalizer from the list and update it.
 			controllerutil.RemoveFinalizer(gz, RESOURCES_FINALIZER_NAME)
@@ -66,19 +203,40 @@ func zoneReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, isModified b
 		}
 		if finalizerRemoved {
 			if err := cl.Update(ctx, gz); err != nil {
-				return ctrl.Result{}, err
+				return ctrl.Result{}, false, err
 			}
 		}
 
 		// Stop reconciliation as the item is being deleted
-		return ctrl.Result{}, nil
+		return ctrl.Result{}, true, nil
+	}
+
+	// A paused zone is neither synchronized with PowerDNS nor its status updated, its deletion still proceeds
+	gz.SetPaused(isPaused(gz))
+	if isPaused(gz) {
+		log.Info("Zone reconciliation is paused")
+		return ctrl.Result{}, false, nil
+	}
+
+	// A PowerDNS server which is not authoritative cannot serve the zone, the misconfiguration is reported
+	// instead of failing on each call to PowerDNS API
+	daemonType := notAuthoritativeDaemonType()
+	gz.SetNotAuthoritative(daemonType)
+	if daemonType != "" {
+		log.Info("PowerDNS server is not authoritative, zone not synchronized", "daemonType", daemonType)
+		return ctrl.Result{}, false, nil
 	}
 
 	// We cannot exit previously (at the early moments of reconcile), because we have to allow deletion process
 	if isInFailedStatus && !isModified {
-		// Update resource metrics
-		updateZonesMetrics(gz)
-		return ctrl.Result{}, nil
+		status := gz.GetStatus()
+		requeueAfter, retry := retryBackoff.retryAfter(status.Conditions, status.FailureCount, status.LastFailureTime, string(gz.GetUID()), time.Now())
+		if !retry {
+			// Update resource metrics
+			updateZonesMetrics(gz)
+			return ctrl.Result{RequeueAfter: requeueAfter}, false, nil
+		}
+		log.Info("Retrying synchronization of failed zone", "failureCount", ptr.Deref(status.FailureCount, 0))
 	}
 
 	// If a Zone already exists with the same DNS name:
@@ -87,12 +245,12 @@ func zoneReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, isModified b
 	var existingZones dnsv1alpha2.ZoneList
 	if err := cl.List(ctx, &existingZones, client.MatchingFields{"Zone.Entry.Name": gz.GetName()}); err != nil {
 		log.Error(err, "unable to find Zone related to the DNS Name")
-		return ctrl.Result{}, err
+		return ctrl.Result{}, false, err
 	}
 	var existingClusterZones dnsv1alpha2.ClusterZoneList
 	if err := cl.List(ctx, &existingClusterZones, client.MatchingFields{"ClusterZone.Entry.Name": gz.GetName()}); err != nil {
 		log.Error(err, "unable to find ClusterZone related to the DNS Name")
-		return ctrl.Result{}, err
+		return ctrl.Result{}, false, err
 	}
 
 	// Multiple use-cases:
@@ -106,42 +264,322 @@ func zoneReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, isModified b
 		// Update resource metrics
 		updateZonesMetrics(gz)
 
-		return ctrl.Result{}, fmt.Errorf("zone already exists")
+		return ctrl.Result{}, false, fmt.Errorf("zone already exists")
 	}
 
 	// Get zone
 	zoneRes, err := getZoneExternalResources(ctx, gz.GetObjectMeta().Name, PDNSClient, log)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, false, err
+	}
+
+	// A zone can only be created on the PowerDNS API when it is within the allowed suffixes:
+	// * Stop reconciliation
+	// * Append a Failed Status on Zone
+	if (zoneRes == nil || zoneRes.Name == nil) && !isZoneAllowed(gz.GetObjectMeta().Name, allowedZoneSuffixes) {
+		gz.SetNotAllowed(allowedZoneSuffixes)
+
+		// Update resource metrics
+		updateZonesMetrics(gz)
+
+		return ctrl.Result{}, false, fmt.Errorf("zone %s is not allowed on the PowerDNS API", gz.GetObjectMeta().Name)
 	}
 
+	// A zone existing in PowerDNS before its first synchronization is adopted when requested
+	preexisting := zoneRes != nil && zoneRes.Name != nil && gz.GetStatus().ID == nil
+
+	// Signing state before the reconcile, the zone is rectified on its change when auto-rectify is enabled
+	signed := zoneRes != nil && ptr.Deref(zoneRes.DNSsec, false)
+	nsec3Params := ptr.Deref(gz.GetStatus().Nsec3Params, "")
+
 	err = zoneExternalResourcesReconcile(ctx, zoneRes, gz, PDNSClient, log)
 	if err != nil {
 		gz.SetSynchronizationFailed(err)
-		return ctrl.Result{}, err
+		return ctrl.Result{}, false, err
+	}
+
+	// The glue records must not overwrite nor delete the records of the RRsets of the zone
+	var zoneRRsets []dnsv1alpha2.GenericRRset
+	if len(gz.GetSpec().NameserverGlue) > 0 || len(gz.GetStatus().NameserverGlue) > 0 {
+		if zoneRRsets, err = getZoneRRsets(ctx, gz, cl); err != nil {
+			gz.SetSynchronizationFailed(err)
+			return ctrl.Result{}, false, err
+		}
+	}
+	err = nameserverGlueExternalResourcesReconcile(ctx, gz, zoneRRsets, PDNSClient, log)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
+	}
+
+	err = nsec3ParamsExternalResourcesReconcile(ctx, gz, PDNSClient, log)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
+	}
+
+	err = soaExternalResourcesReconcile(ctx, gz, PDNSClient, log)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
+	}
+
+	err = serialExternalResourcesReconcile(ctx, gz, PDNSClient, log)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
+	}
+
+	err = validateTSIGKeyRefs(ctx, gz, cl)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
+	}
+
+	err = tsigMetadataExternalResourcesReconcile(ctx, gz, PDNSClient, log)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
+	}
+
+	err = alsoNotifyExternalResourcesReconcile(ctx, gz, PDNSClient, log)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
+	}
+
+	err = allowAXFRFromExternalResourcesReconcile(ctx, gz, PDNSClient, log)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
+	}
+
+	err = adoptReconcile(ctx, gz, preexisting, PDNSClient, log)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
+	}
+
+	err = metadataExternalResourcesReconcile(ctx, gz, PDNSClient, log)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
+	}
+
+	if err := axfrRetrieveReconcile(ctx, gz, cl, PDNSClient, log); err != nil {
+		return ctrl.Result{}, false, err
+	}
+
+	err = catalogMembershipReconcile(ctx, gz, cl, PDNSClient, log)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
+	}
+
+	err = catalogMembersReconcile(ctx, gz, cl, PDNSClient, log)
+	if err != nil {
+		gz.SetSynchronizationFailed(err)
+		return ctrl.Result{}, false, err
 	}
 
 	// Update ZoneStatus
 	zoneRes, err = getZoneExternalResources(ctx, gz.GetObjectMeta().Name, PDNSClient, log)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, false, err
+	}
+
+	signingChanged := signed != ptr.Deref(zoneRes.DNSsec, false) || nsec3Params != ptr.Deref(gz.GetStatus().Nsec3Params, "")
+	if err := rectifyReconcile(ctx, gz, cl, signingChanged, PDNSClient, log); err != nil {
+		return ctrl.Result{}, false, err
 	}
 
 	gz.SetAvailable(zoneRes)
 
+	// Export of the zone, a failed export does not prevent the zone from being available
+	if err := zoneExportReconcile(ctx, gz, cl, PDNSClient, log); err != nil {
+		log.Error(err, "Failed to export zone")
+	}
+
+	// Create the RRsets of spec.defaultRecords not applied yet
+	if err := defaultRecordsReconcile(ctx, gz, scheme, cl, log); err != nil {
+		return ctrl.Result{}, false, err
+	}
+
+	// Report TTL inconsistencies across managed RRsets
+	if err := zoneTTLHarmonizationReconcile(ctx, gz, defaultTTLByType, cl, log); err != nil {
+		return ctrl.Result{}, false, err
+	}
+
+	// Report the RRsets managed in the zone
+	if err := zoneManagedRecordsReconcile(ctx, gz, cl, log); err != nil {
+		return ctrl.Result{}, false, err
+	}
+
 	// Update resource metrics
 	updateZonesMetrics(gz)
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{}, true, nil
+}
+
+// getZoneRRsets returns the RRsets and ClusterRRsets referencing the Zone/ClusterZone
+func getZoneRRsets(ctx context.Context, gz dnsv1alpha2.GenericZone, cl client.Client) ([]dnsv1alpha2.GenericRRset, error) {
+	var zoneRef dnsv1alpha2.ZoneRef
+	var listOptions []client.ListOption
+	switch gz.(type) {
+	case *dnsv1alpha2.Zone:
+		zoneRef = dnsv1alpha2.ZoneRef{Name: gz.GetName(), Kind: "Zone"}
+		listOptions = append(listOptions, client.InNamespace(gz.GetNamespace()))
+	case *dnsv1alpha2.ClusterZone:
+		zoneRef = dnsv1alpha2.ZoneRef{Name: gz.GetName(), Kind: "ClusterZone"}
+	}
+
+	var rrsets []dnsv1alpha2.GenericRRset
+	var existingRRsets dnsv1alpha2.RRsetList
+	if err := cl.List(ctx, &existingRRsets, append(listOptions, client.MatchingFields{"RRset.ZoneRef": dnsv1alpha2.ZoneRefKey(zoneRef)})...); err != nil {
+		return nil, err
+	}
+	for i := range existingRRsets.Items {
+		rrsets = append(rrsets, &existingRRsets.Items[i])
+	}
+	var existingClusterRRsets dnsv1alpha2.ClusterRRsetList
+	if err := cl.List(ctx, &existingClusterRRsets, client.MatchingFields{"ClusterRRset.ZoneRef": dnsv1alpha2.ZoneRefKey(zoneRef)}); err != nil {
+		return nil, err
+	}
+	for i := range existingClusterRRsets.Items {
+		rrsets = append(rrsets, &existingClusterRRsets.Items[i])
+	}
+	return rrsets, nil
+}
+
+// deleteOwnedRRsets deletes the RRsets and ClusterRRsets controlled by the Zone/ClusterZone (see ownObject)
+func deleteOwnedRRsets(ctx context.Context, gz dnsv1alpha2.GenericZone, cl client.Client, log logr.Logger) error {
+	rrsets, err := getZoneRRsets(ctx, gz, cl)
+	if err != nil {
+		log.Error(err, "unable to find RRsets related to the Zone")
+		return err
+	}
+	for _, rrset := range rrsets {
+		if !metav1.IsControlledBy(rrset, gz) || !rrset.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		log.V(1).Info("Deleting RRset owned by the Zone", "RRset.Name", rrset.GetName(), "RRset.Namespace", rrset.GetNamespace())
+		if err := cl.Delete(ctx, rrset); client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to delete RRset owned by the Zone", "RRset.Name", rrset.GetName())
+			return err
+		}
+	}
+	return nil
+}
+
+// defaultRecordsReconcile creates the RRsets (ClusterRRsets for a ClusterZone) of spec.defaultRecords, owned by the Zone/ClusterZone
+// A template is applied once, it is skipped when a RRset with the same name and type already exists; the templates applied
+// are recorded in an annotation so that a RRset deleted afterwards is not recreated
+func defaultRecordsReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, scheme *runtime.Scheme, cl client.Client, log logr.Logger) error {
+	if len(gz.GetSpec().DefaultRecords) == 0 {
+		return nil
+	}
+	rrsets, err := getZoneRRsets(ctx, gz, cl)
+	if err != nil {
+		log.Error(err, "unable to find RRsets related to the Zone")
+		return err
+	}
+	existing := getManagedRecords(rrsets)
+	applied := getAppliedDefaultRecords(gz)
+
+	changed := false
+	for _, template := range gz.GetSpec().DefaultRecords {
+		rrset := newDefaultRRset(gz, template)
+		record := getRRsetName(rrset) + " " + rrset.GetSpec().Type
+		if slices.Contains(applied, record) {
+			continue
+		}
+		if !slices.Contains(existing, record) {
+			if err := ctrl.SetControllerReference(gz, rrset, scheme); err != nil {
+				log.Error(err, "Failed to set owner reference")
+				return err
+			}
+			if err := cl.Create(ctx, rrset); client.IgnoreAlreadyExists(err) != nil {
+				log.Error(err, "Failed to create default RRset", "RRset.Name", rrset.GetName())
+				return err
+			}
+			log.Info("Default RRset created", "RRset.Name", rrset.GetName(), "fqdn", getRRsetName(rrset), "type", rrset.GetSpec().Type)
+		}
+		applied = append(applied, record)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	// Work on a copy, so that the status of the zone is not overwritten with the one of the API server
+	patched := gz.Copy()
+	annotations := patched.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[DEFAULT_RECORDS_ANNOTATION] = strings.Join(applied, ",")
+	patched.SetAnnotations(annotations)
+	if err := cl.Patch(ctx, patched, client.MergeFrom(gz)); err != nil {
+		log.Error(err, "Failed to set annotation", "annotation", DEFAULT_RECORDS_ANNOTATION)
+		return err
+	}
+	return nil
+}
+
+// zoneManagedRecordsReconcile reports in Zone status the RRsets managed in the zone, only their count for large zones
+func zoneManagedRecordsReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, cl client.Client, log logr.Logger) error {
+	rrsets, err := getZoneRRsets(ctx, gz, cl)
+	if err != nil {
+		log.Error(err, "unable to find RRsets related to the Zone")
+		return err
+	}
+	records := getManagedRecords(rrsets)
+	status := gz.GetStatus()
+	status.ManagedRecordsCount = ptr.To(int32(len(records)))
+	status.ManagedRecords = records
+	if len(records) > MAX_MANAGED_RECORDS_IN_STATUS {
+		status.ManagedRecords = nil
+	}
+	gz.SetStatus(status)
+	return nil
+}
+
+// zoneTTLHarmonizationReconcile reports in Zone status RRset types having inconsistent TTLs, as applied in PowerDNS
+func zoneTTLHarmonizationReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, defaultTTLByType map[string]uint32, cl client.Client, log logr.Logger) error {
+	status := gz.GetStatus()
+	status.TTLInconsistencies = nil
+	if ptr.Deref(gz.GetSpec().TTLHarmonization, dnsv1alpha2.TTL_HARMONIZATION_DISABLED) == dnsv1alpha2.TTL_HARMONIZATION_REPORT {
+		rrsets, err := getZoneRRsets(ctx, gz, cl)
+		if err != nil {
+			log.Error(err, "unable to find RRsets related to the Zone")
+			return err
+		}
+		var managedRRsets []dnsv1alpha2.GenericRRset
+		for _, rrset := range rrsets {
+			if rrset.GetDeletionTimestamp().IsZero() {
+				managedRRsets = append(managedRRsets, rrset)
+			}
+		}
+		status.TTLInconsistencies = findTTLInconsistencies(gz, managedRRsets, defaultTTLByType)
+		if len(status.TTLInconsistencies) > 0 {
+			log.Info("Inconsistent TTLs detected in Zone", "inconsistencies", status.TTLInconsistencies)
+		}
+	}
+	gz.SetStatus(status)
+	return nil
 }
 
-func rrsetReconcile(ctx context.Context, gr dnsv1alpha2.GenericRRset, zone dnsv1alpha2.GenericZone, isModified bool, isDeleted bool, lastUpdateTime *metav1.Time, scheme *runtime.Scheme, cl client.Client, PDNSClient PdnsClienter, log logr.Logger) (ctrl.Result, error) {
+// rrsetReconcile synchronizes a RRset/ClusterRRset with PowerDNS, it also reports whether the synchronization
+// succeeded: early returns (paused RRset, retry backoff, duplicated RRset, ...) do not synchronize anything
+func rrsetReconcile(ctx context.Context, gr dnsv1alpha2.GenericRRset, zone dnsv1alpha2.GenericZone, isModified bool, isDeleted bool, lastUpdateTime *metav1.Time, defaultTTLByType map[string]uint32, allowLuaRecords bool, notifier *ZoneNotifier, retryBackoff RetryBackoff, finalizerTimeout time.Duration, scheme *runtime.Scheme, cl client.Client, PDNSClient PdnsClienter, log logr.Logger) (ctrl.Result, bool, error) {
+	log = log.WithValues(rrsetLogValues(gr)...)
+	ctx = logf.IntoContext(ctx, log)
 	isInFailedStatus := (gr.GetStatus().SyncStatus != nil && *gr.GetStatus().SyncStatus == dnsv1alpha2.FAILED_STATUS)
 	log.V(1).Info("RRset situation", "isModified", isModified, "isDeleted", isDeleted, "lastUpdateTime", lastUpdateTime, "isInFailedStatus", isInFailedStatus)
 
 	// examine DeletionTimestamp to determine if object is under deletion
 	if !isDeleted {
-		log.V(1).Info("RRset not deleted", "RRset.Name", gr.GetName())
+		log.V(1).Info("RRset not deleted")
 		// The object is not being deleted, so if it does not have our finalizer,
 		// then lets add the finalizer and update the object. This is equivalent
 		// to registering our finalizer.
@@ -151,21 +589,43 @@ func rrsetReconcile(ctx context.Context, gr dnsv1alpha2.GenericRRset, zone dnsv1
 			lastUpdateTime = &metav1.Time{Time: time.Now().UTC()}
 			if err := cl.Update(ctx, gr); err != nil {
 				log.Error(err, "Failed to add finalizer")
-				return ctrl.Result{}, err
+				return ctrl.Result{}, false, err
 			}
 		}
 	} else {
-		log.V(1).Info("RRset is deleted", "RRset.Name", gr.GetName())
+		log.V(1).Info("RRset is deleted")
 		// The object is being deleted
 		finalizerRemoved := false
 		if controllerutil.ContainsFinalizer(gr, RESOURCES_FINALIZER_NAME) {
 			log.V(1).Info("Removing resources finalizer from RRset")
 			// our finalizer is present, so lets handle any external dependency
-			if err := deleteRrsetExternalResources(ctx, zone, gr, PDNSClient, log); err != nil {
+			if err := deleteReverseRecordsExternalResources(ctx, gr, PDNSClient, log); err != nil {
+				if !finalizerTimedOut(gr.GetDeletionTimestamp(), finalizerTimeout, time.Now()) {
+					log.Error(err, "Failed to delete reverse records")
+					return ctrl.Result{}, false, err
+				}
+				log.Error(err, "Finalizer timeout exceeded, removing finalizer without deleting the reverse records from PowerDNS")
+				gr.SetCleanupAbandoned(err)
+			}
+			// The records of an overridden ClusterRRset are managed by the overriding RRset
+			replaced, err := isReplaced(ctx, gr, cl)
+			if err != nil {
+				log.Error(err, "unable to find RRsets related to the DNS Name")
+				return ctrl.Result{}, false, err
+			}
+			if isOverridden(gr) {
+				log.V(1).Info("ClusterRRset is overridden, keeping external resources")
+			} else if replaced {
+				log.Info("RRset replaced by another resource with the same DNS name, keeping external resources")
+			} else if err := deleteRrsetExternalResources(ctx, zone, gr, PDNSClient, log); err != nil {
 				// if fail to delete the external resource, return with error
-				// so that it can be retried
-				log.Error(err, "Failed to delete external resources")
-				return ctrl.Result{}, err
+				// so that it can be retried, until the finalizer timeout
+				if !finalizerTimedOut(gr.GetDeletionTimestamp(), finalizerTimeout, time.Now()) {
+					log.Error(err, "Failed to delete external resources")
+					return ctrl.Result{}, false, err
+				}
+				log.Error(err, "Finalizer timeout exceeded, removing finalizer without deleting the records from PowerDNS")
+				gr.SetCleanupAbandoned(err)
 			}
 			// remove our finalizer from the list.
 			controllerutil.RemoveFinalizer(gr, RESOURCES_FINALIZER_NAME)
@@ -180,12 +640,34 @@ func rrsetReconcile(ctx context.Context, gr dnsv1alpha2.GenericRRset, zone dnsv1
 		if finalizerRemoved {
 			if err := cl.Update(ctx, gr); err != nil {
 				log.Error(err, "Failed to remove finalizer")
-				return ctrl.Result{}, err
+				return ctrl.Result{}, false, err
 			}
 		}
 
 		// Stop reconciliation as the item is being deleted
-		return ctrl.Result{}, nil
+		return ctrl.Result{}, true, nil
+	}
+
+	// A paused RRset, or a RRset of a paused zone, is neither synchronized with PowerDNS nor its status updated,
+	// its deletion still proceeds
+	gr.SetPaused(isPaused(gr) || isPaused(zone))
+	if isPaused(gr) || isPaused(zone) {
+		log.Info("RRset reconciliation is paused")
+		return ctrl.Result{}, false, nil
+	}
+
+	// A PowerDNS server which is not authoritative cannot serve the records, see zoneReconcile
+	daemonType := notAuthoritativeDaemonType()
+	gr.SetNotAuthoritative(daemonType)
+	if daemonType != "" {
+		log.Info("PowerDNS server is not authoritative, RRset not synchronized", "daemonType", daemonType)
+		return ctrl.Result{}, false, nil
+	}
+
+	// The records are moved when spec.zoneRef changes, the previous Zone does not own the RRset anymore
+	if err := zoneRefChangeReconcile(ctx, gr, PDNSClient, log); err != nil {
+		log.Error(err, "Failed to delete records from the previous zone")
+		return ctrl.Result{}, false, err
 	}
 
 	// Set OwnerReference as soon as the Zone is known, so that RRsets in a
@@ -193,31 +675,93 @@ func rrsetReconcile(ctx context.Context, gr dnsv1alpha2.GenericRRset, zone dnsv1
 	if err := ownObject(ctx, zone, gr, scheme, cl, log); err != nil {
 		if apierrors.IsConflict(err) {
 			log.Info("Conflict on RRSet owner reference, retrying")
-			return ctrl.Result{Requeue: true}, nil
+			return ctrl.Result{Requeue: true}, false, nil
 		}
 		log.Error(err, "Failed to set owner reference")
-		return ctrl.Result{}, err
+		return ctrl.Result{}, false, err
 	}
 
 	// We cannot exit previously (at the early moments of reconcile), because we have to allow deletion process
 	if isInFailedStatus && !isModified {
-		// Update resource metrics
-		updateRrsetsMetrics(getRRsetName(gr), gr)
-		return ctrl.Result{}, nil
+		status := gr.GetStatus()
+		requeueAfter, retry := retryBackoff.retryAfter(status.Conditions, status.FailureCount, status.LastFailureTime, string(gr.GetUID()), time.Now())
+		if !retry {
+			// Update resource metrics
+			updateRrsetsMetrics(getRRsetName(gr), gr)
+			return ctrl.Result{RequeueAfter: requeueAfter}, false, nil
+		}
+		log.Info("Retrying synchronization of failed RRset", "failureCount", ptr.Deref(status.FailureCount, 0))
 	}
 
 	// If a RRset already exists with the same DNS name:
 	// * Stop reconciliation
 	// * Append a Failed Status on RRset
+	// A dual-stack RRset has a DNS entry per address family
 	var existingRRsets dnsv1alpha2.RRsetList
-	if err := cl.List(ctx, &existingRRsets, client.MatchingFields{"RRset.Entry.Name": getRRsetName(gr) + "/" + gr.GetSpec().Type}); err != nil {
-		log.Error(err, "unable to find RRsets related to the DNS Name")
-		return ctrl.Result{}, err
-	}
 	var existingClusterRRsets dnsv1alpha2.ClusterRRsetList
-	if err := cl.List(ctx, &existingClusterRRsets, client.MatchingFields{"ClusterRRset.Entry.Name": getRRsetName(gr) + "/" + gr.GetSpec().Type}); err != nil {
-		log.Error(err, "unable to find RRsets related to the DNS Name")
-		return ctrl.Result{}, err
+	for _, entry := range getRRsetEntries(gr) {
+		var rrsets dnsv1alpha2.RRsetList
+		if err := cl.List(ctx, &rrsets, client.MatchingFields{"RRset.Entry.Name": entry}); err != nil {
+			log.Error(err, "unable to find RRsets related to the DNS Name")
+			return ctrl.Result{}, false, err
+		}
+		for _, r := range rrsets.Items {
+			// A RRset being deleted is replaced by this one (e.g. short-lived ACME challenge records), see isReplaced
+			if !r.DeletionTimestamp.IsZero() {
+				continue
+			}
+			if !slices.ContainsFunc(existingRRsets.Items, func(e dnsv1alpha2.RRset) bool { return e.UID == r.UID }) {
+				existingRRsets.Items = append(existingRRsets.Items, r)
+			}
+		}
+		var clusterRRsets dnsv1alpha2.ClusterRRsetList
+		if err := cl.List(ctx, &clusterRRsets, client.MatchingFields{"ClusterRRset.Entry.Name": entry}); err != nil {
+			log.Error(err, "unable to find RRsets related to the DNS Name")
+			return ctrl.Result{}, false, err
+		}
+		for _, c := range clusterRRsets.Items {
+			if !c.DeletionTimestamp.IsZero() {
+				continue
+			}
+			if !slices.ContainsFunc(existingClusterRRsets.Items, func(e dnsv1alpha2.ClusterRRset) bool { return e.UID == c.UID }) {
+				existingClusterRRsets.Items = append(existingClusterRRsets.Items, c)
+			}
+		}
+	}
+
+	// A ClusterRRset allowing namespace override is suppressed by a RRset with the same DNS name:
+	// * Stop reconciliation
+	// * Append a Pending Status on ClusterRRset
+	if _, ok := gr.(*dnsv1alpha2.ClusterRRset); ok && ptr.Deref(gr.GetSpec().AllowNamespaceOverride, false) && len(existingRRsets.Items) > 0 {
+		name := getRRsetName(gr)
+		by := client.ObjectKeyFromObject(&existingRRsets.Items[0]).String()
+		gr.SetOverridden(lastUpdateTime, name, by)
+		log.Info("ClusterRRset is overridden by a RRset", "RRset", by)
+
+		// Update resource metrics
+		updateRrsetsMetrics(getRRsetName(gr), gr)
+
+		return ctrl.Result{}, false, nil
+	}
+	// A RRset overrides the ClusterRRsets allowing it, they are not duplicates
+	if _, ok := gr.(*dnsv1alpha2.RRset); ok {
+		var overridden []string
+		for _, entry := range getRRsetEntries(gr) {
+			var overridable dnsv1alpha2.ClusterRRsetList
+			if err := cl.List(ctx, &overridable, client.MatchingFields{"ClusterRRset.Entry.Override": entry}); err != nil {
+				log.Error(err, "unable to find ClusterRRsets related to the DNS Name")
+				return ctrl.Result{}, false, err
+			}
+			for _, c := range overridable.Items {
+				if !slices.Contains(overridden, c.Name) {
+					overridden = append(overridden, c.Name)
+				}
+			}
+		}
+		existingClusterRRsets.Items = slices.DeleteFunc(existingClusterRRsets.Items, func(c dnsv1alpha2.ClusterRRset) bool {
+			return slices.Contains(overridden, c.Name)
+		})
+		gr.SetOverrides(overridden)
 	}
 
 	// Multiple use-cases:
@@ -229,26 +773,94 @@ func rrsetReconcile(ctx context.Context, gr dnsv1alpha2.GenericRRset, zone dnsv1
 	// In that case: len(existingClusterRRsets.Items) > 1
 	if len(existingRRsets.Items) > 1 || (len(existingRRsets.Items) >= 1 && len(existingClusterRRsets.Items) >= 1) || len(existingClusterRRsets.Items) > 1 {
 		name := getRRsetName(gr)
-		gr.SetDuplicated(lastUpdateTime, name)
+		conflicts := rrsetConflicts(gr, existingRRsets.Items, existingClusterRRsets.Items)
+		gr.SetDuplicated(lastUpdateTime, name, conflicts)
+		log.Info("RRset duplicated", "conflicts", conflicts)
+
+		// Update resource metrics
+		updateRrsetsMetrics(getRRsetName(gr), gr)
+
+		return ctrl.Result{}, false, fmt.Errorf("RRset already exists")
+	}
+
+	// The RRset name must be the zone apex or a subdomain of the zone:
+	// * Stop reconciliation
+	// * Append a Failed Status on RRset
+	if !isInBailiwick(getRRsetName(gr), zone.GetObjectMeta().Name) {
+		name := getRRsetName(gr)
+		gr.SetOutOfZone(lastUpdateTime, name)
+
+		// Update resource metrics
+		updateRrsetsMetrics(getRRsetName(gr), gr)
+
+		return ctrl.Result{}, false, fmt.Errorf("RRset is not within zone %s", zone.GetObjectMeta().Name)
+	}
+
+	// LUA records run code on the PowerDNS server, they must be enabled on the operator:
+	// * Stop reconciliation
+	// * Append a Failed Status on RRset
+	if isLuaType(gr.GetSpec().Type) && !allowLuaRecords {
+		name := getRRsetName(gr)
+		gr.SetLuaNotAllowed(lastUpdateTime, name)
 
 		// Update resource metrics
 		updateRrsetsMetrics(getRRsetName(gr), gr)
 
-		return ctrl.Result{}, fmt.Errorf("RRset already exists")
+		return ctrl.Result{}, false, fmt.Errorf("LUA records are not allowed")
+	}
+
+	// A CNAME cannot coexist with any other type at the same DNS name, nor at the zone apex:
+	// * Stop reconciliation
+	// * Append a Failed Status on RRset
+	conflict, err := rrsetHasCnameConflict(ctx, gr, zone, cl)
+	if err != nil {
+		log.Error(err, "unable to find RRsets related to the DNS Name")
+		return ctrl.Result{}, false, err
+	}
+	if conflict {
+		name := getRRsetName(gr)
+		gr.SetCnameConflict(lastUpdateTime, name)
+
+		// Update resource metrics
+		updateRrsetsMetrics(getRRsetName(gr), gr)
+
+		return ctrl.Result{}, false, fmt.Errorf("RRset conflicts with a CNAME")
 	}
 
 	// Create or Update
 	var changed bool
-	var err error
-	changed, err = createOrUpdateRrsetExternalResources(ctx, zone, gr, PDNSClient)
+	changed, err = createOrUpdateRrsetExternalResources(ctx, zone, gr, defaultTTLByType, PDNSClient)
+	if changed && !isModified && ptr.Deref(gr.GetStatus().SyncStatus, "") == dnsv1alpha2.SUCCEEDED_STATUS {
+		// The RRset was available and is unchanged: it has been modified or deleted out-of-band in PowerDNS
+		log.Info("Drift detected on RRset, PowerDNS records restored")
+	}
 	if changed {
 		lastUpdateTime = &metav1.Time{Time: time.Now().UTC()}
+		if notifier != nil && ptr.Deref(zone.GetSpec().NotifyOnChange, false) {
+			notifier.Notify(ctx, zone)
+		}
 	}
 	if err != nil {
 		log.Error(err, "Failed to create or update external resources")
 		gr.SetSynchronizationFailed(lastUpdateTime, err)
 		updateRrsetsMetrics(getRRsetName(gr), gr)
-		return ctrl.Result{}, err
+		return ctrl.Result{}, false, err
+	}
+
+	status := gr.GetStatus()
+	status.ZoneRef = &dnsv1alpha2.ZoneRef{Name: gr.GetSpec().ZoneRef.Name, Kind: gr.GetSpec().ZoneRef.Kind}
+	status.LastSyncTime = getLastSyncTime(status.LastSyncTime, time.Now())
+	gr.SetStatus(status)
+	if isLuaType(gr.GetSpec().Type) {
+		gr.SetLuaRecord(getLuaEmbeddedTypes(gr.GetSpec().Records))
+	} else {
+		gr.SetLuaRecord(nil)
+	}
+
+	// PTR records are maintained on a best-effort basis, the RRset stays available
+	reverseErr := reverseRecordsReconcile(ctx, gr, zone, defaultTTLByType, cl, PDNSClient)
+	if reverseErr != nil {
+		log.Error(reverseErr, "Failed to reconcile reverse records")
 	}
 
 	// This Patch is very important:
@@ -262,22 +874,225 @@ func rrsetReconcile(ctx context.Context, gr dnsv1alpha2.GenericRRset, zone dnsv1
 	// Metrics calculation
 	updateRrsetsMetrics(getRRsetName(gr), gr)
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{}, true, reverseErr
 }
 
-func getZoneExternalResources(ctx context.Context, domain string, PDNSClient PdnsClienter, log logr.Logger) (*powerdns.Zone, error) {
-	zoneRes, err := PDNSClient.Zones.Get(ctx, domain)
-	if err != nil {
-		if err.Error() != ZONE_NOT_FOUND_MSG {
-			log.Error(err, "Failed to get zone")
-			return nil, err
+// isReplaced returns true if another RRset or ClusterRRset, not being deleted, has the DNS entry of a deleted RRset:
+// a resource recreated while the previous one is still being deleted (e.g. ACME challenge records) manages
+// the records, they are kept in PowerDNS
+func isReplaced(ctx context.Context, gr dnsv1alpha2.GenericRRset, cl client.Client) (bool, error) {
+	for _, entry := range getRRsetEntries(gr) {
+		var rrsets dnsv1alpha2.RRsetList
+		if err := cl.List(ctx, &rrsets, client.MatchingFields{"RRset.Entry.Name": entry}); err != nil {
+			return false, err
+		}
+		for _, r := range rrsets.Items {
+			if r.UID != gr.GetUID() && r.DeletionTimestamp.IsZero() {
+				return true, nil
+			}
+		}
+		var clusterRRsets dnsv1alpha2.ClusterRRsetList
+		if err := cl.List(ctx, &clusterRRsets, client.MatchingFields{"ClusterRRset.Entry.Name": entry}); err != nil {
+			return false, err
+		}
+		for _, c := range clusterRRsets.Items {
+			if c.UID != gr.GetUID() && c.DeletionTimestamp.IsZero() {
+				return true, nil
+			}
 		}
 	}
-	return zoneRes, nil
+	return false, nil
 }
 
-func createZoneExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZone, PDNSClient PdnsClienter, log logr.Logger) error {
-	// Make Nameservers canonical
+// isOverridden returns true if the RRset is a ClusterRRset suppressed by a RRset with the same DNS name
+func isOverridden(gr dnsv1alpha2.GenericRRset) bool {
+	condition := meta.FindStatusCondition(gr.GetStatus().Conditions, "Available")
+	return condition != nil && condition.Reason == dnsv1alpha2.OVERRIDDEN_REASON
+}
+
+// listReverseZones returns the zones a RRset may maintain PTR records in:
+// the ClusterZones, and the Zones of its namespace
+func listReverseZones(ctx context.Context, gr dnsv1alpha2.GenericRRset, cl client.Client) ([]dnsv1alpha2.GenericZone, error) {
+	var zones []dnsv1alpha2.GenericZone
+	var clusterZones dnsv1alpha2.ClusterZoneList
+	if err := cl.List(ctx, &clusterZones); err != nil {
+		return nil, err
+	}
+	for i := range clusterZones.Items {
+		zones = append(zones, &clusterZones.Items[i])
+	}
+	if gr.GetNamespace() != "" {
+		var namespacedZones dnsv1alpha2.ZoneList
+		if err := cl.List(ctx, &namespacedZones, client.InNamespace(gr.GetNamespace())); err != nil {
+			return nil, err
+		}
+		for i := range namespacedZones.Items {
+			zones = append(zones, &namespacedZones.Items[i])
+		}
+	}
+	return zones, nil
+}
+
+// reverseRecordsReconcile maintains the PTR records of the addresses of a RRset with manageReverse
+// in their reverse zones, PTR records which are no longer expected are deleted
+func reverseRecordsReconcile(ctx context.Context, gr dnsv1alpha2.GenericRRset, zone dnsv1alpha2.GenericZone, defaultTTLByType map[string]uint32, cl client.Client, PDNSClient PdnsClienter) error {
+	manageReverse := ptr.Deref(gr.GetSpec().ManageReverse, false)
+	previous := gr.GetStatus().ReverseRecords
+	if !manageReverse && len(previous) == 0 {
+		status := gr.GetStatus()
+		meta.RemoveStatusCondition(&status.Conditions, dnsv1alpha2.REVERSE_MANAGED_CONDITION)
+		gr.SetStatus(status)
+		return nil
+	}
+
+	var expected []dnsv1alpha2.ReverseRecord
+	var missing []string
+	if manageReverse {
+		zones, err := listReverseZones(ctx, gr, cl)
+		if err != nil {
+			gr.SetReverseManaged(previous, nil, err)
+			return err
+		}
+		ttl := getRRsetTTL(zone, gr, defaultTTLByType)
+		for _, record := range getRRsetRecords(gr) {
+			addr, err := netip.ParseAddr(record)
+			if err != nil {
+				continue
+			}
+			name := reverseName(addr)
+			reverseZone := findReverseZone(name, zones)
+			if reverseZone == nil {
+				missing = append(missing, record)
+				continue
+			}
+			zoneRef := dnsv1alpha2.ZoneRef{Name: reverseZone.GetName(), Kind: "ClusterZone"}
+			if _, ok := reverseZone.(*dnsv1alpha2.Zone); ok {
+				zoneRef.Kind = "Zone"
+			}
+			ptrRRset := &dnsv1alpha2.ClusterRRset{
+				Spec: dnsv1alpha2.RRsetSpec{
+					Type:    string(powerdns.RRTypePTR),
+					Name:    name,
+					TTL:     ttl,
+					Records: []string{getRRsetName(gr)},
+					ZoneRef: zoneRef,
+				},
+			}
+			if _, err := createOrUpdateRrsetExternalResources(ctx, reverseZone, ptrRRset, defaultTTLByType, PDNSClient); err != nil {
+				// Keep track of all PTR records which may exist, to delete them later
+				gr.SetReverseManaged(mergeReverseRecords(previous, expected), missing, err)
+				return err
+			}
+			expected = append(expected, dnsv1alpha2.ReverseRecord{Name: name, ZoneRef: zoneRef})
+		}
+	}
+
+	for _, reverseRecord := range previous {
+		if slices.Contains(expected, reverseRecord) {
+			continue
+		}
+		if err := PDNSClient.Records.Delete(ctx, reverseRecord.ZoneRef.Name, reverseRecord.Name, powerdns.RRTypePTR); err != nil && !isPdnsNotFound(err) {
+			gr.SetReverseManaged(mergeReverseRecords(previous, expected), missing, err)
+			return err
+		}
+	}
+
+	if !manageReverse {
+		status := gr.GetStatus()
+		status.ReverseRecords = nil
+		meta.RemoveStatusCondition(&status.Conditions, dnsv1alpha2.REVERSE_MANAGED_CONDITION)
+		gr.SetStatus(status)
+		return nil
+	}
+	gr.SetReverseManaged(expected, missing, nil)
+	return nil
+}
+
+// mergeReverseRecords returns the reverse records of a, completed by the ones of b
+func mergeReverseRecords(a, b []dnsv1alpha2.ReverseRecord) []dnsv1alpha2.ReverseRecord {
+	result := slices.Clone(a)
+	for _, reverseRecord := range b {
+		if !slices.Contains(result, reverseRecord) {
+			result = append(result, reverseRecord)
+		}
+	}
+	return result
+}
+
+// deleteReverseRecordsExternalResources deletes the PTR records maintained for a RRset
+func deleteReverseRecordsExternalResources(ctx context.Context, gr dnsv1alpha2.GenericRRset, PDNSClient PdnsClienter, log logr.Logger) error {
+	if isOrphanedOnDelete(gr) {
+		return nil
+	}
+	for _, reverseRecord := range gr.GetStatus().ReverseRecords {
+		if err := PDNSClient.Records.Delete(ctx, reverseRecord.ZoneRef.Name, reverseRecord.Name, powerdns.RRTypePTR); err != nil && !isPdnsNotFound(err) {
+			log.Error(err, "Failed to delete reverse record", "reverseRecord", reverseRecord.Name)
+			return err
+		}
+	}
+	return nil
+}
+
+// rrsetConflicts lists the RRsets and ClusterRRsets, other than gr, sharing its DNS name and type, with their zone
+func rrsetConflicts(gr dnsv1alpha2.GenericRRset, rrsets []dnsv1alpha2.RRset, clusterRRsets []dnsv1alpha2.ClusterRRset) []string {
+	_, isClusterRRset := gr.(*dnsv1alpha2.ClusterRRset)
+	conflicts := make([]string, 0, len(rrsets)+len(clusterRRsets))
+	for _, r := range rrsets {
+		if !isClusterRRset && r.Namespace == gr.GetNamespace() && r.Name == gr.GetName() {
+			continue
+		}
+		conflicts = append(conflicts, fmt.Sprintf("RRset %s/%s in zone %s", r.Namespace, r.Name, r.Spec.ZoneRef.Name))
+	}
+	for _, c := range clusterRRsets {
+		if isClusterRRset && c.Name == gr.GetName() {
+			continue
+		}
+		conflicts = append(conflicts, fmt.Sprintf("ClusterRRset %s in zone %s", c.Name, c.Spec.ZoneRef.Name))
+	}
+	slices.Sort(conflicts)
+	return conflicts
+}
+
+// rrsetHasCnameConflict checks whether the RRset is a CNAME at the zone apex, or
+// whether a CNAME and another type share the DNS name of the RRset
+func rrsetHasCnameConflict(ctx context.Context, gr dnsv1alpha2.GenericRRset, zone dnsv1alpha2.GenericZone, cl client.Client) (bool, error) {
+	name := getRRsetName(gr)
+	var existingRRsets dnsv1alpha2.RRsetList
+	if err := cl.List(ctx, &existingRRsets, client.MatchingFields{"RRset.Entry.FQDN": name}); err != nil {
+		return false, err
+	}
+	var existingClusterRRsets dnsv1alpha2.ClusterRRsetList
+	if err := cl.List(ctx, &existingClusterRRsets, client.MatchingFields{"ClusterRRset.Entry.FQDN": name}); err != nil {
+		return false, err
+	}
+
+	var otherTypes []string
+	for _, rrset := range existingRRsets.Items {
+		if rrset.GetUID() != gr.GetUID() {
+			otherTypes = append(otherTypes, rrset.Spec.Type)
+		}
+	}
+	for _, rrset := range existingClusterRRsets.Items {
+		if rrset.GetUID() != gr.GetUID() {
+			otherTypes = append(otherTypes, rrset.Spec.Type)
+		}
+	}
+	return isCnameConflict(gr.GetSpec().Type, name, zone.GetObjectMeta().Name, otherTypes), nil
+}
+
+func getZoneExternalResources(ctx context.Context, domain string, PDNSClient PdnsClienter, log logr.Logger) (*powerdns.Zone, error) {
+	zoneRes, err := PDNSClient.Zones.Get(ctx, domain)
+	if err != nil {
+		if !isPdnsNotFound(err) {
+			log.Error(err, "Failed to get zone")
+			return nil, err
+		}
+	}
+	return zoneRes, nil
+}
+
+func createZoneExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZone, PDNSClient PdnsClienter, log logr.Logger) error {
+	// Make Nameservers canonical
 	for i, ns := range zone.GetSpec().Nameservers {
 		zone.GetSpec().Nameservers[i] = makeCanonical(ns)
 	}
@@ -288,22 +1103,48 @@ func createZoneExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZo
 		catalog = ptr.To(makeCanonical(ptr.Deref(zone.GetSpec().Catalog, "")))
 	}
 
+	dnssec, presigned := getZoneSigning(zone)
 	z := powerdns.Zone{
 		ID:          &zone.GetObjectMeta().Name,
 		Name:        &zone.GetObjectMeta().Name,
 		Kind:        powerdns.ZoneKindPtr(powerdns.ZoneKind(zone.GetSpec().Kind)),
-		DNSsec:      ptr.To(false),
-		SOAEditAPI:  zone.GetSpec().SOAEditAPI,
+		DNSsec:      ptr.To(ptr.Deref(dnssec, false)),
+		Presigned:   presigned,
+		SOAEditAPI:  getSOAEditAPI(zone),
 		Nameservers: zone.GetSpec().Nameservers,
+		Masters:     zone.GetSpec().Masters,
 		Catalog:     catalog,
+		Account:     zone.GetSpec().Account,
+	}
+	// Nameservers of Slave zones are transferred from the masters
+	if isSlaveZone(zone) {
+		z.Nameservers = nil
 	}
 
 	_, err := PDNSClient.Zones.Add(ctx, &z)
 	if err != nil {
-		log.Error(err, "Failed to create zone")
+		// A conflict is handled by the caller
+		if !isPdnsConflict(err) {
+			log.Error(err, "Failed to create zone")
+		}
 		return err
 	}
 
+	// NS records are created by PowerDNS with the default TTL
+	if isNSManaged(zone) && zone.GetSpec().NameserverTTL != nil {
+		if err := updateNsOnZoneExternalResources(ctx, zone, *zone.GetSpec().NameserverTTL, PDNSClient, log); err != nil {
+			return err
+		}
+	}
+
+	// Do not wait for the first refresh to transfer the content of a Slave zone
+	if isSlaveZone(zone) && len(zone.GetSpec().Masters) > 0 {
+		if _, err := PDNSClient.Zones.AxfrRetrieve(ctx, zone.GetObjectMeta().Name); err != nil {
+			// The transfer is retried by PowerDNS on next refresh, it is not an error
+			log.Error(err, "Failed to retrieve zone from masters")
+		}
+	}
+
 	return nil
 }
 
@@ -316,12 +1157,17 @@ func updateZoneExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZo
 		catalog = ptr.To(makeCanonical(ptr.Deref(zone.GetSpec().Catalog, "")))
 	}
 
+	dnssec, presigned := getZoneSigning(zone)
 	err := PDNSClient.Zones.Change(ctx, zone.GetObjectMeta().Name, &powerdns.Zone{
 		Name:        &zone.GetObjectMeta().Name,
 		Kind:        &zoneKind,
 		Nameservers: zone.GetSpec().Nameservers,
+		Masters:     zone.GetSpec().Masters,
 		Catalog:     catalog,
-		SOAEditAPI:  zone.GetSpec().SOAEditAPI,
+		SOAEditAPI:  getSOAEditAPI(zone),
+		DNSsec:      dnssec,
+		Presigned:   presigned,
+		Account:     zone.GetSpec().Account,
 	})
 	if err != nil {
 		log.Error(err, "Failed to update zone")
@@ -336,6 +1182,7 @@ func updateNsOnZoneExternalResources(ctx context.Context, zone dnsv1alpha2.Gener
 		nameserversCanonical = append(nameserversCanonical, makeCanonical(n))
 	}
 
+	defer lockZoneChange(PDNSClient.Records, zone.GetObjectMeta().Name)()
 	err := PDNSClient.Records.Change(ctx, makeCanonical(zone.GetObjectMeta().Name), makeCanonical(zone.GetObjectMeta().Name), powerdns.RRTypeNS, ttl, nameserversCanonical)
 	if err != nil {
 		log.Error(err, "Failed to update NS in zone")
@@ -344,113 +1191,861 @@ func updateNsOnZoneExternalResources(ctx context.Context, zone dnsv1alpha2.Gener
 	return nil
 }
 
-func deleteZoneExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZone, PDNSClient PdnsClienter, log logr.Logger) error {
+// deleteZoneExternalResources deletes the zone from PowerDNS, an orphaned zone is kept and, with the
+// delete-managed-records annotation, only the records of rrsets managed by the operator are deleted from it
+func deleteZoneExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZone, rrsets []dnsv1alpha2.GenericRRset, PDNSClient PdnsClienter, log logr.Logger) error {
+	if isOrphanedOnDelete(zone) {
+		if deletesManagedRecords(zone) {
+			if err := deleteManagedRecords(ctx, zone, rrsets, PDNSClient, log); err != nil {
+				return err
+			}
+		}
+		// The zone is no longer managed by the operator, it is not marked as adopted anymore
+		log.Info("Zone deleted with the orphan-on-delete annotation, keeping it in PowerDNS")
+		return zoneMetadataReconcile(ctx, zone.GetObjectMeta().Name, OWNER_METADATA_KIND, nil, PDNSClient, log)
+	}
 	err := PDNSClient.Zones.Delete(ctx, zone.GetObjectMeta().Name)
 	// Zone may have already been deleted and it is not an error
-	if err != nil && err.Error() != ZONE_NOT_FOUND_MSG {
+	if err != nil && !isPdnsNotFound(err) {
 		log.Error(err, "Failed to delete zone")
 		return err
 	}
 	return nil
 }
 
+// deleteManagedRecords deletes from the zone the records of the RRsets managed by the operator, the records added
+// outside of the operator are kept. Orphaned RRsets keep their records, overridden ClusterRRsets have none
+func deleteManagedRecords(ctx context.Context, zone dnsv1alpha2.GenericZone, rrsets []dnsv1alpha2.GenericRRset, PDNSClient PdnsClienter, log logr.Logger) error {
+	for _, rrset := range rrsets {
+		if isOrphanedOnDelete(rrset) || isOverridden(rrset) {
+			continue
+		}
+		name := getRRsetName(rrset)
+		for _, rrType := range getRRsetTypes(rrset) {
+			log.V(1).Info("Deleting records managed in the orphaned zone", "fqdn", name, "type", rrType)
+			if err := PDNSClient.Records.Delete(ctx, zone.GetObjectMeta().Name, name, powerdns.RRType(rrType)); err != nil && !isPdnsNotFound(err) {
+				log.Error(err, "Failed to delete record", "fqdn", name, "type", rrType)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func zoneExternalResourcesReconcile(ctx context.Context, zoneRes *powerdns.Zone, gz dnsv1alpha2.GenericZone, PDNSClient PdnsClienter, log logr.Logger) error {
 	if zoneRes.Name == nil {
 		// If Zone does not exist, create it
 		err := createZoneExternalResources(ctx, gz, PDNSClient, log)
-		if err != nil {
+		if err == nil {
+			return nil
+		}
+		if !isPdnsConflict(err) {
 			log.Error(err, "Failed to create external resources")
 			return err
 		}
-	} else {
-		// If Zone exists, compare content and update it if necessary
-		ns, err := PDNSClient.Records.Get(ctx, gz.GetObjectMeta().Name, gz.GetObjectMeta().Name, ptr.To(powerdns.RRTypeNS))
+		// The zone has been created meanwhile (concurrent reconcile, operator restart),
+		// it is reconciled as an existing zone
+		log.Info("Zone already exists in PowerDNS, reconciling it as an existing zone")
+		gz.SetCreationConflict()
+		zoneRes, err = PDNSClient.Zones.Get(ctx, gz.GetObjectMeta().Name)
 		if err != nil {
+			log.Error(err, "Failed to get zone")
 			return err
 		}
+	}
+	// Zone exists, compare content and update it if necessary
+	ns, err := PDNSClient.Records.Get(ctx, gz.GetObjectMeta().Name, gz.GetObjectMeta().Name, ptr.To(powerdns.RRTypeNS))
+	if err != nil {
+		return err
+	}
 
-		// An issue exist on GET API Calls, comments for another RRSet are included although we filter
-		// See https://github.com/PowerDNS/pdns/issues/14539
-		// See https://github.com/PowerDNS/pdns/pull/14045
-		var filteredRRset powerdns.RRset
-		for _, rr := range ns {
-			if *rr.Name == makeCanonical(gz.GetObjectMeta().Name) && *rr.Type == powerdns.RRTypeNS {
-				filteredRRset = rr
-			}
+	filteredRRset := filterRRset(ns, gz.GetObjectMeta().Name, powerdns.RRTypeNS)
+	var nameservers []string
+	for _, n := range filteredRRset.Records {
+		nameservers = append(nameservers, strings.TrimSuffix(*n.Content, "."))
+	}
+
+	// Workflow is different on update types:
+	// Nameservers changes  => patch RRSet
+	// Other changes        => patch Zone
+	zoneIdentical, nsIdentical := zoneIsIdenticalToExternalZone(gz, zoneRes, nameservers)
+
+	// Nameservers changes, including their TTL
+	ttl := getNameserverTTL(gz, filteredRRset.TTL)
+	if isNSManaged(gz) && ptr.Deref(filteredRRset.TTL, ttl) != ttl {
+		nsIdentical = false
+	}
+	if !nsIdentical {
+		err := updateNsOnZoneExternalResources(ctx, gz, ttl, PDNSClient, log)
+		if err != nil {
+			log.Error(err, "Failed to update NS in zone")
+			return err
+		}
+	}
+	// Other changes
+	if !zoneIdentical {
+		err := updateZoneExternalResources(ctx, gz, PDNSClient, log)
+		if err != nil {
+			log.Error(err, "Failed to update zone")
+			return err
 		}
-		var nameservers []string
-		for _, n := range filteredRRset.Records {
-			nameservers = append(nameservers, strings.TrimSuffix(*n.Content, "."))
+	}
+	return nil
+}
+
+// nameserverGlueExternalResourcesReconcile creates, updates or deletes the A/AAAA glue records of in-bailiwick nameservers,
+// with the TTL of the NS records of the zone. The records of the RRsets of the zone (rrsets) are left untouched
+func nameserverGlueExternalResourcesReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, rrsets []dnsv1alpha2.GenericRRset, PDNSClient PdnsClienter, log logr.Logger) error {
+	if err := validateNameserverGlue(gz); err != nil {
+		log.Error(err, "Invalid nameserver glue")
+		return err
+	}
+
+	status := gz.GetStatus()
+	zoneName := gz.GetObjectMeta().Name
+	managedEntries := map[string]string{}
+	for _, rrset := range rrsets {
+		for _, entry := range getRRsetEntries(rrset) {
+			managedEntries[strings.ToLower(entry)] = rrset.GetName()
 		}
+	}
+	for nameserver, addresses := range gz.GetSpec().NameserverGlue {
+		desired, _ := getGlueRecords(addresses)
+		for rrType := range desired {
+			if rrsetName, ok := managedEntries[strings.ToLower(makeCanonical(nameserver)+"/"+string(rrType))]; ok {
+				err := fmt.Errorf("glue defined for %s which is managed by the %s RRset %s", nameserver, rrType, rrsetName)
+				log.Error(err, "Invalid nameserver glue")
+				return err
+			}
+		}
+	}
 
-		// Workflow is different on update types:
-		// Nameservers changes  => patch RRSet
-		// Other changes        => patch Zone
-		zoneIdentical, nsIdentical := zoneIsIdenticalToExternalZone(gz, zoneRes, nameservers)
+	// Delete glue records no longer expected
+	for nameserver, addresses := range status.NameserverGlue {
+		previous, err := getGlueRecords(addresses)
+		if err != nil {
+			return err
+		}
+		desired, _ := getGlueRecords(gz.GetSpec().NameserverGlue[nameserver])
+		for rrType := range previous {
+			if _, ok := desired[rrType]; ok {
+				continue
+			}
+			// The record is now managed by a RRset of the zone
+			if _, ok := managedEntries[strings.ToLower(makeCanonical(nameserver)+"/"+string(rrType))]; ok {
+				continue
+			}
+			// A glue record already deleted from PowerDNS is not an error
+			unlock := lockZoneChange(PDNSClient.Records, zoneName)
+			err := PDNSClient.Records.Delete(ctx, zoneName, makeCanonical(nameserver), rrType)
+			unlock()
+			if err != nil && !isPdnsNotFound(err) {
+				log.Error(err, "Failed to delete glue record", "nameserver", nameserver, "type", rrType)
+				return err
+			}
+		}
+	}
 
-		// Nameservers changes
-		if !nsIdentical {
-			ttl := ptr.To(DEFAULT_TTL_FOR_NS_RECORDS)
-			if filteredRRset.TTL != nil {
-				ttl = filteredRRset.TTL
+	// Create or update expected glue records, with the TTL of the NS records
+	var ttl uint32
+	if len(gz.GetSpec().NameserverGlue) > 0 {
+		ns, err := PDNSClient.Records.Get(ctx, zoneName, zoneName, ptr.To(powerdns.RRTypeNS))
+		if err != nil && !isPdnsNotFound(err) {
+			return err
+		}
+		ttl = getNameserverTTL(gz, filterRRset(ns, zoneName, powerdns.RRTypeNS).TTL)
+	}
+	for nameserver, addresses := range gz.GetSpec().NameserverGlue {
+		desired, _ := getGlueRecords(addresses)
+		for rrType, contents := range desired {
+			records, err := PDNSClient.Records.Get(ctx, zoneName, makeCanonical(nameserver), &rrType)
+			if err != nil && !isPdnsNotFound(err) {
+				return err
+			}
+			existingRRset := filterRRset(records, nameserver, rrType)
+			var existing []string
+			for _, r := range existingRRset.Records {
+				existing = append(existing, *r.Content)
+			}
+			// The order of the addresses does not matter
+			if slices.Equal(slices.Sorted(slices.Values(existing)), slices.Sorted(slices.Values(contents))) && ptr.Deref(existingRRset.TTL, ttl) == ttl {
+				continue
 			}
-			err := updateNsOnZoneExternalResources(ctx, gz, *ttl, PDNSClient, log)
+			unlock := lockZoneChange(PDNSClient.Records, zoneName)
+			err = PDNSClient.Records.Change(ctx, zoneName, makeCanonical(nameserver), rrType, ttl, contents)
+			unlock()
 			if err != nil {
-				log.Error(err, "Failed to update NS in zone")
+				log.Error(err, "Failed to update glue record", "nameserver", nameserver, "type", rrType)
 				return err
 			}
 		}
-		// Other changes
-		if !zoneIdentical {
-			err := updateZoneExternalResources(ctx, gz, PDNSClient, log)
-			if err != nil {
-				log.Error(err, "Failed to update zone")
+	}
+
+	status.NameserverGlue = nil
+	if len(gz.GetSpec().NameserverGlue) > 0 {
+		status.NameserverGlue = make(map[string][]string, len(gz.GetSpec().NameserverGlue))
+		for nameserver, addresses := range gz.GetSpec().NameserverGlue {
+			status.NameserverGlue[nameserver] = slices.Clone(addresses)
+		}
+	}
+	gz.SetStatus(status)
+	return nil
+}
+
+// nsec3ParamsExternalResourcesReconcile applies the NSEC3PARAM metadata of DNSSEC signed zones
+func nsec3ParamsExternalResourcesReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, PDNSClient PdnsClienter, log logr.Logger) error {
+	status := gz.GetStatus()
+	if !ptr.Deref(gz.GetSpec().DNSSEC, false) {
+		status.Nsec3Params = nil
+		gz.SetStatus(status)
+		return nil
+	}
+
+	zoneName := gz.GetObjectMeta().Name
+	var current string
+	metadata, err := PDNSClient.Metadata.Get(ctx, zoneName, powerdns.MetadataNSEC3Param)
+	if err != nil && !isPdnsNotFound(err) {
+		log.Error(err, "Failed to get NSEC3PARAM metadata")
+		return err
+	}
+	if err == nil && len(metadata.Metadata) > 0 {
+		current = metadata.Metadata[0]
+	}
+
+	desired := ptr.Deref(gz.GetSpec().Nsec3Params, "")
+	switch {
+	case desired != "" && desired != current:
+		if _, err := PDNSClient.Metadata.Set(ctx, zoneName, powerdns.MetadataNSEC3Param, []string{desired}); err != nil {
+			log.Error(err, "Failed to set NSEC3PARAM metadata")
+			return err
+		}
+		current = desired
+	case desired == "" && current != "" && status.Nsec3Params != nil:
+		// NSEC3 was configured by the operator, switch back to NSEC
+		if err := PDNSClient.Metadata.Delete(ctx, zoneName, powerdns.MetadataNSEC3Param); err != nil {
+			log.Error(err, "Failed to delete NSEC3PARAM metadata")
+			return err
+		}
+		current = ""
+	}
+
+	status.Nsec3Params = nil
+	if current != "" {
+		status.Nsec3Params = ptr.To(current)
+	}
+	gz.SetStatus(status)
+	return nil
+}
+
+// soaExternalResourcesReconcile applies the SOA parameters and the comment of the zone to its apex SOA record, when defined
+func soaExternalResourcesReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, PDNSClient PdnsClienter, log logr.Logger) error {
+	soa := gz.GetSpec().SOA
+	comment := gz.GetSpec().Comment
+	if (soa == nil && comment == nil) || isSlaveZone(gz) {
+		return nil
+	}
+
+	zoneName := gz.GetObjectMeta().Name
+	// The SOA record is read and written back under the lock of the zone, so that no concurrent write changes the serial in between
+	defer lockZoneChange(PDNSClient.Records, zoneName)()
+	rrsets, err := PDNSClient.Records.Get(ctx, zoneName, zoneName, ptr.To(powerdns.RRTypeSOA))
+	if err != nil {
+		log.Error(err, "Failed to get SOA record")
+		return err
+	}
+	current := filterRRset(rrsets, zoneName, powerdns.RRTypeSOA)
+	if current.Name == nil || len(current.Records) == 0 {
+		return fmt.Errorf("SOA record of zone %s not found", zoneName)
+	}
+
+	content := *current.Records[0].Content
+	if soa != nil {
+		if content, err = getSOAContent(content, soa); err != nil {
+			return err
+		}
+	}
+	// The comments of the SOA record are only managed with the comment of the zone
+	var options []func(*powerdns.RRset)
+	commentsIdentical := true
+	if comment != nil {
+		comments := []powerdns.Comment{{Content: ptr.To(*comment), Account: ptr.To(OPERATOR_COMMENT_ACCOUNT)}}
+		commentsIdentical = commentsAreIdentical(comments, current.Comments)
+		options = append(options, powerdns.WithComments(comments...))
+	}
+	if content == *current.Records[0].Content && commentsIdentical {
+		return nil
+	}
+	if err := PDNSClient.Records.Change(ctx, zoneName, makeCanonical(zoneName), powerdns.RRTypeSOA, ptr.Deref(current.TTL, DEFAULT_TTL_FOR_NS_RECORDS), []string{content}, options...); err != nil {
+		log.Error(err, "Failed to update SOA record")
+		return err
+	}
+	return nil
+}
+
+// serialExternalResourcesReconcile sets the SOA serial of the zone with its serial strategy, when managed by the operator.
+// The serial is set when the records of the zone changed, or when the serial changed, since it was last set
+func serialExternalResourcesReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, PDNSClient PdnsClienter, log logr.Logger) error {
+	status := gz.GetStatus()
+	if !isSerialManaged(gz) {
+		status.AppliedSerial = nil
+		status.RecordsDigest = nil
+		gz.SetStatus(status)
+		return nil
+	}
+
+	zoneName := gz.GetObjectMeta().Name
+	// The serial is read, computed and written back under the lock of the zone, so that no concurrent write of its records
+	// lands in between
+	defer lockZoneChange(PDNSClient.Records, zoneName)()
+	zoneRes, err := PDNSClient.Zones.Get(ctx, zoneName)
+	if err != nil {
+		log.Error(err, "Failed to get zone")
+		return err
+	}
+	current := filterRRset(zoneRes.RRsets, zoneName, powerdns.RRTypeSOA)
+	if current.Name == nil || len(current.Records) == 0 {
+		return fmt.Errorf("SOA record of zone %s not found", zoneName)
+	}
+	fields := strings.Fields(*current.Records[0].Content)
+	if len(fields) != 7 {
+		return fmt.Errorf("invalid SOA record %q", *current.Records[0].Content)
+	}
+	serial, err := strconv.ParseUint(fields[2], 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid SOA serial %q", fields[2])
+	}
+
+	digest := getRecordsDigest(zoneName, zoneRes.RRsets)
+	if ptr.Deref(status.AppliedSerial, 0) == uint32(serial) && ptr.Deref(status.RecordsDigest, "") == digest {
+		return nil
+	}
+	next := getNextSerial(*gz.GetSpec().SerialStrategy, uint32(serial), time.Now())
+	fields[2] = strconv.FormatUint(uint64(next), 10)
+	// The comments of the SOA record are kept, they are managed with the comment of the zone
+	var options []func(*powerdns.RRset)
+	if gz.GetSpec().Comment != nil {
+		options = append(options, powerdns.WithComments(powerdns.Comment{Content: ptr.To(*gz.GetSpec().Comment), Account: ptr.To(OPERATOR_COMMENT_ACCOUNT)}))
+	}
+	if err := PDNSClient.Records.Change(ctx, zoneName, makeCanonical(zoneName), powerdns.RRTypeSOA, ptr.Deref(current.TTL, DEFAULT_TTL_FOR_NS_RECORDS), []string{strings.Join(fields, " ")}, options...); err != nil {
+		log.Error(err, "Failed to update SOA serial")
+		return err
+	}
+	log.Info("SOA serial updated", "serial", next, "strategy", *gz.GetSpec().SerialStrategy)
+
+	status.AppliedSerial = ptr.To(next)
+	status.RecordsDigest = ptr.To(digest)
+	gz.SetStatus(status)
+	return nil
+}
+
+// catalogMembershipReconcile reports the membership of the zone in its catalog with the CatalogMember condition.
+// A catalog removed from the specification is cleared in PowerDNS, as a nil catalog is not considered as a change
+func catalogMembershipReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, cl client.Client, PDNSClient PdnsClienter, log logr.Logger) error {
+	zoneName := gz.GetObjectMeta().Name
+	catalog := ptr.Deref(gz.GetSpec().Catalog, "")
+	if catalog == "" {
+		wasMember := meta.FindStatusCondition(gz.GetStatus().Conditions, dnsv1alpha2.CATALOG_MEMBER_CONDITION) != nil
+		if gz.GetSpec().Catalog == nil && wasMember {
+			zoneKind := powerdns.ZoneKind(gz.GetSpec().Kind)
+			dnssec, presigned := getZoneSigning(gz)
+			if err := PDNSClient.Zones.Change(ctx, zoneName, &powerdns.Zone{
+				Name:        &zoneName,
+				Kind:        &zoneKind,
+				Nameservers: gz.GetSpec().Nameservers,
+				Masters:     gz.GetSpec().Masters,
+				Catalog:     ptr.To(""),
+				SOAEditAPI:  getSOAEditAPI(gz),
+				DNSsec:      dnssec,
+				Presigned:   presigned,
+				Account:     gz.GetSpec().Account,
+			}); err != nil {
+				log.Error(err, "Failed to remove zone from catalog")
 				return err
 			}
 		}
+		gz.SetCatalogMember("", nil)
+		return nil
+	}
+	catalog = makeCanonical(catalog)
+
+	// The catalog zone must exist in PowerDNS, and be a Producer (or Consumer) zone
+	catalogRes, err := getZoneExternalResources(ctx, catalog, PDNSClient, log)
+	if err != nil {
+		return err
+	}
+	switch {
+	case catalogRes.Name == nil:
+		err = fmt.Errorf("zone %s not found", catalog)
+		if managed, listErr := isManagedZone(ctx, strings.TrimSuffix(catalog, "."), cl); listErr != nil {
+			return listErr
+		} else if managed {
+			err = fmt.Errorf("zone %s not yet created", catalog)
+		}
+	case !slices.Contains([]powerdns.ZoneKind{powerdns.ProducerZoneKind, powerdns.ConsumerZoneKind}, ptr.Deref(catalogRes.Kind, "")):
+		err = fmt.Errorf("zone %s is not a Producer or Consumer zone", catalog)
 	}
+	gz.SetCatalogMember(catalog, err)
 	return nil
 }
 
-func deleteRrsetExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZone, rrset dnsv1alpha2.GenericRRset, PDNSClient PdnsClienter, log logr.Logger) error {
-	err := PDNSClient.Records.Delete(ctx, zone.GetObjectMeta().Name, getRRsetName(rrset), powerdns.RRType(rrset.GetSpec().Type))
+// catalogMembersReconcile keeps the member PTR records of a catalog zone in sync with the Zones/ClusterZones referencing it
+func catalogMembersReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, cl client.Client, PDNSClient PdnsClienter, log logr.Logger) error {
+	status := gz.GetStatus()
+	if !isCatalogZone(gz) {
+		status.CatalogMembers = nil
+		gz.SetStatus(status)
+		return nil
+	}
+
+	catalog := gz.GetObjectMeta().Name
+	members, err := getCatalogMembers(ctx, catalog, cl)
 	if err != nil {
-		log.Error(err, "Failed to delete record")
 		return err
 	}
+	if slices.Equal(members, status.CatalogMembers) {
+		return nil
+	}
+
+	if err := PDNSClient.Records.Change(ctx, catalog, "version."+makeCanonical(catalog), powerdns.RRTypeTXT, DEFAULT_TTL_FOR_RRSETS, []string{CATALOG_ZONE_VERSION}); err != nil {
+		log.Error(err, "Failed to update catalog version")
+		return err
+	}
+	// Remove members which no longer reference the catalog
+	for _, member := range status.CatalogMembers {
+		if slices.Contains(members, member) {
+			continue
+		}
+		if err := PDNSClient.Records.Delete(ctx, catalog, getCatalogMemberName(catalog, member), powerdns.RRTypePTR); err != nil && !isPdnsNotFound(err) {
+			log.Error(err, "Failed to remove catalog member", "member", member)
+			return err
+		}
+	}
+	for _, member := range members {
+		if slices.Contains(status.CatalogMembers, member) {
+			continue
+		}
+		if err := PDNSClient.Records.Change(ctx, catalog, getCatalogMemberName(catalog, member), powerdns.RRTypePTR, DEFAULT_TTL_FOR_RRSETS, []string{member}); err != nil {
+			log.Error(err, "Failed to add catalog member", "member", member)
+			return err
+		}
+	}
 
+	status.CatalogMembers = members
+	gz.SetStatus(status)
 	return nil
 }
 
-func createOrUpdateRrsetExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZone, rrset dnsv1alpha2.GenericRRset, PDNSClient PdnsClienter) (bool, error) {
+// getCatalogMembers returns the sorted canonical names of the Zones/ClusterZones, not being deleted, referencing the catalog
+func getCatalogMembers(ctx context.Context, catalog string, cl client.Client) ([]string, error) {
+	key := strings.TrimSuffix(strings.ToLower(catalog), ".")
+	var zones dnsv1alpha2.ZoneList
+	if err := cl.List(ctx, &zones, client.MatchingFields{"Zone.Spec.Catalog": key}); err != nil {
+		return nil, err
+	}
+	var clusterZones dnsv1alpha2.ClusterZoneList
+	if err := cl.List(ctx, &clusterZones, client.MatchingFields{"ClusterZone.Spec.Catalog": key}); err != nil {
+		return nil, err
+	}
+
+	var members []string
+	for _, z := range zones.Items {
+		if z.DeletionTimestamp.IsZero() {
+			members = append(members, makeCanonical(z.Name))
+		}
+	}
+	for _, z := range clusterZones.Items {
+		if z.DeletionTimestamp.IsZero() && z.Name != key {
+			members = append(members, makeCanonical(z.Name))
+		}
+	}
+	slices.Sort(members)
+	return slices.Compact(members), nil
+}
+
+// isManagedZone returns true if a Zone or a ClusterZone exists with the name
+func isManagedZone(ctx context.Context, name string, cl client.Client) (bool, error) {
+	var zones dnsv1alpha2.ZoneList
+	if err := cl.List(ctx, &zones, client.MatchingFields{"Zone.Entry.Name": name}); err != nil {
+		return false, err
+	}
+	var clusterZones dnsv1alpha2.ClusterZoneList
+	if err := cl.List(ctx, &clusterZones, client.MatchingFields{"ClusterZone.Entry.Name": name}); err != nil {
+		return false, err
+	}
+	return len(zones.Items)+len(clusterZones.Items) > 0, nil
+}
+
+// axfrRetrieveReconcile requests a transfer of a Slave zone from its masters when the retrieve-axfr annotation is set
+// The annotation is removed afterwards, whatever the kind of zone and the outcome of the retrieve
+func axfrRetrieveReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, cl client.Client, PDNSClient PdnsClienter, log logr.Logger) error {
+	if _, ok := gz.GetAnnotations()[RETRIEVE_AXFR_ANNOTATION]; !ok {
+		return nil
+	}
+
+	if isSlaveZone(gz) {
+		res, err := PDNSClient.Zones.AxfrRetrieve(ctx, gz.GetObjectMeta().Name)
+		if err != nil {
+			log.Error(err, "Failed to retrieve zone from masters")
+		}
+		var result string
+		if res != nil {
+			result = ptr.Deref(res.Result, "")
+		}
+		gz.SetAxfrRetrieved(result, err)
+	} else {
+		log.Info("Ignoring AXFR retrieve request on a zone which is not a Slave zone", "annotation", RETRIEVE_AXFR_ANNOTATION)
+	}
+
+	// Work on a copy, so that the status of the zone is not overwritten with the one of the API server
+	patched := gz.Copy()
+	annotations := patched.GetAnnotations()
+	delete(annotations, RETRIEVE_AXFR_ANNOTATION)
+	patched.SetAnnotations(annotations)
+	if err := cl.Patch(ctx, patched, client.MergeFrom(gz)); err != nil {
+		log.Error(err, "Failed to remove annotation", "annotation", RETRIEVE_AXFR_ANNOTATION)
+		return err
+	}
+	return nil
+}
+
+// rectifyReconcile rectifies the zone when the rectify annotation is set, or after a change of its DNSSEC signing
+// when auto-rectify is enabled, the outcome is recorded in the Rectified condition
+// The annotation is removed afterwards, whatever the outcome of the rectify
+func rectifyReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, cl client.Client, signingChanged bool, PDNSClient PdnsClienter, log logr.Logger) error {
+	_, requested := gz.GetAnnotations()[RECTIFY_ANNOTATION]
+	if !requested && (!signingChanged || !ptr.Deref(gz.GetSpec().AutoRectify, false)) {
+		return nil
+	}
+
+	res, err := PDNSClient.Zones.Rectify(ctx, gz.GetObjectMeta().Name)
+	if err != nil {
+		log.Error(err, "Failed to rectify zone")
+	}
+	var result string
+	if res != nil {
+		result = ptr.Deref(res.Result, "")
+	}
+	gz.SetRectified(result, err)
+
+	if !requested {
+		return nil
+	}
+	// Work on a copy, so that the status of the zone is not overwritten with the one of the API server
+	patched := gz.Copy()
+	annotations := patched.GetAnnotations()
+	delete(annotations, RECTIFY_ANNOTATION)
+	patched.SetAnnotations(annotations)
+	if err := cl.Patch(ctx, patched, client.MergeFrom(gz)); err != nil {
+		log.Error(err, "Failed to remove annotation", "annotation", RECTIFY_ANNOTATION)
+		return err
+	}
+	return nil
+}
+
+// zoneExportReconcile writes the BIND format export of the zone to a ConfigMap named after the zone when the export annotation is set,
+// in the namespace of a Zone, in the namespace given as annotation value for a ClusterZone
+// The export is regenerated when the serial of the zone changes
+func zoneExportReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, cl client.Client, PDNSClient PdnsClienter, log logr.Logger) error {
+	status := gz.GetStatus()
+	namespace, ok := gz.GetAnnotations()[EXPORT_ANNOTATION]
+	if !ok {
+		status.Export = nil
+		gz.SetStatus(status)
+		return nil
+	}
+	if _, isZone := gz.(*dnsv1alpha2.Zone); isZone {
+		namespace = gz.GetNamespace()
+	}
+	if namespace == "" {
+		return fmt.Errorf("the namespace of the export must be given as %s annotation value", EXPORT_ANNOTATION)
+	}
+
+	serial := ptr.Deref(status.Serial, 0)
+	if status.Export != nil && status.Export.Namespace == namespace && status.Export.Serial == serial {
+		return nil
+	}
+
+	export, err := PDNSClient.Zones.Export(ctx, gz.GetObjectMeta().Name)
+	if err != nil {
+		return err
+	}
+	if len(export) > EXPORT_MAX_SIZE {
+		return fmt.Errorf("export of %d bytes exceeds the maximum size of a ConfigMap", len(export))
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gz.GetName(),
+			Namespace: namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, cl, configMap, func() error {
+		configMap.Data = map[string]string{EXPORT_CONFIGMAP_KEY: string(export)}
+		return ctrl.SetControllerReference(gz, configMap, cl.Scheme())
+	})
+	if err != nil {
+		return err
+	}
+
+	status.Export = &dnsv1alpha2.ZoneExport{Namespace: namespace, ConfigMap: configMap.Name, Serial: serial}
+	gz.SetStatus(status)
+	return nil
+}
+
+// validateTSIGKeyRefs ensures the TSIGKeys referenced by the zone exist:
+// in the namespace of a Zone, in the namespace of the "<namespace>/<name>" reference for a ClusterZone
+func validateTSIGKeyRefs(ctx context.Context, gz dnsv1alpha2.GenericZone, cl client.Client) error {
+	for _, ref := range slices.Concat(gz.GetSpec().TSIGAllowAXFR, gz.GetSpec().TSIGAllowDNSUpdate) {
+		key, err := getTSIGKeyRefKey(gz, ref)
+		if err != nil {
+			return err
+		}
+		if err := cl.Get(ctx, key, &dnsv1alpha2.TSIGKey{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("TSIGKey %s not found", ref)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// tsigMetadataExternalResourcesReconcile applies the TSIG keys allowed to transfer and update the zone,
+// referenced by their name in PowerDNS
+func tsigMetadataExternalResourcesReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, PDNSClient PdnsClienter, log logr.Logger) error {
+	allowAXFR, err := getTSIGKeyPDNSNames(gz, gz.GetSpec().TSIGAllowAXFR)
+	if err != nil {
+		return err
+	}
+	allowDNSUpdate, err := getTSIGKeyPDNSNames(gz, gz.GetSpec().TSIGAllowDNSUpdate)
+	if err != nil {
+		return err
+	}
+	if err := zoneMetadataReconcile(ctx, gz.GetObjectMeta().Name, powerdns.MetadataTSIGAllowAXFR, allowAXFR, PDNSClient, log); err != nil {
+		return err
+	}
+	return zoneMetadataReconcile(ctx, gz.GetObjectMeta().Name, powerdns.MetadataTSIGAllowDNSUpdate, allowDNSUpdate, PDNSClient, log)
+}
+
+// alsoNotifyExternalResourcesReconcile applies the additional addresses notified on changes of the zone,
+// they are notified by PowerDNS along with the nameservers, including on NOTIFY sent on RRsets changes (notifyOnChange)
+func alsoNotifyExternalResourcesReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, PDNSClient PdnsClienter, log logr.Logger) error {
+	alsoNotify := gz.GetSpec().AlsoNotify
+	if err := zoneMetadataReconcile(ctx, gz.GetObjectMeta().Name, powerdns.MetadataAlsoNotify, alsoNotify, PDNSClient, log); err != nil {
+		return err
+	}
+	status := gz.GetStatus()
+	status.AlsoNotify = slices.Clone(alsoNotify)
+	gz.SetStatus(status)
+	return nil
+}
+
+// allowAXFRFromExternalResourcesReconcile applies the networks allowed to transfer the zone, the metadata is deleted
+// when omitted, unless it is managed through spec.metadata
+func allowAXFRFromExternalResourcesReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, PDNSClient PdnsClienter, log logr.Logger) error {
+	allowAXFRFrom := gz.GetSpec().AllowAXFRFrom
+	if _, ok := gz.GetSpec().Metadata[string(powerdns.MetadataAllowAXFRFrom)]; allowAXFRFrom == nil && ok {
+		return nil
+	}
+	return zoneMetadataReconcile(ctx, gz.GetObjectMeta().Name, powerdns.MetadataAllowAXFRFrom, allowAXFRFrom, PDNSClient, log)
+}
+
+// metadataExternalResourcesReconcile applies the API-RECTIFY metadata of the zone, when auto-rectify is set, and makes
+// the metadata of the zone match exactly spec.metadata, when set, the kinds managed through dedicated fields
+// or read-only in PowerDNS API are left untouched
+// The live metadata of the zone is reported in status
+func metadataExternalResourcesReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, PDNSClient PdnsClienter, log logr.Logger) error {
+	zoneName := gz.GetObjectMeta().Name
+	if autoRectify := gz.GetSpec().AutoRectify; autoRectify != nil {
+		value := "0"
+		if *autoRectify {
+			value = "1"
+		}
+		if err := zoneMetadataReconcile(ctx, zoneName, powerdns.MetadataAPIRectify, []string{value}, PDNSClient, log); err != nil {
+			return err
+		}
+	}
+
+	metadata, err := PDNSClient.Metadata.List(ctx, zoneName)
+	if err != nil {
+		log.Error(err, "Failed to list zone metadata")
+		return err
+	}
+	current := make(map[string][]string, len(metadata))
+	for _, m := range metadata {
+		current[string(ptr.Deref(m.Kind, ""))] = m.Metadata
+	}
+
+	if desired := gz.GetSpec().Metadata; desired != nil {
+		for kind := range current {
+			if _, ok := desired[kind]; ok || isReservedMetadataKind(kind) || isFieldManagedMetadataKind(gz, kind) {
+				continue
+			}
+			if err := PDNSClient.Metadata.Delete(ctx, zoneName, powerdns.MetadataKind(kind)); err != nil {
+				log.Error(err, "Failed to delete zone metadata", "kind", kind)
+				return err
+			}
+			delete(current, kind)
+		}
+		for kind, values := range desired {
+			if isReservedMetadataKind(kind) || isFieldManagedMetadataKind(gz, kind) || metadataIsIdentical(current[kind], values) {
+				continue
+			}
+			if _, err := PDNSClient.Metadata.Set(ctx, zoneName, powerdns.MetadataKind(kind), values); err != nil {
+				log.Error(err, "Failed to set zone metadata", "kind", kind)
+				return err
+			}
+			current[kind] = slices.Clone(values)
+		}
+	}
+
+	status := gz.GetStatus()
+	status.Metadata = nil
+	if len(current) > 0 {
+		status.Metadata = current
+	}
+	gz.SetStatus(status)
+	return nil
+}
+
+// zoneOwner identifies the resource managing a zone, e.g. "Zone/namespace/example.org" or "ClusterZone/example.org"
+func zoneOwner(gz dnsv1alpha2.GenericZone) string {
+	if _, ok := gz.(*dnsv1alpha2.ClusterZone); ok {
+		return "ClusterZone/" + gz.GetName()
+	}
+	return "Zone/" + gz.GetNamespace() + "/" + gz.GetName()
+}
+
+// adoptReconcile marks a zone which already existed in PowerDNS before its first synchronization as managed by the
+// operator, when adopt is enabled. The records of the zone are kept, they are managed once RRsets are created.
+func adoptReconcile(ctx context.Context, gz dnsv1alpha2.GenericZone, preexisting bool, PDNSClient PdnsClienter, log logr.Logger) error {
+	if !preexisting || !ptr.Deref(gz.GetSpec().Adopt, false) {
+		return nil
+	}
+	owner := zoneOwner(gz)
+	if err := zoneMetadataReconcile(ctx, gz.GetObjectMeta().Name, OWNER_METADATA_KIND, []string{owner}, PDNSClient, log); err != nil {
+		return err
+	}
+	log.Info("Zone already exists in PowerDNS, adopted", "owner", owner)
+	gz.SetAdopted(owner)
+	return nil
+}
+
+// zoneMetadataReconcile makes a zone metadata kind match exactly the expected values, the metadata is deleted when no value is expected
+func zoneMetadataReconcile(ctx context.Context, zoneName string, kind powerdns.MetadataKind, values []string, PDNSClient PdnsClienter, log logr.Logger) error {
+	metadata, err := PDNSClient.Metadata.Get(ctx, zoneName, kind)
+	if err != nil && !isPdnsNotFound(err) {
+		log.Error(err, "Failed to get zone metadata", "kind", kind)
+		return err
+	}
+	var current []string
+	if err == nil {
+		current = metadata.Metadata
+	}
+	if metadataIsIdentical(current, values) {
+		return nil
+	}
+
+	if len(values) == 0 {
+		if err := PDNSClient.Metadata.Delete(ctx, zoneName, kind); err != nil {
+			log.Error(err, "Failed to delete zone metadata", "kind", kind)
+			return err
+		}
+		return nil
+	}
+	if _, err := PDNSClient.Metadata.Set(ctx, zoneName, kind, values); err != nil {
+		log.Error(err, "Failed to set zone metadata", "kind", kind)
+		return err
+	}
+	return nil
+}
+
+func deleteRrsetExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZone, rrset dnsv1alpha2.GenericRRset, PDNSClient PdnsClienter, log logr.Logger) error {
+	// The RRsets of an orphaned zone are deleted with it, their records are kept as well
+	if isOrphanedOnDelete(rrset) || isOrphanedOnDelete(zone) {
+		log.Info("RRset deleted with the orphan-on-delete annotation, keeping its records in PowerDNS")
+		return nil
+	}
+	for _, rrType := range getRRsetTypes(rrset) {
+		err := PDNSClient.Records.Delete(ctx, zone.GetObjectMeta().Name, getRRsetName(rrset), powerdns.RRType(rrType))
+		// The zone may have already been deleted with its records and it is not an error
+		if err != nil && !isPdnsNotFound(err) {
+			log.Error(err, "Failed to delete record")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// zoneRefChangeReconcile deletes the records of a RRset from the zone they were synchronized in when spec.zoneRef
+// references another zone, and removes the owner reference of the previous zone so that the new one can own the RRset
+func zoneRefChangeReconcile(ctx context.Context, rrset dnsv1alpha2.GenericRRset, PDNSClient PdnsClienter, log logr.Logger) error {
+	previous := rrset.GetStatus().ZoneRef
+	current := rrset.GetSpec().ZoneRef
+	if previous == nil || (makeCanonical(previous.Name) == makeCanonical(current.Name) && previous.Kind == current.Kind) {
+		return nil
+	}
+	log.Info("Zone reference changed, moving RRset", "previousZone", makeCanonical(previous.Name))
+
+	// A Zone and a ClusterZone cannot have the same name, records are only deleted if the PowerDNS zone changed
+	if name := rrset.GetStatus().DnsEntryName; name != nil && makeCanonical(previous.Name) != makeCanonical(current.Name) {
+		for _, rrType := range getRRsetTypes(rrset) {
+			err := PDNSClient.Records.Delete(ctx, previous.Name, *name, powerdns.RRType(rrType))
+			// The previous zone may have already been deleted with its records and it is not an error
+			if err != nil && !isPdnsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	rrset.SetOwnerReferences(slices.DeleteFunc(rrset.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+		return ref.Kind == previous.Kind && ref.Name == previous.Name
+	}))
+	status := rrset.GetStatus()
+	status.ZoneRef = nil
+	rrset.SetStatus(status)
+	return nil
+}
+
+func createOrUpdateRrsetExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZone, rrset dnsv1alpha2.GenericRRset, defaultTTLByType map[string]uint32, PDNSClient PdnsClienter) (bool, error) {
+	// An empty change is a deletion for PowerDNS, it is never sent for a RRset
+	if len(getRRsetRecords(rrset)) == 0 {
+		return false, fmt.Errorf("RRset has no records")
+	}
+	if isDualStackType(rrset.GetSpec().Type) {
+		return createOrUpdateDualStackRrsetExternalResources(ctx, zone, rrset, defaultTTLByType, PDNSClient)
+	}
 	name := getRRsetName(rrset)
 	rrType := powerdns.RRType(rrset.GetSpec().Type)
+	ttl := getRRsetTTL(zone, rrset, defaultTTLByType)
 	// Looking for a record with same Name and Type
 	records, err := PDNSClient.Records.Get(ctx, zone.GetObjectMeta().Name, name, &rrType)
-	if err != nil && !apierrors.IsNotFound(err) {
+	if err != nil && !isPdnsNotFound(err) {
 		return false, err
 	}
-	// An issue exist on GET API Calls, comments for another RRSet are included although we filter
-	// See https://github.com/PowerDNS/pdns/issues/14539
-	// See https://github.com/PowerDNS/pdns/pull/14045
-	var filteredRecord powerdns.RRset
-	for _, fr := range records {
-		if *fr.Name == makeCanonical(name) {
-			filteredRecord = fr
-			break
-		}
-	}
-	if filteredRecord.Name != nil && rrsetIsIdenticalToExternalRRset(rrset, filteredRecord) {
+	filteredRecord := filterRRset(records, name, rrType)
+	if filteredRecord.Name != nil && rrsetIsIdenticalToExternalRRset(rrset, ttl, filteredRecord) {
 		return false, nil
 	}
 
 	// Create or Update
-	operatorAccount := "powerdns-operator"
-	comments := func(*powerdns.RRset) {}
-	if rrset.GetSpec().Comment != nil {
-		comments = powerdns.WithComments(powerdns.Comment{Content: rrset.GetSpec().Comment, Account: &operatorAccount})
+	// Records.Change resets the disabled and set-ptr flags of the records, RRsets using them are patched as a whole (not batched)
+	// Concurrent writes to the zone are serialized
+	if len(getRRsetDisabledRecords(rrset)) > 0 || ptr.Deref(rrset.GetSpec().SetPTR, false) {
+		defer lockZone(zone.GetObjectMeta().Name)()
+		err = PDNSClient.Records.Patch(ctx, zone.GetObjectMeta().Name, &powerdns.RRsets{Sets: []powerdns.RRset{{
+			Name:       ptr.To(makeCanonical(name)),
+			Type:       &rrType,
+			TTL:        &ttl,
+			ChangeType: powerdns.ChangeTypePtr(powerdns.ChangeTypeReplace),
+			Records:    getRRsetPdnsRecords(rrset),
+			Comments:   getRRsetComments(rrset),
+		}}})
+	} else {
+		defer lockZoneChange(PDNSClient.Records, zone.GetObjectMeta().Name)()
+		err = PDNSClient.Records.Change(ctx, zone.GetObjectMeta().Name, name, rrType, ttl, getRRsetRecords(rrset), powerdns.WithComments(getRRsetComments(rrset)...))
 	}
-	err = PDNSClient.Records.Change(ctx, zone.GetObjectMeta().Name, name, rrType, rrset.GetSpec().TTL, rrset.GetSpec().Records, comments)
 	if err != nil {
 		return false, err
 	}
@@ -458,6 +2053,245 @@ func createOrUpdateRrsetExternalResources(ctx context.Context, zone dnsv1alpha2.
 	return true, nil
 }
 
+// createOrUpdateDualStackRrsetExternalResources synchronizes the IPv4 and IPv6 addresses of a dual-stack RRset
+// as an A and an AAAA RRsets, each of them compared separately with PowerDNS; the one without addresses is deleted
+func createOrUpdateDualStackRrsetExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZone, rrset dnsv1alpha2.GenericRRset, defaultTTLByType map[string]uint32, PDNSClient PdnsClienter) (bool, error) {
+	changed := false
+	for _, family := range splitDualStackRRset(rrset) {
+		if len(family.GetSpec().Records) > 0 {
+			familyChanged, err := createOrUpdateRrsetExternalResources(ctx, zone, family, defaultTTLByType, PDNSClient)
+			changed = changed || familyChanged
+			if err != nil {
+				return changed, err
+			}
+			continue
+		}
+
+		name := getRRsetName(family)
+		rrType := powerdns.RRType(family.GetSpec().Type)
+		records, err := PDNSClient.Records.Get(ctx, zone.GetObjectMeta().Name, name, &rrType)
+		if err != nil && !isPdnsNotFound(err) {
+			return changed, err
+		}
+		if filterRRset(records, name, rrType).Name == nil {
+			continue
+		}
+		unlock := lockZoneChange(PDNSClient.Records, zone.GetObjectMeta().Name)
+		err = PDNSClient.Records.Delete(ctx, zone.GetObjectMeta().Name, name, rrType)
+		unlock()
+		if err != nil && !isPdnsNotFound(err) {
+			return changed, err
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// cryptokeyExternalResourcesReconcile creates the Cryptokey if it does not exist (anymore) in PowerDNS,
+// and (de)activates it if necessary. Key material is immutable, only the activation is reconciled.
+func cryptokeyExternalResourcesReconcile(ctx context.Context, zone dnsv1alpha2.GenericZone, cryptokey *dnsv1alpha2.Cryptokey, PDNSClient PdnsClienter, log logr.Logger) (*powerdns.Cryptokey, error) {
+	active := ptr.Deref(cryptokey.Spec.Active, true)
+
+	if cryptokey.Status.ID != nil {
+		cryptokeyRes, err := PDNSClient.Cryptokeys.Get(ctx, zone.GetObjectMeta().Name, *cryptokey.Status.ID)
+		if err != nil && !isPdnsNotFound(err) {
+			log.Error(err, "Failed to get cryptokey")
+			return nil, err
+		}
+		if err == nil {
+			if ptr.Deref(cryptokeyRes.Active, false) != active {
+				if err := PDNSClient.Cryptokeys.Change(ctx, zone.GetObjectMeta().Name, *cryptokey.Status.ID, active); err != nil {
+					log.Error(err, "Failed to update cryptokey")
+					return nil, err
+				}
+				cryptokeyRes.Active = &active
+			}
+			return cryptokeyRes, nil
+		}
+		log.Info("Cryptokey not found in PowerDNS, creating it again", "id", *cryptokey.Status.ID)
+	}
+
+	cryptokeyRes, err := PDNSClient.Cryptokeys.Add(ctx, zone.GetObjectMeta().Name, &powerdns.Cryptokey{
+		KeyType:   &cryptokey.Spec.KeyType,
+		Active:    &active,
+		Algorithm: cryptokey.Spec.Algorithm,
+		Bits:      cryptokey.Spec.Bits,
+	})
+	if err != nil {
+		log.Error(err, "Failed to create cryptokey")
+		return nil, err
+	}
+	return cryptokeyRes, nil
+}
+
+func deleteCryptokeyExternalResources(ctx context.Context, zone dnsv1alpha2.GenericZone, cryptokey *dnsv1alpha2.Cryptokey, PDNSClient PdnsClienter, log logr.Logger) error {
+	if cryptokey.Status.ID == nil {
+		return nil
+	}
+	err := PDNSClient.Cryptokeys.Delete(ctx, zone.GetObjectMeta().Name, *cryptokey.Status.ID)
+	// Cryptokey may have already been deleted and it is not an error
+	if err != nil && !isPdnsNotFound(err) {
+		log.Error(err, "Failed to delete cryptokey")
+		return err
+	}
+	return nil
+}
+
+// dnssecMaintenanceZoneReconcile applies the DNSSEC maintenance operations to a zone, its progress is recorded in zoneStatus.
+// The ZSK rollover is done in two steps: a new inactive ZSK is published, then it is activated and the previous ZSKs are
+// deactivated once the prepublish duration has elapsed, the time to wait before the next step is returned.
+// Errors are reported in zoneStatus, they do not prevent the maintenance of the other zones.
+func dnssecMaintenanceZoneReconcile(ctx context.Context, maintenance *dnsv1alpha2.DNSSECMaintenance, zoneName string, cryptokeyManaged bool, zoneStatus *dnsv1alpha2.DNSSECMaintenanceZoneStatus, PDNSClient PdnsClienter, log logr.Logger) time.Duration {
+	fail := func(err error) time.Duration {
+		zoneStatus.Phase = dnsv1alpha2.MAINTENANCE_FAILED_PHASE
+		zoneStatus.Message = ptr.To(err.Error())
+		return 0
+	}
+
+	if zoneStatus.Phase == dnsv1alpha2.MAINTENANCE_PENDING_PHASE {
+		if ptr.Deref(maintenance.Spec.Rectify, false) && zoneStatus.Rectify == nil {
+			res, err := PDNSClient.Zones.Rectify(ctx, zoneName)
+			if err != nil {
+				log.Error(err, "Failed to rectify zone")
+				return fail(err)
+			}
+			zoneStatus.Rectify = ptr.To(ptr.Deref(res.Result, ""))
+		}
+		if !ptr.Deref(maintenance.Spec.ZSKRollover, false) {
+			zoneStatus.Phase = dnsv1alpha2.MAINTENANCE_SUCCEEDED_PHASE
+			return 0
+		}
+		if cryptokeyManaged {
+			zoneStatus.Phase = dnsv1alpha2.MAINTENANCE_SKIPPED_PHASE
+			zoneStatus.Message = ptr.To("ZSK rollover skipped, the keys of the zone are managed through Cryptokey resources")
+			return 0
+		}
+
+		activeZSKs, err := getActiveZSKs(ctx, zoneName, PDNSClient)
+		if err != nil {
+			log.Error(err, "Failed to list cryptokeys")
+			return fail(err)
+		}
+		if len(activeZSKs) == 0 {
+			zoneStatus.Phase = dnsv1alpha2.MAINTENANCE_SKIPPED_PHASE
+			zoneStatus.Message = ptr.To("ZSK rollover skipped, the zone has no active ZSK")
+			return 0
+		}
+		// The new key is published inactive, with the algorithm of the current one
+		newKey, err := PDNSClient.Cryptokeys.Add(ctx, zoneName, &powerdns.Cryptokey{
+			KeyType:   ptr.To("zsk"),
+			Active:    ptr.To(false),
+			Algorithm: activeZSKs[0].Algorithm,
+			Bits:      activeZSKs[0].Bits,
+		})
+		if err != nil {
+			log.Error(err, "Failed to create cryptokey")
+			return fail(err)
+		}
+		log.Info("New ZSK published", "id", ptr.Deref(newKey.ID, 0))
+		zoneStatus.Phase = dnsv1alpha2.MAINTENANCE_PREPUBLISHED_PHASE
+		zoneStatus.NewKeyID = newKey.ID
+		zoneStatus.PrepublishTime = &metav1.Time{Time: time.Now().UTC()}
+		return maintenance.GetPrepublishDuration()
+	}
+
+	if zoneStatus.Phase == dnsv1alpha2.MAINTENANCE_PREPUBLISHED_PHASE {
+		if remaining := time.Until(zoneStatus.PrepublishTime.Add(maintenance.GetPrepublishDuration())); remaining > 0 {
+			return remaining
+		}
+		activeZSKs, err := getActiveZSKs(ctx, zoneName, PDNSClient)
+		if err != nil {
+			log.Error(err, "Failed to list cryptokeys")
+			return fail(err)
+		}
+		newKeyID := ptr.Deref(zoneStatus.NewKeyID, 0)
+		if err := PDNSClient.Cryptokeys.Change(ctx, zoneName, newKeyID, true); err != nil {
+			log.Error(err, "Failed to activate cryptokey", "id", newKeyID)
+			return fail(err)
+		}
+		// The previous keys are kept published, the signatures made with them are still valid until they expire
+		for _, key := range activeZSKs {
+			if ptr.Deref(key.ID, 0) == newKeyID {
+				continue
+			}
+			if err := PDNSClient.Cryptokeys.Change(ctx, zoneName, ptr.Deref(key.ID, 0), false); err != nil {
+				log.Error(err, "Failed to deactivate cryptokey", "id", ptr.Deref(key.ID, 0))
+				return fail(err)
+			}
+		}
+		log.Info("ZSK rolled over", "id", newKeyID)
+		zoneStatus.Phase = dnsv1alpha2.MAINTENANCE_SUCCEEDED_PHASE
+		zoneStatus.Message = nil
+	}
+	return 0
+}
+
+// getActiveZSKs returns the active ZSKs of a zone
+func getActiveZSKs(ctx context.Context, zoneName string, PDNSClient PdnsClienter) ([]powerdns.Cryptokey, error) {
+	keys, err := PDNSClient.Cryptokeys.List(ctx, zoneName)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(keys, func(key powerdns.Cryptokey) bool {
+		return ptr.Deref(key.KeyType, "") != "zsk" || !ptr.Deref(key.Active, false)
+	}), nil
+}
+
+// tsigKeyExternalResourcesReconcile creates or updates the TSIG key in PowerDNS
+// An empty secret lets PowerDNS generate the key, it is then returned in the TSIGKey
+func tsigKeyExternalResourcesReconcile(ctx context.Context, tsigKey *dnsv1alpha2.TSIGKey, secret string, PDNSClient PdnsClienter, log logr.Logger) (*powerdns.TSIGKey, error) {
+	if tsigKey.Status.ID != nil {
+		tsigKeyRes, err := PDNSClient.TSIGKeys.Get(ctx, *tsigKey.Status.ID)
+		if err != nil && !isPdnsNotFound(err) {
+			log.Error(err, "Failed to get TSIG key")
+			return nil, err
+		}
+		if err == nil {
+			// Keys created before their name was qualified with the namespace are renamed
+			nameChanged := makeCanonical(ptr.Deref(tsigKeyRes.Name, "")) != makeCanonical(tsigKey.GetPDNSName())
+			algorithmChanged := ptr.Deref(tsigKeyRes.Algorithm, "") != tsigKey.Spec.Algorithm
+			secretChanged := secret != "" && ptr.Deref(tsigKeyRes.Key, "") != secret
+			if !nameChanged && !algorithmChanged && !secretChanged {
+				return tsigKeyRes, nil
+			}
+			newKey := powerdns.TSIGKey{
+				Name:      ptr.To(tsigKey.GetPDNSName()),
+				Algorithm: ptr.To(tsigKey.Spec.Algorithm),
+			}
+			if secret != "" {
+				newKey.Key = ptr.To(secret)
+			}
+			tsigKeyRes, err = PDNSClient.TSIGKeys.Change(ctx, *tsigKey.Status.ID, newKey)
+			if err != nil {
+				log.Error(err, "Failed to update TSIG key")
+				return nil, err
+			}
+			return tsigKeyRes, nil
+		}
+		log.Info("TSIG key not found in PowerDNS, creating it again", "id", *tsigKey.Status.ID)
+	}
+
+	tsigKeyRes, err := PDNSClient.TSIGKeys.Create(ctx, tsigKey.GetPDNSName(), tsigKey.Spec.Algorithm, secret)
+	if err != nil {
+		log.Error(err, "Failed to create TSIG key")
+		return nil, err
+	}
+	return tsigKeyRes, nil
+}
+
+func deleteTSIGKeyExternalResources(ctx context.Context, tsigKey *dnsv1alpha2.TSIGKey, PDNSClient PdnsClienter, log logr.Logger) error {
+	if tsigKey.Status.ID == nil {
+		return nil
+	}
+	err := PDNSClient.TSIGKeys.Delete(ctx, *tsigKey.Status.ID)
+	// TSIG key may have already been deleted and it is not an error
+	if err != nil && !isPdnsNotFound(err) {
+		log.Error(err, "Failed to delete TSIG key")
+		return err
+	}
+	return nil
+}
+
 func ownObject(ctx context.Context, zone dnsv1alpha2.GenericZone, rrset dnsv1alpha2.GenericRRset, scheme *runtime.Scheme, cl client.Client, log logr.Logger) error {
 	err := ctrl.SetControllerReference(zone, rrset, scheme)
 	if err != nil {
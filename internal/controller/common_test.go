@@ -13,16 +13,30 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/joeig/go-powerdns/v3"
 	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -34,8 +48,11 @@ var (
 func init() {
 	m = NewMockClient()
 	PDNSClient = PdnsClienter{
-		Records: m.Records,
-		Zones:   m.Zones,
+		Records:    m.Records,
+		Zones:      m.Zones,
+		Cryptokeys: m.Cryptokeys,
+		Metadata:   m.Metadata,
+		TSIGKeys:   m.TSIGKeys,
 	}
 }
 
@@ -71,6 +88,10 @@ func setupTestCase() func() {
 	return func() {
 		resetZonesMap()
 		resetRecordsMap()
+		resetCryptokeysMap()
+		resetMetadataMap()
+		resetTSIGKeysMap()
+		axfrRetrieves.Clear()
 	}
 }
 
@@ -161,6 +182,111 @@ func TestCreateExternalResources(t *testing.T) {
 	}
 }
 
+func TestCreateSlaveZoneExternalResources(t *testing.T) {
+	var (
+		name    = "example3.org"
+		masters = []string{"192.0.2.1", "192.0.2.2:5300"}
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       dnsv1alpha2.ZoneSpec{Kind: SLAVE_KIND_ZONE, Masters: masters, SOAEditAPI: ptr.To("DEFAULT")},
+	}
+	if err := createZoneExternalResources(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	external, _ := readFromZonesMap(makeCanonical(name))
+	if !cmp.Equal(external.Masters, masters) {
+		t.Errorf("got %v, want %v", external.Masters, masters)
+	}
+	if len(external.Nameservers) != 0 {
+		t.Errorf("nameservers of a Slave zone should not be set, got %v", external.Nameservers)
+	}
+	if got := getAxfrRetrievesCount(name); got != 1 {
+		t.Errorf("got %v AXFR retrieves, want %v", got, 1)
+	}
+}
+
+func TestPresignedZoneExternalResources(t *testing.T) {
+	var (
+		name        = "presigned.org"
+		nameservers = []string{"ns1.presigned.org", "ns2.presigned.org"}
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, Nameservers: nameservers, SOAEditAPI: ptr.To("DEFAULT"), Catalog: ptr.To(""), DNSSEC: ptr.To(true), Presigned: ptr.To(true)},
+	}
+	if err := createZoneExternalResources(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The zone is created presigned, without keys generated by PowerDNS
+	external, _ := readFromZonesMap(makeCanonical(name))
+	if !ptr.Deref(external.Presigned, false) {
+		t.Errorf("got %v, want a presigned zone", external.Presigned)
+	}
+	if len(readFromCryptokeysMap(makeCanonical(name))) != 0 {
+		t.Errorf("got %v, want no cryptokey", readFromCryptokeysMap(makeCanonical(name)))
+	}
+	if identical, _ := zoneIsIdenticalToExternalZone(zone, external, nameservers); !identical {
+		t.Errorf("got %v, want %v", identical, true)
+	}
+
+	// The presigned setting is applied on the update of an existing zone, its dnssec setting is left untouched
+	zone.Spec.Presigned = ptr.To(false)
+	if err := updateZoneExternalResources(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	external, _ = readFromZonesMap(makeCanonical(name))
+	if ptr.Deref(external.Presigned, true) {
+		t.Errorf("got %v, want a zone which is not presigned", external.Presigned)
+	}
+}
+
+func TestCreationConflictZoneExternalResources(t *testing.T) {
+	var (
+		name        = "example.org"
+		nameservers = []string{"ns1.example1.org", "ns2.example1.org"}
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	// The zone already exists in PowerDNS although it was not found on the first Get
+	zone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, Nameservers: slices.Clone(nameservers), SOAEditAPI: ptr.To("DEFAULT")},
+	}
+	if err := zoneExternalResourcesReconcile(ctx, &powerdns.Zone{}, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := getMockedNameservers(name); !cmp.Equal(got, nameservers) {
+		t.Errorf("got %v, want %v", got, nameservers)
+	}
+	if got := getMockedKind(name); got != NATIVE_KIND_ZONE {
+		t.Errorf("got %v, want %v", got, NATIVE_KIND_ZONE)
+	}
+	condition := meta.FindStatusCondition(zone.Status.Conditions, dnsv1alpha2.CREATION_CONFLICT_CONDITION)
+	if condition == nil || condition.Reason != dnsv1alpha2.PDNS_CONFLICT_REASON {
+		t.Errorf("got %v, want a %s condition", condition, dnsv1alpha2.CREATION_CONFLICT_CONDITION)
+	}
+}
+
 func TestUpdateExternalResources(t *testing.T) {
 	var (
 		name        = "example.org"
@@ -282,7 +408,7 @@ func TestDeleteExternalResources(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.description, func(t *testing.T) {
-			err := deleteZoneExternalResources(ctx, tc.genericZone, PDNSClient, log)
+			err := deleteZoneExternalResources(ctx, tc.genericZone, nil, PDNSClient, log)
 			if !cmp.Equal(err, tc.e) {
 				t.Errorf("got %v, want %v", err, tc.e)
 			}
@@ -324,6 +450,7 @@ func TestDeleteRrsetExternalResources(t *testing.T) {
 	}{
 		{"Existing RRset", &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers1, Catalog: &catalog, SOAEditAPI: &soaEditApi}}, &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: rrsetFqdn1, Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: rrsetType1, Name: rrsetName1, TTL: rrsetTTL1, Records: rrsetRecords1, Comment: &rrsetComment1}}, nil},
 		{"Inexisting RRset", &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers1, Catalog: &catalog, SOAEditAPI: &soaEditApi}}, &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: rrsetFqdn2, Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: rrsetType2, Name: rrsetName2, TTL: rrsetTTL2, Records: rrsetRecords2, Comment: &rrsetComment2}}, nil},
+		{"Deleted Zone", &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "deleted.org", Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers1, Catalog: &catalog, SOAEditAPI: &soaEditApi}}, &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "test.deleted.org", Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: "deleted.org", Kind: "Zone"}, Type: rrsetType1, Name: rrsetName1, TTL: rrsetTTL1, Records: rrsetRecords1}}, nil},
 	}
 
 	// Mock initialization
@@ -340,6 +467,303 @@ func TestDeleteRrsetExternalResources(t *testing.T) {
 	}
 }
 
+func TestDeleteOwnedRRsets(t *testing.T) {
+	ctx := context.Background()
+	namespace := "example"
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: namespace, UID: "zone-uid"}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE}}
+	otherZone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: "other", UID: "other-zone-uid"}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE}}
+	rrset := func(name, namespace string, owner *dnsv1alpha2.Zone) *dnsv1alpha2.RRset {
+		r := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"}, Type: "A", Name: name, Records: []string{"192.0.2.1"}}}
+		if owner != nil {
+			r.OwnerReferences = []metav1.OwnerReference{{APIVersion: dnsv1alpha2.GroupVersion.String(), Kind: "Zone", Name: owner.Name, UID: owner.UID, Controller: ptr.To(true)}}
+		}
+		return r
+	}
+
+	scheme := runtime.NewScheme()
+	_ = dnsv1alpha2.AddToScheme(scheme)
+	indexZoneRef := func(o client.Object) []string {
+		return []string{dnsv1alpha2.ZoneRefKey(o.(dnsv1alpha2.GenericRRset).GetSpec().ZoneRef)}
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(rrset("www", namespace, zone), rrset("mail", namespace, zone), rrset("not-owned", namespace, nil), rrset("www", "other", otherZone)).
+		WithIndex(&dnsv1alpha2.RRset{}, "RRset.ZoneRef", indexZoneRef).
+		WithIndex(&dnsv1alpha2.ClusterRRset{}, "ClusterRRset.ZoneRef", indexZoneRef).
+		Build()
+
+	if err := deleteOwnedRRsets(ctx, zone, cl, log.FromContext(ctx)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var rrsets dnsv1alpha2.RRsetList
+	if err := cl.List(ctx, &rrsets); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result []string
+	for _, r := range rrsets.Items {
+		result = append(result, r.Namespace+"/"+r.Name)
+	}
+	expected := []string{namespace + "/not-owned", "other/www"}
+	if !cmp.Equal(result, expected) {
+		t.Errorf("got %v, want %v", result, expected)
+	}
+}
+
+func TestIsTSIGKeySecret(t *testing.T) {
+	tsigKey := func(name string, secretName string) *dnsv1alpha2.TSIGKey {
+		k := &dnsv1alpha2.TSIGKey{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "example"}, Spec: dnsv1alpha2.TSIGKeySpec{Algorithm: "hmac-sha256"}}
+		if secretName != "" {
+			k.Spec.SecretRef = &dnsv1alpha2.SecretKeyRef{Name: secretName}
+		}
+		return k
+	}
+
+	scheme := runtime.NewScheme()
+	_ = dnsv1alpha2.AddToScheme(scheme)
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(tsigKey("transfer-key", ""), tsigKey("update-key", "shared-secret")).
+		WithIndex(&dnsv1alpha2.TSIGKey{}, "TSIGKey.SecretName", func(o client.Object) []string {
+			return []string{o.(*dnsv1alpha2.TSIGKey).GetSecretName()}
+		}).
+		Build()
+	r := &TSIGKeyReconciler{Client: cl}
+
+	var testCases = []struct {
+		description string
+		namespace   string
+		name        string
+		expected    bool
+	}{
+		{"Secret named after the TSIGKey", "example", "transfer-key", true},
+		{"Secret referenced by secretRef", "example", "shared-secret", true},
+		{"Secret not referenced", "example", "tls-certificate", false},
+		{"Secret of another namespace", "other", "transfer-key", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			secret := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: tc.name, Namespace: tc.namespace}}
+			if result := r.isTSIGKeySecret(secret); result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestZoneCommentExternalResources(t *testing.T) {
+	zoneName := "comment.org"
+	soaContent := "ns1.comment.org. hostmaster.comment.org. 2025010101 10800 3600 604800 3600"
+	ctx := context.Background()
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+	_ = PDNSClient.Records.Change(ctx, zoneName, makeCanonical(zoneName), powerdns.RRTypeSOA, 3600, []string{soaContent})
+
+	var testCases = []struct {
+		description      string
+		comment          *string
+		soa              *dnsv1alpha2.SOASpec
+		expectedComments []string
+		expectedContent  string
+	}{
+		{"Comment not managed", nil, nil, nil, soaContent},
+		{"Comment set", ptr.To("owned by team-a"), nil, []string{"owned by team-a"}, soaContent},
+		{"Comment changed with SOA parameters", ptr.To("owned by team-b"), &dnsv1alpha2.SOASpec{Refresh: ptr.To(uint32(7200))}, []string{"owned by team-b"}, "ns1.comment.org. hostmaster.comment.org. 2025010101 7200 3600 604800 3600"},
+		{"Comment identical", ptr.To("owned by team-b"), nil, []string{"owned by team-b"}, "ns1.comment.org. hostmaster.comment.org. 2025010101 7200 3600 604800 3600"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: "example"}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, Comment: tc.comment, SOA: tc.soa}}
+			if err := soaExternalResourcesReconcile(ctx, zone, PDNSClient, log.FromContext(ctx)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			rrset, _ := readFromRecordsMap(makeCanonical(zoneName))
+			var comments []string
+			for _, c := range rrset.Comments {
+				comments = append(comments, *c.Content)
+				if ptr.Deref(c.Account, "") != OPERATOR_COMMENT_ACCOUNT {
+					t.Errorf("got %v, want %v", ptr.Deref(c.Account, ""), OPERATOR_COMMENT_ACCOUNT)
+				}
+			}
+			if !cmp.Equal(comments, tc.expectedComments) {
+				t.Errorf("got %v, want %v", comments, tc.expectedComments)
+			}
+			if *rrset.Records[0].Content != tc.expectedContent {
+				t.Errorf("got %v, want %v", *rrset.Records[0].Content, tc.expectedContent)
+			}
+		})
+	}
+}
+
+func TestGetSecretValue(t *testing.T) {
+	ctx := context.Background()
+	key := client.ObjectKey{Namespace: "example", Name: "tsig-secret"}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}, Data: map[string][]byte{"key": []byte("c2VjcmV0")}}
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	emptyCache := fake.NewClientBuilder().WithScheme(scheme).Build()
+	syncedCache := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	var testCases = []struct {
+		description string
+		cache       client.Reader
+		apiReader   client.Reader
+		expected    string
+	}{
+		{"Secret in the cache", syncedCache, nil, "c2VjcmV0"},
+		{"Secret not yet in the cache", emptyCache, syncedCache, "c2VjcmV0"},
+		{"Secret not yet in the cache without API reader", emptyCache, nil, ""},
+		{"Missing secret", emptyCache, emptyCache, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, err := getSecretValue(ctx, tc.cache, tc.apiReader, key, "key")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestOrphanOnDeleteExternalResources(t *testing.T) {
+	var (
+		zoneName    = "example.org"
+		namespace   = "example"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+		rrsetFqdn   = "test.example.org"
+		orphan      = map[string]string{ORPHAN_ANNOTATION: "true"}
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	var testCases = []struct {
+		description      string
+		zoneAnnotations  map[string]string
+		rrsetAnnotations map[string]string
+		expectedRecords  bool
+		expectedZone     bool
+	}{
+		{"Normal delete", nil, nil, false, false},
+		{"Orphaned RRset", nil, orphan, true, false},
+		{"Orphaned Zone", orphan, nil, true, true},
+		{"Annotation not true", map[string]string{ORPHAN_ANNOTATION: "false"}, nil, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			// Mock initialization
+			teardownTestCase := setupTestCase()
+			defer teardownTestCase()
+
+			writeToMetadataMap(zoneName, OWNER_METADATA_KIND, []string{"Zone/example/example.org"})
+			zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace, Annotations: tc.zoneAnnotations}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, Nameservers: nameservers}}
+			rrset := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: rrsetFqdn, Namespace: namespace, Annotations: tc.rrsetAnnotations}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: "test"}}
+
+			if err := deleteRrsetExternalResources(ctx, zone, rrset, PDNSClient, log); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := len(getMockedRecordsForType(rrsetFqdn, "A")) > 0; got != tc.expectedRecords {
+				t.Errorf("got records %v, want %v", got, tc.expectedRecords)
+			}
+			if err := deleteZoneExternalResources(ctx, zone, nil, PDNSClient, log); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, got := readFromZonesMap(makeCanonical(zoneName)); got != tc.expectedZone {
+				t.Errorf("got zone %v, want %v", got, tc.expectedZone)
+			}
+			if tc.expectedZone {
+				if _, found := readFromMetadataMap(zoneName, OWNER_METADATA_KIND); found {
+					t.Errorf("%s metadata should have been deleted", OWNER_METADATA_KIND)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteManagedRecordsOfOrphanedZone(t *testing.T) {
+	var (
+		zoneName    = "example.org"
+		namespace   = "example"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+		managedFqdn = "test.example.org"
+		manualFqdn  = "manual.example.org"
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	var testCases = []struct {
+		description            string
+		zoneAnnotations        map[string]string
+		rrsetAnnotations       map[string]string
+		expectedManagedRecords bool
+	}{
+		{"Orphaned Zone", map[string]string{ORPHAN_ANNOTATION: "true"}, nil, true},
+		{"Managed records deleted", map[string]string{ORPHAN_ANNOTATION: "true", DELETE_MANAGED_RECORDS_ANNOTATION: "true"}, nil, false},
+		{"Orphaned RRset kept", map[string]string{ORPHAN_ANNOTATION: "true", DELETE_MANAGED_RECORDS_ANNOTATION: "true"}, map[string]string{ORPHAN_ANNOTATION: "true"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			// Mock initialization
+			teardownTestCase := setupTestCase()
+			defer teardownTestCase()
+
+			// A record added outside of the operator
+			_ = PDNSClient.Records.Change(ctx, makeCanonical(zoneName), makeCanonical(manualFqdn), powerdns.RRType("A"), 300, []string{"192.0.2.1"})
+			zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace, Annotations: tc.zoneAnnotations}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, Nameservers: nameservers}}
+			rrset := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: managedFqdn, Namespace: namespace, Annotations: tc.rrsetAnnotations}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: "test"}}
+
+			if err := deleteZoneExternalResources(ctx, zone, []dnsv1alpha2.GenericRRset{rrset}, PDNSClient, log); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, found := readFromZonesMap(makeCanonical(zoneName)); !found {
+				t.Errorf("got no zone, want the zone kept in PowerDNS")
+			}
+			if got := len(getMockedRecordsForType(managedFqdn, "A")) > 0; got != tc.expectedManagedRecords {
+				t.Errorf("got managed records %v, want %v", got, tc.expectedManagedRecords)
+			}
+			if len(getMockedRecordsForType(manualFqdn, "A")) == 0 {
+				t.Errorf("got no records for %s, want the records added outside of the operator kept", manualFqdn)
+			}
+		})
+	}
+}
+
+func TestRrsetConflicts(t *testing.T) {
+	zoneRef := dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"}
+	clusterZoneRef := dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "ClusterZone"}
+	rrsets := []dnsv1alpha2.RRset{
+		{ObjectMeta: metav1.ObjectMeta{Name: "www", Namespace: "team-a"}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: zoneRef}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "www", Namespace: "team-b"}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: zoneRef}},
+	}
+	clusterRRsets := []dnsv1alpha2.ClusterRRset{
+		{ObjectMeta: metav1.ObjectMeta{Name: "www"}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: clusterZoneRef}},
+	}
+
+	var testCases = []struct {
+		description string
+		rrset       dnsv1alpha2.GenericRRset
+		expected    []string
+	}{
+		{"RRset", &rrsets[0], []string{"ClusterRRset www in zone example.org", "RRset team-b/www in zone example.org"}},
+		{"ClusterRRset", &clusterRRsets[0], []string{"RRset team-a/www in zone example.org", "RRset team-b/www in zone example.org"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := rrsetConflicts(tc.rrset, rrsets, clusterRRsets)
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
 func TestCreateOrUpdateRrsetExternalResources(t *testing.T) {
 	var (
 		zoneName   = "example.org"
@@ -375,6 +799,7 @@ func TestCreateOrUpdateRrsetExternalResources(t *testing.T) {
 		{"RRset creation", &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers1, Catalog: &catalog, SOAEditAPI: &soaEditApi}}, &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: rrsetFqdn2, Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: rrsetType2, Name: rrsetName2, TTL: rrsetTTL2, Records: rrsetRecords2, Comment: &rrsetComment2}}, true, nil},
 		{"RRset update", &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers1, Catalog: &catalog, SOAEditAPI: &soaEditApi}}, &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: rrsetFqdn1, Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: rrsetType1, Name: rrsetName1, TTL: rrsetTTL1, Records: rrsetRecords1, Comment: &rrsetComment1}}, true, nil},
 		{"RRset identical", &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers1, Catalog: &catalog, SOAEditAPI: &soaEditApi}}, &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: rrsetFqdn1, Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: rrsetType1, Name: rrsetName1, TTL: rrsetTTL1, Records: rrsetRecords1, Comment: &rrsetComment1}}, false, nil},
+		{"RRset identical with Zone default TTL", &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers1, Catalog: &catalog, SOAEditAPI: &soaEditApi, DefaultTTL: &rrsetTTL1}}, &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: rrsetFqdn1, Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: rrsetType1, Name: rrsetName1, Records: rrsetRecords1, Comment: &rrsetComment1}}, false, nil},
 	}
 
 	// Mock initialization
@@ -383,7 +808,7 @@ func TestCreateOrUpdateRrsetExternalResources(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.description, func(t *testing.T) {
-			modified, err := createOrUpdateRrsetExternalResources(ctx, tc.genericZone, tc.rrset, PDNSClient)
+			modified, err := createOrUpdateRrsetExternalResources(ctx, tc.genericZone, tc.rrset, nil, PDNSClient)
 			if !cmp.Equal(modified, tc.want) {
 				t.Errorf("got %v, want %v", modified, tc.want)
 			}
@@ -393,3 +818,2057 @@ func TestCreateOrUpdateRrsetExternalResources(t *testing.T) {
 		})
 	}
 }
+
+func TestInheritedTTLRrsetExternalResources(t *testing.T) {
+	var (
+		zoneName         = "example.org"
+		namespace        = "example"
+		zoneDefaultTTL   = uint32(900)
+		defaultTTLByType = map[string]uint32{"A": 120, DEFAULT_TTL_ANY_TYPE: 600}
+	)
+	ctx := context.Background()
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	var testCases = []struct {
+		description string
+		zoneTTL     *uint32
+		rrsetName   string
+		rrsetType   string
+		records     []string
+		expected    uint32
+	}{
+		{"Zone default TTL", &zoneDefaultTTL, "zone-ttl", "A", []string{"192.0.2.1"}, zoneDefaultTTL},
+		{"Type default TTL", nil, "type-ttl", "A", []string{"192.0.2.2"}, 120},
+		{"Operator default TTL", nil, "operator-ttl", "TXT", []string{`"inherited"`}, 600},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, DefaultTTL: tc.zoneTTL}}
+			rrset := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: tc.rrsetName, Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: tc.rrsetType, Name: tc.rrsetName, Records: tc.records}}
+			if _, err := createOrUpdateRrsetExternalResources(ctx, zone, rrset, defaultTTLByType, PDNSClient); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// The resolved TTL is sent to PowerDNS, never the TTL 0
+			if result := getMockedTTL(tc.rrsetName+"."+zoneName, tc.rrsetType); result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+			// The RRset is compared with the resolved TTL, it is not detected as drifted
+			changed, err := createOrUpdateRrsetExternalResources(ctx, zone, rrset, defaultTTLByType, PDNSClient)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if changed {
+				t.Errorf("got %v, want %v", changed, false)
+			}
+		})
+	}
+}
+
+func TestZoneManagedRecordsReconcile(t *testing.T) {
+	var (
+		zoneName  = "example.org"
+		namespace = "example"
+	)
+	ctx := context.Background()
+
+	newRRsets := func(count int) []client.Object {
+		var rrsets []client.Object
+		for i := range count {
+			name := fmt.Sprintf("host%03d", i)
+			rrsets = append(rrsets, &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: name, Records: []string{"192.0.2.1"}}})
+		}
+		return rrsets
+	}
+	indexZoneRef := func(o client.Object) []string {
+		return []string{dnsv1alpha2.ZoneRefKey(o.(dnsv1alpha2.GenericRRset).GetSpec().ZoneRef)}
+	}
+
+	var testCases = []struct {
+		description     string
+		rrsets          []client.Object
+		expectedCount   int32
+		expectedRecords []string
+	}{
+		{"No RRset", nil, 0, nil},
+		{"RRsets listed", newRRsets(2), 2, []string{"host000.example.org. A", "host001.example.org. A"}},
+		{"Only the count of a large zone", newRRsets(MAX_MANAGED_RECORDS_IN_STATUS + 1), MAX_MANAGED_RECORDS_IN_STATUS + 1, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = dnsv1alpha2.AddToScheme(scheme)
+			cl := fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(tc.rrsets...).
+				WithIndex(&dnsv1alpha2.RRset{}, "RRset.ZoneRef", indexZoneRef).
+				WithIndex(&dnsv1alpha2.ClusterRRset{}, "ClusterRRset.ZoneRef", indexZoneRef).
+				Build()
+
+			zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE}}
+			if err := zoneManagedRecordsReconcile(ctx, zone, cl, log.FromContext(ctx)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := ptr.Deref(zone.Status.ManagedRecordsCount, -1); got != tc.expectedCount {
+				t.Errorf("got %v, want %v", got, tc.expectedCount)
+			}
+			if !cmp.Equal(zone.Status.ManagedRecords, tc.expectedRecords) {
+				t.Errorf("got %v, want %v", zone.Status.ManagedRecords, tc.expectedRecords)
+			}
+		})
+	}
+}
+
+func TestDefaultRecordsReconcile(t *testing.T) {
+	ctx := context.Background()
+	namespace := "example"
+	zone := &dnsv1alpha2.Zone{
+		ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: namespace, UID: "zone-uid", Annotations: map[string]string{DEFAULT_RECORDS_ANNOTATION: "old.example.org. A"}},
+		Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, DefaultRecords: []dnsv1alpha2.RRsetTemplate{
+			{Type: "MX", Name: "example.org.", Records: []string{"10 mail.example.org."}},
+			{Type: "A", Name: "www", Records: []string{"192.0.2.1"}},
+			{Type: "txt", Name: "_dmarc", TTL: 300, Records: []string{"\"v=DMARC1; p=none\""}},
+			{Type: "A", Name: "old", Records: []string{"192.0.2.2"}},
+		}},
+	}
+	userRRset := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "www", Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"}, Type: "A", Name: "www", Records: []string{"192.0.2.10"}}}
+
+	scheme := runtime.NewScheme()
+	_ = dnsv1alpha2.AddToScheme(scheme)
+	indexZoneRef := func(o client.Object) []string {
+		return []string{dnsv1alpha2.ZoneRefKey(o.(dnsv1alpha2.GenericRRset).GetSpec().ZoneRef)}
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(zone, userRRset).
+		WithIndex(&dnsv1alpha2.RRset{}, "RRset.ZoneRef", indexZoneRef).
+		WithIndex(&dnsv1alpha2.ClusterRRset{}, "ClusterRRset.ZoneRef", indexZoneRef).
+		Build()
+
+	listRRsets := func() []dnsv1alpha2.RRset {
+		var rrsets dnsv1alpha2.RRsetList
+		if err := cl.List(ctx, &rrsets); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return rrsets.Items
+	}
+	reconcile := func() {
+		if err := cl.Get(ctx, client.ObjectKeyFromObject(zone), zone); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := defaultRecordsReconcile(ctx, zone, scheme, cl, log.FromContext(ctx)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	reconcile()
+	var result []string
+	for _, r := range listRRsets() {
+		result = append(result, r.Name)
+		if r.Name != userRRset.Name && !metav1.IsControlledBy(&r, zone) {
+			t.Errorf("RRset %s is not owned by the zone", r.Name)
+		}
+	}
+	expected := []string{"example.org--dmarc-txt", "example.org-apex-mx", "www"}
+	if !cmp.Equal(result, expected) {
+		t.Errorf("got %v, want %v", result, expected)
+	}
+
+	// The default RRsets deleted afterwards are not recreated
+	for _, r := range listRRsets() {
+		if r.Name != userRRset.Name {
+			if err := cl.Delete(ctx, &r); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	}
+	reconcile()
+	if rrsets := listRRsets(); len(rrsets) != 1 || rrsets[0].Name != userRRset.Name {
+		t.Errorf("got %v, want only the RRset %s", rrsets, userRRset.Name)
+	}
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(zone), zone); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = []string{"old.example.org. A", "example.org. MX", "www.example.org. A", "_dmarc.example.org. TXT"}
+	if result := getAppliedDefaultRecords(zone); !cmp.Equal(result, expected) {
+		t.Errorf("got %v, want %v", result, expected)
+	}
+}
+
+func TestEmptyRecordsRrsetExternalResources(t *testing.T) {
+	var (
+		zoneName  = "example.org"
+		namespace = "example"
+		records   = []string{"1.1.1.1", "2.2.2.2"}
+	)
+	ctx := context.Background()
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE}}
+	rrset := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "empty", Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: "empty", TTL: 300, Records: records}}
+	if _, err := createOrUpdateRrsetExternalResources(ctx, zone, rrset, nil, PDNSClient); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Applying the RRset without records is rejected, the records are kept in PowerDNS
+	rrset.Spec.Records = []string{}
+	changed, err := createOrUpdateRrsetExternalResources(ctx, zone, rrset, nil, PDNSClient)
+	if err == nil {
+		t.Errorf("an error was expected")
+	}
+	if changed {
+		t.Errorf("got %v, want %v", changed, false)
+	}
+	if result := getMockedRecordsForType("empty."+zoneName, "A"); !cmp.Equal(result, records) {
+		t.Errorf("got %v, want %v", result, records)
+	}
+}
+
+func TestDisabledRecordsExternalResources(t *testing.T) {
+	var (
+		zoneName    = "example.org"
+		namespace   = "example"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+		rrsetName   = "disabled"
+		records     = []string{"1.1.1.1", "2.2.2.2"}
+	)
+	ctx := context.Background()
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, Nameservers: nameservers}}
+	rrset := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: rrsetName, Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: rrsetName, TTL: 300, Records: records, DisabledRecords: []string{"2.2.2.2"}}}
+	disabledRecords := func() (result []string) {
+		stored, _ := readFromRecordsMap(makeCanonical(rrsetName + "." + zoneName))
+		for _, r := range stored.Records {
+			if ptr.Deref(r.Disabled, false) {
+				result = append(result, *r.Content)
+			}
+		}
+		return
+	}
+
+	var testCases = []struct {
+		description     string
+		disabledRecords []string
+		modified        bool
+	}{
+		{"Record disabled", []string{"2.2.2.2"}, true},
+		{"Disabled record unchanged", []string{"2.2.2.2"}, false},
+		{"Record enabled again", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			rrset.Spec.DisabledRecords = tc.disabledRecords
+			modified, err := createOrUpdateRrsetExternalResources(ctx, zone, rrset, nil, PDNSClient)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if modified != tc.modified {
+				t.Errorf("got %v, want %v", modified, tc.modified)
+			}
+			if got := disabledRecords(); !cmp.Equal(got, tc.disabledRecords) {
+				t.Errorf("got %v, want %v", got, tc.disabledRecords)
+			}
+		})
+	}
+}
+
+func TestNameserverGlueExternalResources(t *testing.T) {
+	var (
+		name        = "example.org"
+		nameservers = []string{"ns1.example.org", "ns2.example.org", "ns1.example.net"}
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: dnsv1alpha2.ZoneSpec{
+			Kind:        NATIVE_KIND_ZONE,
+			Nameservers: nameservers,
+			NameserverGlue: map[string][]string{
+				"ns1.example.org": {"192.0.2.1"},
+				"ns2.example.org": {"2001:db8::2"},
+			},
+		},
+	}
+
+	// In-bailiwick glue is created
+	if err := nameserverGlueExternalResourcesReconcile(ctx, zone, nil, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := getMockedRecordsForType("ns1.example.org", "A"); !cmp.Equal(got, []string{"192.0.2.1"}) {
+		t.Errorf("got %v, want %v", got, []string{"192.0.2.1"})
+	}
+	if got := getMockedRecordsForType("ns2.example.org", "AAAA"); !cmp.Equal(got, []string{"2001:db8::2"}) {
+		t.Errorf("got %v, want %v", got, []string{"2001:db8::2"})
+	}
+	if !cmp.Equal(zone.Status.NameserverGlue, zone.Spec.NameserverGlue) {
+		t.Errorf("got %v, want %v", zone.Status.NameserverGlue, zone.Spec.NameserverGlue)
+	}
+
+	// Removed glue is deleted
+	zone.Spec.NameserverGlue = map[string][]string{"ns1.example.org": {"192.0.2.10"}}
+	if err := nameserverGlueExternalResourcesReconcile(ctx, zone, nil, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := getMockedRecordsForType("ns1.example.org", "A"); !cmp.Equal(got, []string{"192.0.2.10"}) {
+		t.Errorf("got %v, want %v", got, []string{"192.0.2.10"})
+	}
+	if _, found := readFromRecordsMap(makeCanonical("ns2.example.org")); found {
+		t.Errorf("glue record of ns2.example.org should have been deleted")
+	}
+	if !cmp.Equal(zone.Status.NameserverGlue, zone.Spec.NameserverGlue) {
+		t.Errorf("got %v, want %v", zone.Status.NameserverGlue, zone.Spec.NameserverGlue)
+	}
+
+	// Out-of-bailiwick glue is rejected
+	zone.Spec.NameserverGlue = map[string][]string{"ns1.example.net": {"192.0.2.20"}}
+	if err := nameserverGlueExternalResourcesReconcile(ctx, zone, nil, PDNSClient, log); err == nil {
+		t.Errorf("an error was expected")
+	}
+	if _, found := readFromRecordsMap(makeCanonical("ns1.example.net")); found {
+		t.Errorf("glue record of ns1.example.net should not have been created")
+	}
+}
+
+func TestNameserverGlueTTLExternalResources(t *testing.T) {
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+	_ = PDNSClient.Records.Change(ctx, "example.org", "example.org.", powerdns.RRTypeNS, 1800, []string{"ns1.example.org."})
+
+	zone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: "example.org"},
+		Spec: dnsv1alpha2.ZoneSpec{
+			Kind:           NATIVE_KIND_ZONE,
+			Nameservers:    []string{"ns1.example.org"},
+			NameserverGlue: map[string][]string{"ns1.example.org": {"192.0.2.1"}},
+		},
+	}
+
+	var testCases = []struct {
+		description   string
+		nameserverTTL *uint32
+		expectedTTL   uint32
+	}{
+		{"TTL of the NS records in PowerDNS", nil, 1800},
+		{"nameserverTTL of the zone", ptr.To(uint32(7200)), 7200},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			zone.Spec.NameserverTTL = tc.nameserverTTL
+			if err := nameserverGlueExternalResourcesReconcile(ctx, zone, nil, PDNSClient, log); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result := getMockedTTL("ns1.example.org", "A"); result != tc.expectedTTL {
+				t.Errorf("got %v, want %v", result, tc.expectedTTL)
+			}
+		})
+	}
+}
+
+func TestNameserverGlueManagedByRRsetExternalResources(t *testing.T) {
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: "example.org"},
+		Spec: dnsv1alpha2.ZoneSpec{
+			Kind:           NATIVE_KIND_ZONE,
+			Nameservers:    []string{"ns1.example.org", "ns2.example.org"},
+			NameserverGlue: map[string][]string{"ns1.example.org": {"192.0.2.1"}, "ns2.example.org": {"192.0.2.2"}},
+		},
+	}
+	if err := nameserverGlueExternalResourcesReconcile(ctx, zone, nil, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The address of ns2.example.org is now managed by a RRset
+	rrsets := []dnsv1alpha2.GenericRRset{&dnsv1alpha2.ClusterRRset{
+		ObjectMeta: metav1.ObjectMeta{Name: "ns2.example.org"},
+		Spec:       dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "ClusterZone"}, Type: DUAL_STACK_TYPE, Name: "ns2", Records: []string{"192.0.2.2"}},
+	}}
+
+	// A glue colliding with the RRset is rejected
+	if err := nameserverGlueExternalResourcesReconcile(ctx, zone, rrsets, PDNSClient, log); err == nil {
+		t.Errorf("an error was expected")
+	}
+
+	// A glue removed from the zone does not delete the record of the RRset
+	zone.Spec.NameserverGlue = map[string][]string{"ns1.example.org": {"192.0.2.1"}}
+	if err := nameserverGlueExternalResourcesReconcile(ctx, zone, rrsets, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := getMockedRecordsForType("ns2.example.org", "A"); !cmp.Equal(got, []string{"192.0.2.2"}) {
+		t.Errorf("got %v, want %v", got, []string{"192.0.2.2"})
+	}
+	if !cmp.Equal(zone.Status.NameserverGlue, zone.Spec.NameserverGlue) {
+		t.Errorf("got %v, want %v", zone.Status.NameserverGlue, zone.Spec.NameserverGlue)
+	}
+}
+
+// glueRecordsClient counts the changes, the records it deletes are already deleted from PowerDNS
+type glueRecordsClient struct {
+	pdnsRecordsClienter
+	changes int
+}
+
+func (c *glueRecordsClient) Change(ctx context.Context, domain string, name string, recordType powerdns.RRType, ttl uint32, content []string, options ...func(*powerdns.RRset)) error {
+	c.changes++
+	return c.pdnsRecordsClienter.Change(ctx, domain, name, recordType, ttl, content, options...)
+}
+
+func (c *glueRecordsClient) Delete(ctx context.Context, domain string, name string, recordType powerdns.RRType) error {
+	return powerdns.Error{StatusCode: ZONE_NOT_FOUND_CODE, Status: fmt.Sprintf("%d %s", ZONE_NOT_FOUND_CODE, ZONE_NOT_FOUND_MSG), Message: ZONE_NOT_FOUND_MSG}
+}
+
+func TestNameserverGlueIdempotentExternalResources(t *testing.T) {
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	records := &glueRecordsClient{pdnsRecordsClienter: PDNSClient.Records}
+	pdnsClient := PdnsClienter{Records: records, Zones: PDNSClient.Zones}
+	zone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: "example.org"},
+		Spec: dnsv1alpha2.ZoneSpec{
+			Kind:           NATIVE_KIND_ZONE,
+			Nameservers:    []string{"ns1.example.org", "ns2.example.org"},
+			NameserverGlue: map[string][]string{"ns1.example.org": {"192.0.2.1", "192.0.2.2"}, "ns2.example.org": {"192.0.2.3"}},
+		},
+	}
+	if err := nameserverGlueExternalResourcesReconcile(ctx, zone, nil, pdnsClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var testCases = []struct {
+		description     string
+		glue            map[string][]string
+		expectedChanges int
+	}{
+		{"Addresses in another order", map[string][]string{"ns1.example.org": {"192.0.2.2", "192.0.2.1"}, "ns2.example.org": {"192.0.2.3"}}, 0},
+		{"Glue already deleted from PowerDNS", map[string][]string{"ns1.example.org": {"192.0.2.2", "192.0.2.1"}}, 0},
+		{"Address changed", map[string][]string{"ns1.example.org": {"192.0.2.1"}}, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			records.changes = 0
+			zone.Spec.NameserverGlue = tc.glue
+			if err := nameserverGlueExternalResourcesReconcile(ctx, zone, nil, pdnsClient, log); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if records.changes != tc.expectedChanges {
+				t.Errorf("got %v, want %v", records.changes, tc.expectedChanges)
+			}
+		})
+	}
+}
+
+// notFoundRecordsClient answers to Records.Get with a PowerDNS not-found error
+type notFoundRecordsClient struct {
+	pdnsRecordsClienter
+}
+
+func (c *notFoundRecordsClient) Get(ctx context.Context, domain string, name string, recordType *powerdns.RRType) ([]powerdns.RRset, error) {
+	return nil, powerdns.Error{StatusCode: ZONE_NOT_FOUND_CODE, Status: fmt.Sprintf("%d %s", ZONE_NOT_FOUND_CODE, ZONE_NOT_FOUND_MSG), Message: ZONE_NOT_FOUND_MSG}
+}
+
+func TestDualStackRrsetNotFoundExternalResources(t *testing.T) {
+	ctx := context.Background()
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	pdnsClient := PdnsClienter{Records: &notFoundRecordsClient{pdnsRecordsClienter: PDNSClient.Records}, Zones: PDNSClient.Zones}
+	zone := &dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: "example.org"}}
+	rrset := &dnsv1alpha2.ClusterRRset{
+		ObjectMeta: metav1.ObjectMeta{Name: "www"},
+		Spec:       dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "ClusterZone"}, Type: DUAL_STACK_TYPE, Name: "www", TTL: 300, Records: []string{"192.0.2.1"}},
+	}
+
+	// The AAAA RRset, without addresses, is not found in PowerDNS
+	changed, err := createOrUpdateRrsetExternalResources(ctx, zone, rrset, nil, pdnsClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !changed {
+		t.Errorf("the A RRset should have been created")
+	}
+	if _, found := readFromRecordsMap(makeCanonical("www.example.org")); !found {
+		t.Errorf("the A RRset should have been created")
+	}
+}
+
+func TestNsec3ParamsExternalResources(t *testing.T) {
+	var (
+		name        = "example.org"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+		nsec3Params = "1 0 0 ab"
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: dnsv1alpha2.ZoneSpec{
+			Kind:        NATIVE_KIND_ZONE,
+			Nameservers: nameservers,
+			DNSSEC:      ptr.To(true),
+			Nsec3Params: ptr.To(nsec3Params),
+		},
+	}
+
+	// NSEC3PARAM is applied
+	if err := nsec3ParamsExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := readFromMetadataMap(name, powerdns.MetadataNSEC3Param); !cmp.Equal(got, []string{nsec3Params}) {
+		t.Errorf("got %v, want %v", got, []string{nsec3Params})
+	}
+	if !cmp.Equal(zone.Status.Nsec3Params, ptr.To(nsec3Params)) {
+		t.Errorf("got %v, want %v", ptr.Deref(zone.Status.Nsec3Params, ""), nsec3Params)
+	}
+
+	// Drift is rectified
+	writeToMetadataMap(name, powerdns.MetadataNSEC3Param, []string{"1 0 10 cd"})
+	if err := nsec3ParamsExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := readFromMetadataMap(name, powerdns.MetadataNSEC3Param); !cmp.Equal(got, []string{nsec3Params}) {
+		t.Errorf("got %v, want %v", got, []string{nsec3Params})
+	}
+
+	// Removed NSEC3PARAM switches back to NSEC
+	zone.Spec.Nsec3Params = nil
+	if err := nsec3ParamsExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, found := readFromMetadataMap(name, powerdns.MetadataNSEC3Param); found {
+		t.Errorf("NSEC3PARAM metadata should have been deleted")
+	}
+	if zone.Status.Nsec3Params != nil {
+		t.Errorf("got %v, want nil", *zone.Status.Nsec3Params)
+	}
+}
+
+func TestCryptokeyExternalResources(t *testing.T) {
+	var (
+		name      = "example.org"
+		namespace = "example"
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	cryptokey := &dnsv1alpha2.Cryptokey{
+		ObjectMeta: metav1.ObjectMeta{Name: "ksk", Namespace: namespace},
+		Spec:       dnsv1alpha2.CryptokeySpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: name, Kind: "Zone"}, KeyType: "ksk"},
+	}
+
+	// Creation
+	cryptokeyRes, err := cryptokeyExternalResourcesReconcile(ctx, zone, cryptokey, PDNSClient, log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cryptokey.SetAvailable(cryptokeyRes)
+	if len(readFromCryptokeysMap(makeCanonical(name))) != 1 {
+		t.Errorf("cryptokey should have been created")
+	}
+	if !cmp.Equal(ptr.Deref(cryptokey.Status.Active, false), true) {
+		t.Errorf("got %v, want %v", ptr.Deref(cryptokey.Status.Active, false), true)
+	}
+	if cryptokey.Status.KeyTag == nil || len(cryptokey.Status.DS) == 0 {
+		t.Errorf("key tag and DS records should be exposed in status")
+	}
+	id := *cryptokey.Status.ID
+
+	// Deactivation
+	cryptokey.Spec.Active = ptr.To(false)
+	cryptokeyRes, err = cryptokeyExternalResourcesReconcile(ctx, zone, cryptokey, PDNSClient, log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cryptokey.SetAvailable(cryptokeyRes)
+	if !cmp.Equal(*cryptokey.Status.ID, id) {
+		t.Errorf("got %v, want %v", *cryptokey.Status.ID, id)
+	}
+	if !cmp.Equal(*readFromCryptokeysMap(makeCanonical(name))[0].Active, false) {
+		t.Errorf("cryptokey should have been deactivated")
+	}
+
+	// Deletion
+	if err := deleteCryptokeyExternalResources(ctx, zone, cryptokey, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(readFromCryptokeysMap(makeCanonical(name))) != 0 {
+		t.Errorf("cryptokey should have been deleted")
+	}
+
+	// Re-creation of a cryptokey deleted out of band
+	if _, err = cryptokeyExternalResourcesReconcile(ctx, zone, cryptokey, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(readFromCryptokeysMap(makeCanonical(name))) != 1 {
+		t.Errorf("cryptokey should have been created again")
+	}
+
+	// Communication error
+	fakeZone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: FAKE_SITE, Namespace: namespace}}
+	if _, err := cryptokeyExternalResourcesReconcile(ctx, fakeZone, &dnsv1alpha2.Cryptokey{Spec: dnsv1alpha2.CryptokeySpec{KeyType: "zsk"}}, PDNSClient, log); err == nil {
+		t.Errorf("an error was expected")
+	}
+}
+
+func TestDNSSECMaintenanceZoneReconcile(t *testing.T) {
+	var (
+		name = "example.org"
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	writeToCryptokeysMap(makeCanonical(name), []powerdns.Cryptokey{
+		{ID: ptr.To(uint64(1)), KeyType: ptr.To("ksk"), Active: ptr.To(true), Algorithm: ptr.To("ED25519")},
+		{ID: ptr.To(uint64(2)), KeyType: ptr.To("zsk"), Active: ptr.To(true), Algorithm: ptr.To("ED25519")},
+	})
+	maintenance := &dnsv1alpha2.DNSSECMaintenance{
+		Spec: dnsv1alpha2.DNSSECMaintenanceSpec{
+			Rectify:            ptr.To(true),
+			ZSKRollover:        ptr.To(true),
+			PrepublishDuration: &metav1.Duration{Duration: time.Hour},
+		},
+	}
+	zoneStatus := dnsv1alpha2.DNSSECMaintenanceZoneStatus{Name: name, Phase: dnsv1alpha2.MAINTENANCE_PENDING_PHASE}
+
+	// The zone is rectified and a new inactive ZSK is published
+	if wait := dnssecMaintenanceZoneReconcile(ctx, maintenance, name, false, &zoneStatus, PDNSClient, log); wait != time.Hour {
+		t.Errorf("got %v, want %v", wait, time.Hour)
+	}
+	if zoneStatus.Phase != dnsv1alpha2.MAINTENANCE_PREPUBLISHED_PHASE || ptr.Deref(zoneStatus.Rectify, "") != "Rectified" {
+		t.Errorf("got %s (%v), want %s", zoneStatus.Phase, ptr.Deref(zoneStatus.Message, ""), dnsv1alpha2.MAINTENANCE_PREPUBLISHED_PHASE)
+	}
+	keys := readFromCryptokeysMap(makeCanonical(name))
+	if len(keys) != 3 || ptr.Deref(keys[2].Active, true) || ptr.Deref(keys[2].Algorithm, "") != "ED25519" {
+		t.Errorf("an inactive ZSK should have been published, got %v", keys)
+	}
+
+	// Nothing is done before the end of the prepublish duration
+	if wait := dnssecMaintenanceZoneReconcile(ctx, maintenance, name, false, &zoneStatus, PDNSClient, log); wait <= 0 {
+		t.Errorf("got %v, want a positive wait", wait)
+	}
+
+	// The new ZSK is activated and the previous one deactivated
+	zoneStatus.PrepublishTime = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+	if wait := dnssecMaintenanceZoneReconcile(ctx, maintenance, name, false, &zoneStatus, PDNSClient, log); wait != 0 {
+		t.Errorf("got %v, want 0", wait)
+	}
+	if zoneStatus.Phase != dnsv1alpha2.MAINTENANCE_SUCCEEDED_PHASE {
+		t.Errorf("got %s (%v), want %s", zoneStatus.Phase, ptr.Deref(zoneStatus.Message, ""), dnsv1alpha2.MAINTENANCE_SUCCEEDED_PHASE)
+	}
+	var active []uint64
+	for _, key := range readFromCryptokeysMap(makeCanonical(name)) {
+		if ptr.Deref(key.Active, false) {
+			active = append(active, *key.ID)
+		}
+	}
+	if !cmp.Equal(active, []uint64{1, 3}) {
+		t.Errorf("got %v, want %v", active, []uint64{1, 3})
+	}
+
+	// Zones whose keys are managed through Cryptokeys are skipped
+	zoneStatus = dnsv1alpha2.DNSSECMaintenanceZoneStatus{Name: name, Phase: dnsv1alpha2.MAINTENANCE_PENDING_PHASE}
+	dnssecMaintenanceZoneReconcile(ctx, maintenance, name, true, &zoneStatus, PDNSClient, log)
+	if zoneStatus.Phase != dnsv1alpha2.MAINTENANCE_SKIPPED_PHASE || len(readFromCryptokeysMap(makeCanonical(name))) != 3 {
+		t.Errorf("got %s, want %s", zoneStatus.Phase, dnsv1alpha2.MAINTENANCE_SKIPPED_PHASE)
+	}
+
+	// Failures are reported in the zone status
+	zoneStatus = dnsv1alpha2.DNSSECMaintenanceZoneStatus{Name: FAKE_SITE, Phase: dnsv1alpha2.MAINTENANCE_PENDING_PHASE}
+	writeToCryptokeysMap(makeCanonical(FAKE_SITE), []powerdns.Cryptokey{{ID: ptr.To(uint64(1)), KeyType: ptr.To("zsk"), Active: ptr.To(true)}})
+	maintenance.Spec.Rectify = nil
+	dnssecMaintenanceZoneReconcile(ctx, maintenance, FAKE_SITE, false, &zoneStatus, PDNSClient, log)
+	if zoneStatus.Phase != dnsv1alpha2.MAINTENANCE_FAILED_PHASE || zoneStatus.Message == nil {
+		t.Errorf("got %s, want %s", zoneStatus.Phase, dnsv1alpha2.MAINTENANCE_FAILED_PHASE)
+	}
+}
+
+func TestTSIGKeyExternalResources(t *testing.T) {
+	var (
+		name      = "transfer-key"
+		namespace = "example"
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	tsigKey := &dnsv1alpha2.TSIGKey{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       dnsv1alpha2.TSIGKeySpec{Algorithm: "hmac-sha256"},
+	}
+
+	// Creation with a generated key
+	tsigKeyRes, err := tsigKeyExternalResourcesReconcile(ctx, tsigKey, "", PDNSClient, log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tsigKey.SetAvailable(tsigKeyRes)
+	if ptr.Deref(tsigKeyRes.Key, "") == "" {
+		t.Errorf("generated key should be returned")
+	}
+	if !cmp.Equal(ptr.Deref(tsigKey.Status.SecretName, ""), name) {
+		t.Errorf("got %v, want %v", ptr.Deref(tsigKey.Status.SecretName, ""), name)
+	}
+	if !cmp.Equal(ptr.Deref(tsigKey.Status.Name, ""), "transfer-key.example") {
+		t.Errorf("got %v, want %v", ptr.Deref(tsigKey.Status.Name, ""), "transfer-key.example")
+	}
+
+	// A TSIGKey of the same name in another namespace does not collide
+	otherTSIGKey := &dnsv1alpha2.TSIGKey{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "other"},
+		Spec:       dnsv1alpha2.TSIGKeySpec{Algorithm: "hmac-sha256"},
+	}
+	otherTSIGKeyRes, err := tsigKeyExternalResourcesReconcile(ctx, otherTSIGKey, "b3RoZXI=", PDNSClient, log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ptr.Deref(otherTSIGKeyRes.ID, "") == ptr.Deref(tsigKey.Status.ID, "") {
+		t.Errorf("TSIGKeys of different namespaces should not share the same key in PowerDNS")
+	}
+
+	// Algorithm and key update
+	tsigKey.Spec.Algorithm = "hmac-sha512"
+	if _, err = tsigKeyExternalResourcesReconcile(ctx, tsigKey, "c2VjcmV0", PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	external, _ := readFromTSIGKeysMap(*tsigKey.Status.ID)
+	if !cmp.Equal(ptr.Deref(external.Algorithm, ""), "hmac-sha512") {
+		t.Errorf("got %v, want %v", ptr.Deref(external.Algorithm, ""), "hmac-sha512")
+	}
+	if !cmp.Equal(ptr.Deref(external.Key, ""), "c2VjcmV0") {
+		t.Errorf("got %v, want %v", ptr.Deref(external.Key, ""), "c2VjcmV0")
+	}
+
+	// Deletion
+	if err := deleteTSIGKeyExternalResources(ctx, tsigKey, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, found := readFromTSIGKeysMap(*tsigKey.Status.ID); found {
+		t.Errorf("TSIG key should have been deleted")
+	}
+
+	// Deletion of a TSIG key already deleted
+	if err := deleteTSIGKeyExternalResources(ctx, tsigKey, PDNSClient, log); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// A key created with the name of the TSIGKey only is renamed
+	legacyKeyRes, _ := PDNSClient.TSIGKeys.Create(ctx, name, "hmac-sha256", "c2VjcmV0")
+	tsigKey.Status.ID = legacyKeyRes.ID
+	tsigKeyRes, err = tsigKeyExternalResourcesReconcile(ctx, tsigKey, "c2VjcmV0", PDNSClient, log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cmp.Equal(ptr.Deref(tsigKeyRes.Name, ""), "transfer-key.example") {
+		t.Errorf("got %v, want %v", ptr.Deref(tsigKeyRes.Name, ""), "transfer-key.example")
+	}
+	if _, found := readFromTSIGKeysMap(makeCanonical(name)); found {
+		t.Errorf("TSIG key should have been renamed")
+	}
+}
+
+func TestTSIGMetadataExternalResources(t *testing.T) {
+	var (
+		name        = "example.org"
+		namespace   = "example"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	clusterZone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: dnsv1alpha2.ZoneSpec{
+			Kind:               NATIVE_KIND_ZONE,
+			Nameservers:        nameservers,
+			TSIGAllowAXFR:      []string{"team-a/transfer-key", "team-b/transfer-key"},
+			TSIGAllowDNSUpdate: []string{"team-a/update-key"},
+		},
+	}
+
+	// Metadata is applied with the names of the keys in PowerDNS
+	if err := tsigMetadataExternalResourcesReconcile(ctx, clusterZone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := readFromMetadataMap(name, powerdns.MetadataTSIGAllowAXFR); !cmp.Equal(got, []string{"transfer-key.team-a", "transfer-key.team-b"}) {
+		t.Errorf("got %v, want %v", got, []string{"transfer-key.team-a", "transfer-key.team-b"})
+	}
+	if got, _ := readFromMetadataMap(name, powerdns.MetadataTSIGAllowDNSUpdate); !cmp.Equal(got, []string{"update-key.team-a"}) {
+		t.Errorf("got %v, want %v", got, []string{"update-key.team-a"})
+	}
+
+	// Stale entries are removed
+	clusterZone.Spec.TSIGAllowAXFR = []string{"team-a/transfer-key"}
+	clusterZone.Spec.TSIGAllowDNSUpdate = nil
+	if err := tsigMetadataExternalResourcesReconcile(ctx, clusterZone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := readFromMetadataMap(name, powerdns.MetadataTSIGAllowAXFR); !cmp.Equal(got, []string{"transfer-key.team-a"}) {
+		t.Errorf("got %v, want %v", got, []string{"transfer-key.team-a"})
+	}
+	if _, found := readFromMetadataMap(name, powerdns.MetadataTSIGAllowDNSUpdate); found {
+		t.Errorf("TSIG-ALLOW-DNSUPDATE metadata should have been deleted")
+	}
+
+	// ClusterZones reference the TSIGKeys with their namespace
+	clusterZone.Spec.TSIGAllowAXFR = []string{"transfer-key"}
+	if err := tsigMetadataExternalResourcesReconcile(ctx, clusterZone, PDNSClient, log); err == nil {
+		t.Errorf("a TSIGKey reference without namespace should be rejected for a ClusterZone")
+	}
+
+	// Zones reference the TSIGKeys of their namespace
+	zone := &dnsv1alpha2.Zone{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: dnsv1alpha2.ZoneSpec{
+			Kind:          NATIVE_KIND_ZONE,
+			Nameservers:   nameservers,
+			TSIGAllowAXFR: []string{"transfer-key"},
+		},
+	}
+	if err := tsigMetadataExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := readFromMetadataMap(name, powerdns.MetadataTSIGAllowAXFR); !cmp.Equal(got, []string{"transfer-key.example"}) {
+		t.Errorf("got %v, want %v", got, []string{"transfer-key.example"})
+	}
+}
+
+func TestAlsoNotifyExternalResources(t *testing.T) {
+	var (
+		name        = "example.org"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: dnsv1alpha2.ZoneSpec{
+			Kind:        MASTER_KIND_ZONE,
+			Nameservers: nameservers,
+			AlsoNotify:  []string{"192.0.2.1", "[2001:db8::1]:5300"},
+		},
+	}
+
+	// Metadata is applied and reported in status
+	if err := alsoNotifyExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := readFromMetadataMap(name, powerdns.MetadataAlsoNotify); !cmp.Equal(got, zone.Spec.AlsoNotify) {
+		t.Errorf("got %v, want %v", got, zone.Spec.AlsoNotify)
+	}
+	if !cmp.Equal(zone.Status.AlsoNotify, zone.Spec.AlsoNotify) {
+		t.Errorf("got %v, want %v", zone.Status.AlsoNotify, zone.Spec.AlsoNotify)
+	}
+
+	// Drift is corrected
+	writeToMetadataMap(name, powerdns.MetadataAlsoNotify, []string{"198.51.100.1"})
+	if err := alsoNotifyExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := readFromMetadataMap(name, powerdns.MetadataAlsoNotify); !cmp.Equal(got, zone.Spec.AlsoNotify) {
+		t.Errorf("got %v, want %v", got, zone.Spec.AlsoNotify)
+	}
+
+	// Metadata is removed with the addresses
+	zone.Spec.AlsoNotify = nil
+	if err := alsoNotifyExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, found := readFromMetadataMap(name, powerdns.MetadataAlsoNotify); found {
+		t.Errorf("ALSO-NOTIFY metadata should have been deleted")
+	}
+	if zone.Status.AlsoNotify != nil {
+		t.Errorf("got %v, want nil", zone.Status.AlsoNotify)
+	}
+}
+
+func TestAllowAXFRFromExternalResources(t *testing.T) {
+	var (
+		name        = "example.org"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	writeToMetadataMap(name, powerdns.MetadataAllowAXFRFrom, []string{"198.51.100.0/24"})
+	zone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers},
+	}
+
+	// Metadata is left to spec.metadata when omitted
+	zone.Spec.Metadata = map[string][]string{"ALLOW-AXFR-FROM": {"198.51.100.0/24"}}
+	if err := allowAXFRFromExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := readFromMetadataMap(name, powerdns.MetadataAllowAXFRFrom); !cmp.Equal(got, []string{"198.51.100.0/24"}) {
+		t.Errorf("got %v, want %v", got, []string{"198.51.100.0/24"})
+	}
+
+	// Metadata is applied and kept by spec.metadata
+	zone.Spec.AllowAXFRFrom = []string{"192.0.2.0/24", dnsv1alpha2.ALLOW_AXFR_FROM_AUTO_NS}
+	zone.Spec.Metadata = map[string][]string{}
+	if err := allowAXFRFromExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := metadataExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := readFromMetadataMap(name, powerdns.MetadataAllowAXFRFrom); !cmp.Equal(got, zone.Spec.AllowAXFRFrom) {
+		t.Errorf("got %v, want %v", got, zone.Spec.AllowAXFRFrom)
+	}
+
+	// An empty list removes the metadata
+	zone.Spec.AllowAXFRFrom = []string{}
+	if err := allowAXFRFromExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, found := readFromMetadataMap(name, powerdns.MetadataAllowAXFRFrom); found {
+		t.Errorf("ALLOW-AXFR-FROM metadata should have been deleted")
+	}
+
+	// Removing the field removes the metadata
+	writeToMetadataMap(name, powerdns.MetadataAllowAXFRFrom, []string{"192.0.2.0/24"})
+	zone.Spec.AllowAXFRFrom = nil
+	if err := allowAXFRFromExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, found := readFromMetadataMap(name, powerdns.MetadataAllowAXFRFrom); found {
+		t.Errorf("ALLOW-AXFR-FROM metadata should have been deleted")
+	}
+}
+
+func TestMetadataExternalResources(t *testing.T) {
+	var (
+		name        = "example.org"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	writeToMetadataMap(name, powerdns.MetadataTSIGAllowAXFR, []string{"transfer-key"})
+	writeToMetadataMap(name, powerdns.MetadataSOAEdit, []string{"INCEPTION-EPOCH"})
+	zone := &dnsv1alpha2.ClusterZone{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, Nameservers: nameservers},
+	}
+
+	// Metadata is not managed when omitted
+	if err := metadataExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string][]string{"TSIG-ALLOW-AXFR": {"transfer-key"}, "SOA-EDIT": {"INCEPTION-EPOCH"}}
+	if !cmp.Equal(zone.Status.Metadata, expected) {
+		t.Errorf("got %v, want %v", zone.Status.Metadata, expected)
+	}
+
+	// Metadata is applied, unlisted kinds are deleted except the reserved ones
+	zone.Spec.Metadata = map[string][]string{"ALLOW-AXFR-FROM": {"192.0.2.0/24", "2001:db8::/32"}, "NSEC3PARAM": {"1 0 0 -"}}
+	if err := metadataExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = map[string][]string{"TSIG-ALLOW-AXFR": {"transfer-key"}, "ALLOW-AXFR-FROM": {"192.0.2.0/24", "2001:db8::/32"}}
+	if !cmp.Equal(zone.Status.Metadata, expected) {
+		t.Errorf("got %v, want %v", zone.Status.Metadata, expected)
+	}
+	if got, _ := readFromMetadataMap(name, powerdns.MetadataAllowAXFRFrom); !cmp.Equal(got, expected["ALLOW-AXFR-FROM"]) {
+		t.Errorf("got %v, want %v", got, expected["ALLOW-AXFR-FROM"])
+	}
+	if _, found := readFromMetadataMap(name, powerdns.MetadataSOAEdit); found {
+		t.Errorf("SOA-EDIT metadata should have been deleted")
+	}
+	if _, found := readFromMetadataMap(name, powerdns.MetadataNSEC3Param); found {
+		t.Errorf("NSEC3PARAM metadata should not be managed through spec.metadata")
+	}
+
+	// An empty map removes all the unreserved metadata, auto-rectify is applied through API-RECTIFY
+	zone.Spec.Metadata = map[string][]string{}
+	zone.Spec.AutoRectify = ptr.To(true)
+	if err := metadataExternalResourcesReconcile(ctx, zone, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = map[string][]string{"TSIG-ALLOW-AXFR": {"transfer-key"}, "API-RECTIFY": {"1"}}
+	if !cmp.Equal(zone.Status.Metadata, expected) {
+		t.Errorf("got %v, want %v", zone.Status.Metadata, expected)
+	}
+}
+
+func TestRecordAvailableEvent(t *testing.T) {
+	succeeded := metav1.Condition{Type: "Available", Status: metav1.ConditionTrue, Reason: dnsv1alpha2.SUCCEEDED_REASON, Message: dnsv1alpha2.SUCCEEDED_MESSAGE}
+	duplicated := metav1.Condition{Type: "Available", Status: metav1.ConditionFalse, Reason: dnsv1alpha2.DUPLICATED_REASON, Message: dnsv1alpha2.ZONE_DUPLICATED_MESSAGE}
+	failed := metav1.Condition{Type: "Available", Status: metav1.ConditionFalse, Reason: dnsv1alpha2.SYNCHRONIZATION_FAILED_REASON, Message: dnsv1alpha2.SYNCHRONIZATION_FAILED_MESSAGE + "Internal Server Error"}
+
+	var testCases = []struct {
+		description string
+		previous    []metav1.Condition
+		current     []metav1.Condition
+		expected    []string
+	}{
+		{"Created", nil, []metav1.Condition{succeeded}, []string{"Normal " + dnsv1alpha2.SUCCEEDED_REASON + " " + dnsv1alpha2.SUCCEEDED_MESSAGE}},
+		{"Duplicated", nil, []metav1.Condition{duplicated}, []string{"Warning " + dnsv1alpha2.DUPLICATED_REASON + " " + dnsv1alpha2.ZONE_DUPLICATED_MESSAGE}},
+		{"Synchronization failed", []metav1.Condition{succeeded}, []metav1.Condition{failed}, []string{"Warning " + dnsv1alpha2.SYNCHRONIZATION_FAILED_REASON + " " + failed.Message}},
+		{"Unchanged", []metav1.Condition{failed}, []metav1.Condition{failed}, nil},
+		{"No condition", nil, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			recorder := events.NewFakeRecorder(10)
+			recordAvailableEvent(recorder, &dnsv1alpha2.Zone{}, tc.previous, tc.current)
+			close(recorder.Events)
+			var result []string
+			for e := range recorder.Events {
+				result = append(result, e)
+			}
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestDryRunRrsetExternalResources(t *testing.T) {
+	var (
+		zoneName  = "example.org"
+		namespace = "example"
+	)
+	ctx := context.Background()
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE}}
+
+	var testCases = []struct {
+		description   string
+		rrset         *dnsv1alpha2.RRset
+		expectedDrift bool
+	}{
+		{"RRset identical", &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "test.example.org", Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: "test", TTL: 1500, Records: []string{"1.1.1.2", "2.2.2.3"}}}, false},
+		{"RRset update", &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "test.example.org", Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: "test", TTL: 1500, Records: []string{"1.1.1.3"}}}, true},
+		{"RRset creation", &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "dry-run.example.org", Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: "dry-run", TTL: 1500, Records: []string{"1.1.1.3"}}}, true},
+	}
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+	dryRunClient := NewDryRunPdnsClienter(PDNSClient)
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			before, _ := readFromRecordsMap(makeCanonical(tc.rrset.Name))
+			_, err := createOrUpdateRrsetExternalResources(ctx, zone, tc.rrset, nil, dryRunClient)
+			var dryRunErr *DryRunError
+			if errors.As(err, &dryRunErr) != tc.expectedDrift {
+				t.Errorf("got %v, want a dry-run error: %v", err, tc.expectedDrift)
+			}
+			if after, _ := readFromRecordsMap(makeCanonical(tc.rrset.Name)); !reflect.DeepEqual(after, before) {
+				t.Errorf("got %v, want %v", after, before)
+			}
+
+			if err := dryRunReconcile(tc.rrset, err); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.expectedDrift && ptr.Deref(tc.rrset.Status.SyncStatus, "") != dnsv1alpha2.PENDING_STATUS {
+				t.Errorf("got %v, want %v", ptr.Deref(tc.rrset.Status.SyncStatus, ""), dnsv1alpha2.PENDING_STATUS)
+			}
+		})
+	}
+}
+
+func TestDryRunNotice(t *testing.T) {
+	var testCases = []struct {
+		description  string
+		podName      string
+		podNamespace string
+		expected     []string
+	}{
+		{"Operator Pod known", "powerdns-operator-7d9f", "powerdns-operator-system", []string{"Warning DryRun " + DRY_RUN_NOTICE_MESSAGE}},
+		{"Operator Pod unknown", "", "", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			recorder := events.NewFakeRecorder(10)
+			notice := &DryRunNotice{Recorder: recorder, PodName: tc.podName, PodNamespace: tc.podNamespace}
+			if err := notice.Start(context.Background()); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			close(recorder.Events)
+			var result []string
+			for e := range recorder.Events {
+				result = append(result, e)
+			}
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNameserverTTLExternalResources(t *testing.T) {
+	var (
+		name        = "example.org"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+		soaEditApi  = "DEFAULT"
+		catalog     = "catalog.org."
+	)
+	ctx := context.Background()
+
+	var testCases = []struct {
+		description   string
+		nameserverTTL *uint32
+		expectedTTL   uint32
+	}{
+		{"Default TTL kept", nil, DEFAULT_TTL_FOR_NS_RECORDS},
+		{"Nameserver TTL applied", ptr.To(uint32(3600)), 3600},
+		{"Nameserver TTL changed", ptr.To(uint32(86400)), 86400},
+		{"Existing TTL kept when unset", nil, 86400},
+	}
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			zone := &dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers, Catalog: &catalog, SOAEditAPI: &soaEditApi, NameserverTTL: tc.nameserverTTL}}
+			zoneRes, err := PDNSClient.Zones.Get(ctx, name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := zoneExternalResourcesReconcile(ctx, zoneRes, zone, PDNSClient, log.FromContext(ctx)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if result := getMockedTTL(name, string(powerdns.RRTypeNS)); result != tc.expectedTTL {
+				t.Errorf("got %v, want %v", result, tc.expectedTTL)
+			}
+		})
+	}
+}
+
+func TestUnmanagedNSExternalResources(t *testing.T) {
+	var (
+		name          = "example.org"
+		namespace     = "example"
+		nameservers   = []string{"ns1.example.org", "ns2.example.org"}
+		nsRecords     = []string{"ns1.example.net.", "ns2.example.net."}
+		expectedNs    = []string{"ns1.example.net", "ns2.example.net"}
+		soaEditApi    = "DEFAULT"
+		nsRRsetTTL    = uint32(3600)
+		nameserverTTL = uint32(86400)
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	var testCases = []struct {
+		description string
+		nameservers []string
+	}{
+		{"Without nameservers", nil},
+		{"With nameservers", nameservers},
+	}
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, Nameservers: tc.nameservers, SOAEditAPI: &soaEditApi, NameserverTTL: &nameserverTTL, ManageNS: ptr.To(false)}}
+			// The apex NS records are owned by an RRset
+			rrset := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "apex-ns", Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: name, Kind: "Zone"}, Type: string(powerdns.RRTypeNS), Name: name + ".", TTL: nsRRsetTTL, Records: nsRecords}}
+			if _, err := createOrUpdateRrsetExternalResources(ctx, zone, rrset, nil, PDNSClient); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			zoneRes, err := PDNSClient.Zones.Get(ctx, name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := zoneExternalResourcesReconcile(ctx, zoneRes, zone, PDNSClient, log); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if got := getMockedNameservers(name); !cmp.Equal(got, expectedNs) {
+				t.Errorf("got %v, want %v", got, expectedNs)
+			}
+			if got := getMockedTTL(name, string(powerdns.RRTypeNS)); got != nsRRsetTTL {
+				t.Errorf("got %v, want %v", got, nsRRsetTTL)
+			}
+		})
+	}
+}
+
+func TestCatalogMembershipReconcile(t *testing.T) {
+	var (
+		name        = "example.org"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+		soaEditApi  = "DEFAULT"
+		producer    = powerdns.ProducerZoneKind
+		master      = powerdns.ZoneKind(MASTER_KIND_ZONE)
+	)
+	ctx := context.Background()
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+	_, _ = PDNSClient.Zones.Add(ctx, &powerdns.Zone{Name: ptr.To("catalog.org."), Kind: &producer, SOAEditAPI: &soaEditApi, Catalog: ptr.To("")})
+	_, _ = PDNSClient.Zones.Add(ctx, &powerdns.Zone{Name: ptr.To("other.org."), Kind: &master, SOAEditAPI: &soaEditApi, Catalog: ptr.To("")})
+
+	scheme := runtime.NewScheme()
+	_ = dnsv1alpha2.AddToScheme(scheme)
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(&dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: "pending.org"}}).
+		WithIndex(&dnsv1alpha2.Zone{}, "Zone.Entry.Name", func(o client.Object) []string { return []string{o.GetName()} }).
+		WithIndex(&dnsv1alpha2.ClusterZone{}, "ClusterZone.Entry.Name", func(o client.Object) []string { return []string{o.GetName()} }).
+		Build()
+
+	var testCases = []struct {
+		description     string
+		catalog         *string
+		expectedStatus  metav1.ConditionStatus
+		expectedCatalog string
+	}{
+		{"Producer catalog", ptr.To("catalog.org"), metav1.ConditionTrue, "catalog.org."},
+		{"Missing catalog", ptr.To("missing.org."), metav1.ConditionFalse, "catalog.org."},
+		{"Managed catalog not yet created", ptr.To("pending.org."), metav1.ConditionFalse, "catalog.org."},
+		{"Not a catalog zone", ptr.To("other.org."), metav1.ConditionFalse, "catalog.org."},
+		{"Catalog removed", nil, "", ""},
+	}
+
+	zone := &dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			zone.Spec = dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers, SOAEditAPI: &soaEditApi, Catalog: tc.catalog}
+			if err := catalogMembershipReconcile(ctx, zone, cl, PDNSClient, log.FromContext(ctx)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var status metav1.ConditionStatus
+			if condition := meta.FindStatusCondition(zone.Status.Conditions, dnsv1alpha2.CATALOG_MEMBER_CONDITION); condition != nil {
+				status = condition.Status
+			}
+			if status != tc.expectedStatus {
+				t.Errorf("got %v, want %v", status, tc.expectedStatus)
+			}
+			if result := getMockedCatalog(name); result != tc.expectedCatalog {
+				t.Errorf("got %v, want %v", result, tc.expectedCatalog)
+			}
+		})
+	}
+}
+
+func TestCatalogMembersReconcile(t *testing.T) {
+	var (
+		catalog     = "catalog.org"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+	)
+	ctx := context.Background()
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+	_, _ = PDNSClient.Zones.Add(ctx, &powerdns.Zone{Name: ptr.To(catalog), Kind: ptr.To(powerdns.ProducerZoneKind), Nameservers: nameservers, SOAEditAPI: ptr.To("DEFAULT"), Catalog: ptr.To("")})
+
+	member := func(name, namespace, catalog string) client.Object {
+		spec := dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers, Catalog: ptr.To(catalog)}
+		if namespace == "" {
+			return &dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+		}
+		return &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Spec: spec}
+	}
+	indexCatalog := func(o client.Object) []string { return []string{getCatalogIndexKey(o.(dnsv1alpha2.GenericZone))} }
+	scheme := runtime.NewScheme()
+	_ = dnsv1alpha2.AddToScheme(scheme)
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(member("a.org", "example", "catalog.org."), member("b.org", "", "Catalog.org"), member("c.org", "example", "other.org")).
+		WithIndex(&dnsv1alpha2.Zone{}, "Zone.Spec.Catalog", indexCatalog).
+		WithIndex(&dnsv1alpha2.ClusterZone{}, "ClusterZone.Spec.Catalog", indexCatalog).
+		Build()
+
+	zone := &dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: catalog}, Spec: dnsv1alpha2.ZoneSpec{Kind: string(powerdns.ProducerZoneKind), Nameservers: nameservers}}
+	if err := catalogMembersReconcile(ctx, zone, cl, PDNSClient, log.FromContext(ctx)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"a.org.", "b.org."}
+	if !cmp.Equal(zone.Status.CatalogMembers, expected) {
+		t.Errorf("got %v, want %v", zone.Status.CatalogMembers, expected)
+	}
+	for _, m := range expected {
+		if result := getMockedRecordsForType(getCatalogMemberName(catalog, m), string(powerdns.RRTypePTR)); !cmp.Equal(result, []string{m}) {
+			t.Errorf("got %v, want %v", result, []string{m})
+		}
+	}
+
+	// Deletion of a member
+	if err := cl.Delete(ctx, member("a.org", "example", "catalog.org.")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := catalogMembersReconcile(ctx, zone, cl, PDNSClient, log.FromContext(ctx)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = []string{"b.org."}
+	if !cmp.Equal(zone.Status.CatalogMembers, expected) {
+		t.Errorf("got %v, want %v", zone.Status.CatalogMembers, expected)
+	}
+	if result := getMockedRecordsForType(getCatalogMemberName(catalog, "a.org."), string(powerdns.RRTypePTR)); len(result) > 0 {
+		t.Errorf("got %v, want no record", result)
+	}
+}
+
+func TestRectifyReconcile(t *testing.T) {
+	var (
+		name        = "example.org"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+	)
+	ctx := context.Background()
+
+	var testCases = []struct {
+		description    string
+		annotations    map[string]string
+		autoRectify    *bool
+		signingChanged bool
+		expected       int
+	}{
+		{"Nothing requested", nil, ptr.To(true), false, 0},
+		{"Signing changed without auto-rectify", nil, nil, true, 0},
+		{"Signing changed with auto-rectify", nil, ptr.To(true), true, 1},
+		{"Rectify annotation", map[string]string{RECTIFY_ANNOTATION: ""}, ptr.To(false), false, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			// Mock initialization
+			teardownTestCase := setupTestCase()
+			defer teardownTestCase()
+
+			zone := &dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: tc.annotations}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, Nameservers: nameservers, AutoRectify: tc.autoRectify}}
+			scheme := runtime.NewScheme()
+			_ = dnsv1alpha2.AddToScheme(scheme)
+			cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(zone.DeepCopy()).Build()
+
+			initialRectifies := getRectifiesCount(name)
+			if err := rectifyReconcile(ctx, zone, cl, tc.signingChanged, PDNSClient, log.FromContext(ctx)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := getRectifiesCount(name) - initialRectifies; got != tc.expected {
+				t.Errorf("got %v, want %v", got, tc.expected)
+			}
+			condition := meta.FindStatusCondition(zone.Status.Conditions, dnsv1alpha2.RECTIFIED_CONDITION)
+			if (condition != nil) != (tc.expected > 0) {
+				t.Errorf("got %v, want a %s condition: %v", condition, dnsv1alpha2.RECTIFIED_CONDITION, tc.expected > 0)
+			}
+			var current dnsv1alpha2.ClusterZone
+			if err := cl.Get(ctx, client.ObjectKey{Name: name}, &current); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, found := current.GetAnnotations()[RECTIFY_ANNOTATION]; found {
+				t.Errorf("the %s annotation should have been removed", RECTIFY_ANNOTATION)
+			}
+		})
+	}
+}
+
+func TestAdoptReconcile(t *testing.T) {
+	var (
+		name        = "example.org"
+		namespace   = "example"
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+	)
+	ctx := context.Background()
+
+	var testCases = []struct {
+		description string
+		adopt       *bool
+		preexisting bool
+		expected    []string
+	}{
+		{"Created zone", ptr.To(true), false, nil},
+		{"Existing zone without adopt", nil, true, nil},
+		{"Existing zone adopted", ptr.To(true), true, []string{"Zone/example/example.org"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			// Mock initialization
+			teardownTestCase := setupTestCase()
+			defer teardownTestCase()
+
+			zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, Nameservers: nameservers, Adopt: tc.adopt}}
+			if err := adoptReconcile(ctx, zone, tc.preexisting, PDNSClient, log.FromContext(ctx)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got, _ := readFromMetadataMap(name, OWNER_METADATA_KIND); !cmp.Equal(got, tc.expected) {
+				t.Errorf("got %v, want %v", got, tc.expected)
+			}
+			condition := meta.FindStatusCondition(zone.Status.Conditions, dnsv1alpha2.ADOPTED_CONDITION)
+			if (condition != nil) != (tc.expected != nil) {
+				t.Errorf("got %v, want a %s condition: %v", condition, dnsv1alpha2.ADOPTED_CONDITION, tc.expected != nil)
+			}
+		})
+	}
+}
+
+func TestResyncResult(t *testing.T) {
+	var testCases = []struct {
+		description     string
+		result          ctrl.Result
+		err             error
+		isDeleted       bool
+		specInterval    *metav1.Duration
+		defaultInterval time.Duration
+		expected        ctrl.Result
+	}{
+		{"Resync disabled", ctrl.Result{}, nil, false, nil, 0, ctrl.Result{}},
+		{"Default interval", ctrl.Result{}, nil, false, nil, 10 * time.Minute, ctrl.Result{RequeueAfter: 10 * time.Minute}},
+		{"Resource interval", ctrl.Result{}, nil, false, &metav1.Duration{Duration: time.Minute}, 10 * time.Minute, ctrl.Result{RequeueAfter: time.Minute}},
+		{"Resync disabled on resource", ctrl.Result{}, nil, false, &metav1.Duration{}, 10 * time.Minute, ctrl.Result{}},
+		{"Failed reconciliation", ctrl.Result{}, errors.New("Internal Server Error"), false, nil, 10 * time.Minute, ctrl.Result{}},
+		{"Deleted resource", ctrl.Result{}, nil, true, nil, 10 * time.Minute, ctrl.Result{}},
+		{"Pending requeue kept", ctrl.Result{RequeueAfter: 2 * time.Second}, nil, false, nil, 10 * time.Minute, ctrl.Result{RequeueAfter: 2 * time.Second}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := resyncResult(tc.result, tc.err, tc.isDeleted, getSyncInterval(tc.specInterval, tc.defaultInterval))
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestGetLastSyncTime(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	var testCases = []struct {
+		description string
+		previous    *metav1.Time
+		expected    *metav1.Time
+	}{
+		{"First sync", nil, &metav1.Time{Time: now}},
+		{"Recent sync kept", &metav1.Time{Time: now.Add(-time.Second)}, &metav1.Time{Time: now.Add(-time.Second)}},
+		{"Old sync refreshed", &metav1.Time{Time: now.Add(-time.Minute)}, &metav1.Time{Time: now}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := getLastSyncTime(tc.previous, now)
+			if !result.Equal(tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRestoreOutOfBandDeletedRrset(t *testing.T) {
+	var (
+		zoneName    = "example.org"
+		namespace   = "example"
+		soaEditApi  = "DEFAULT"
+		catalog     = "catalog.org."
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+		rrsetFqdn   = "restored.example.org."
+		records     = []string{"1.1.1.4"}
+	)
+	ctx := context.Background()
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers, Catalog: &catalog, SOAEditAPI: &soaEditApi}}
+	rrset := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: rrsetFqdn, Namespace: namespace}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: "restored", TTL: 300, Records: records}}
+	if _, err := createOrUpdateRrsetExternalResources(ctx, zone, rrset, nil, PDNSClient); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Out-of-band deletion in PowerDNS
+	if err := PDNSClient.Records.Delete(ctx, zoneName, rrsetFqdn, powerdns.RRTypeA); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	changed, err := createOrUpdateRrsetExternalResources(ctx, zone, rrset, nil, PDNSClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !changed {
+		t.Errorf("got %v, want %v", changed, true)
+	}
+	if result := getMockedRecordsForType(rrsetFqdn, "A"); !cmp.Equal(result, records) {
+		t.Errorf("got %v, want %v", result, records)
+	}
+}
+
+func TestPausedRrsetReconcile(t *testing.T) {
+	var (
+		zoneName  = "example.org"
+		namespace = "example"
+		rrsetFqdn = "paused.example.org."
+		records   = []string{"1.1.1.5"}
+		drifted   = []string{"9.9.9.9"}
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE}}
+	rrset := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "paused", Namespace: namespace, Finalizers: []string{RESOURCES_FINALIZER_NAME}}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: "paused", TTL: 300, Records: records}}
+	if _, err := createOrUpdateRrsetExternalResources(ctx, zone, rrset, nil, PDNSClient); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Out-of-band change in PowerDNS
+	if err := PDNSClient.Records.Change(ctx, zoneName, rrsetFqdn, powerdns.RRTypeA, 300, drifted); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var testCases = []struct {
+		description string
+		rrsetPaused bool
+		zonePaused  bool
+	}{
+		{"Paused RRset", true, false},
+		{"RRset of a paused zone", false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			pausedRRset := rrset.DeepCopy()
+			pausedZone := zone.DeepCopy()
+			if tc.rrsetPaused {
+				pausedRRset.Annotations = map[string]string{PAUSED_ANNOTATION: "true"}
+			}
+			if tc.zonePaused {
+				pausedZone.Annotations = map[string]string{PAUSED_ANNOTATION: "true"}
+			}
+			if _, _, err := rrsetReconcile(ctx, pausedRRset, pausedZone, false, false, nil, nil, false, nil, RetryBackoff{}, 0, nil, nil, PDNSClient, log); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// The drift is not corrected and the status is unchanged, except the Paused condition
+			if result := getMockedRecordsForType(rrsetFqdn, "A"); !cmp.Equal(result, drifted) {
+				t.Errorf("got %v, want %v", result, drifted)
+			}
+			if !meta.IsStatusConditionTrue(pausedRRset.Status.Conditions, dnsv1alpha2.PAUSED_CONDITION) {
+				t.Errorf("got %v, want a %s condition", pausedRRset.Status.Conditions, dnsv1alpha2.PAUSED_CONDITION)
+			}
+			if pausedRRset.Status.SyncStatus != nil || pausedRRset.Status.LastSyncTime != nil {
+				t.Errorf("got %v, want an unchanged status", pausedRRset.Status)
+			}
+		})
+	}
+}
+
+// flakyRecordsClient fails the given number of changes, as a PowerDNS server error
+type flakyRecordsClient struct {
+	pdnsRecordsClienter
+	failures int
+}
+
+func (c *flakyRecordsClient) Change(ctx context.Context, domain string, name string, recordType powerdns.RRType, ttl uint32, content []string, options ...func(*powerdns.RRset)) error {
+	if c.failures > 0 {
+		c.failures--
+		return &powerdns.Error{StatusCode: 500, Status: "500 Internal Server Error", Message: "Internal Server Error"}
+	}
+	return c.pdnsRecordsClienter.Change(ctx, domain, name, recordType, ttl, content, options...)
+}
+
+func TestAcmeChallengeChurnReconcile(t *testing.T) {
+	var (
+		zoneName  = "example.org"
+		namespace = "example"
+		rrsetFqdn = "_acme-challenge.example.org."
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace, UID: "zone-uid"}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE}}
+	scheme := runtime.NewScheme()
+	_ = dnsv1alpha2.AddToScheme(scheme)
+	indexEntries := func(o client.Object) []string {
+		gr := o.(dnsv1alpha2.GenericRRset)
+		if gr.GetStatus().SyncStatus == nil || *gr.GetStatus().SyncStatus == dnsv1alpha2.SUCCEEDED_STATUS {
+			return getRRsetEntries(gr)
+		}
+		return []string{""}
+	}
+	indexFQDN := func(o client.Object) []string {
+		return []string{getRRsetName(o.(dnsv1alpha2.GenericRRset))}
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(zone).
+		WithIndex(&dnsv1alpha2.RRset{}, "RRset.Entry.Name", indexEntries).
+		WithIndex(&dnsv1alpha2.ClusterRRset{}, "ClusterRRset.Entry.Name", indexEntries).
+		WithIndex(&dnsv1alpha2.ClusterRRset{}, "ClusterRRset.Entry.Override", func(o client.Object) []string { return []string{""} }).
+		WithIndex(&dnsv1alpha2.RRset{}, "RRset.Entry.FQDN", indexFQDN).
+		WithIndex(&dnsv1alpha2.ClusterRRset{}, "ClusterRRset.Entry.FQDN", indexFQDN).
+		Build()
+	records := &flakyRecordsClient{pdnsRecordsClienter: PDNSClient.Records}
+	pdnsClient := PDNSClient
+	pdnsClient.Records = records
+	retryBackoff := RetryBackoff{Base: 30 * time.Second, Max: 10 * time.Minute}
+
+	newChallenge := func(name, token string) *dnsv1alpha2.RRset {
+		rrset := &dnsv1alpha2.RRset{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: types.UID(name)},
+			Spec:       dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "TXT", Name: "_acme-challenge", TTL: 60, Records: []string{strconv.Quote(token)}},
+		}
+		if err := cl.Create(ctx, rrset); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return rrset
+	}
+	// reconcile mimics the RRset controller, the status is persisted unless the RRset is gone
+	reconcile := func(rrset *dnsv1alpha2.RRset) (ctrl.Result, error) {
+		if err := cl.Get(ctx, client.ObjectKeyFromObject(rrset), rrset); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		isDeleted := !rrset.DeletionTimestamp.IsZero()
+		lastUpdateTime := rrset.Status.LastUpdateTime
+		if lastUpdateTime == nil {
+			lastUpdateTime = &metav1.Time{Time: time.Now().UTC()}
+		}
+		result, _, err := rrsetReconcile(ctx, rrset, zone, false, isDeleted, lastUpdateTime, nil, false, nil, retryBackoff, 0, scheme, cl, pdnsClient, log)
+		if !isDeleted {
+			if updateErr := cl.Update(ctx, rrset); updateErr != nil {
+				t.Fatalf("unexpected error: %v", updateErr)
+			}
+		}
+		return result, err
+	}
+	isGone := func(rrset *dnsv1alpha2.RRset) bool {
+		return apierrors.IsNotFound(cl.Get(ctx, client.ObjectKeyFromObject(rrset), &dnsv1alpha2.RRset{}))
+	}
+
+	// A challenge failing to synchronize waits for the retry backoff...
+	challenge1 := newChallenge("challenge-1", "token1")
+	records.failures = 1
+	if _, err := reconcile(challenge1); err == nil {
+		t.Fatalf("got no error, want a synchronization failure")
+	}
+	if result, err := reconcile(challenge1); err != nil || result.RequeueAfter == 0 {
+		t.Errorf("got %v (%v), want a requeue after the retry backoff", result, err)
+	}
+	// ...but is deleted right away, even if its records were created despite the failure
+	if err := PDNSClient.Records.Change(ctx, zoneName, rrsetFqdn, powerdns.RRTypeTXT, 60, []string{`"token1"`}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := cl.Delete(ctx, challenge1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := reconcile(challenge1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := getMockedRecordsForType(rrsetFqdn, "TXT"); len(result) != 0 || !isGone(challenge1) {
+		t.Errorf("got records %v, want the challenge and its records deleted", result)
+	}
+
+	// A challenge recreated while the previous one is still being deleted is not a duplicate
+	challenge2 := newChallenge("challenge-2", "token2")
+	if _, err := reconcile(challenge2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := cl.Delete(ctx, challenge2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	challenge3 := newChallenge("challenge-3", "token3")
+	if _, err := reconcile(challenge3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ptr.Deref(challenge3.Status.SyncStatus, "") != dnsv1alpha2.SUCCEEDED_STATUS {
+		t.Errorf("got %v, want %s", challenge3.Status.Conditions, dnsv1alpha2.SUCCEEDED_STATUS)
+	}
+	// The deletion of the previous challenge keeps the records of the new one
+	if _, err := reconcile(challenge2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := getMockedRecordsForType(rrsetFqdn, "TXT"); !cmp.Equal(result, []string{`"token3"`}) || !isGone(challenge2) {
+		t.Errorf("got records %v, want %v", result, []string{`"token3"`})
+	}
+
+	// The last challenge deletes the records
+	if err := cl.Delete(ctx, challenge3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := reconcile(challenge3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := getMockedRecordsForType(rrsetFqdn, "TXT"); len(result) != 0 || !isGone(challenge3) {
+		t.Errorf("got records %v, want the challenge and its records deleted", result)
+	}
+}
+
+func TestRecordPausedEvent(t *testing.T) {
+	paused := metav1.Condition{Type: dnsv1alpha2.PAUSED_CONDITION, Status: metav1.ConditionTrue, Reason: dnsv1alpha2.PAUSED_REASON, Message: dnsv1alpha2.PAUSED_MESSAGE}
+
+	var testCases = []struct {
+		description string
+		previous    []metav1.Condition
+		current     []metav1.Condition
+		expected    []string
+	}{
+		{"Paused", nil, []metav1.Condition{paused}, []string{"Normal " + dnsv1alpha2.PAUSED_REASON + " " + dnsv1alpha2.PAUSED_MESSAGE}},
+		{"Still paused", []metav1.Condition{paused}, []metav1.Condition{paused}, nil},
+		{"Resumed", []metav1.Condition{paused}, nil, []string{"Normal " + dnsv1alpha2.RESUMED_REASON + " " + dnsv1alpha2.RESUMED_MESSAGE}},
+		{"Not paused", nil, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			recorder := events.NewFakeRecorder(10)
+			recordPausedEvent(recorder, &dnsv1alpha2.RRset{}, tc.previous, tc.current)
+			close(recorder.Events)
+			var result []string
+			for e := range recorder.Events {
+				result = append(result, e)
+			}
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+// unreachableRecordsClient fails all the deletions, as a PowerDNS server which cannot be reached
+type unreachableRecordsClient struct {
+	pdnsRecordsClienter
+}
+
+func (c unreachableRecordsClient) Delete(ctx context.Context, domain string, name string, recordType powerdns.RRType) error {
+	return errors.New("dial tcp: connect: connection refused")
+}
+
+func TestFinalizerTimeoutRrsetReconcile(t *testing.T) {
+	var (
+		zoneName  = "example.org"
+		namespace = "example"
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE}}
+	pdnsClient := PDNSClient
+	pdnsClient.Records = unreachableRecordsClient{pdnsRecordsClienter: PDNSClient.Records}
+
+	var testCases = []struct {
+		description       string
+		finalizerTimeout  time.Duration
+		deletedSince      time.Duration
+		expectedErr       bool
+		expectedAbandoned bool
+	}{
+		{"Finalizer timeout disabled", 0, 24 * time.Hour, true, false},
+		{"Finalizer timeout not reached", time.Hour, 10 * time.Minute, true, false},
+		{"Finalizer timeout exceeded", time.Hour, 2 * time.Hour, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			rrset := &dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: namespace, Finalizers: []string{RESOURCES_FINALIZER_NAME}, DeletionTimestamp: &metav1.Time{Time: time.Now().Add(-tc.deletedSince)}},
+				Spec:       dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: "test", Records: []string{"192.0.2.1"}},
+			}
+			scheme := runtime.NewScheme()
+			_ = dnsv1alpha2.AddToScheme(scheme)
+			indexEntries := func(o client.Object) []string { return getRRsetEntries(o.(dnsv1alpha2.GenericRRset)) }
+			cl := fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(rrset).
+				WithIndex(&dnsv1alpha2.RRset{}, "RRset.Entry.Name", indexEntries).
+				WithIndex(&dnsv1alpha2.ClusterRRset{}, "ClusterRRset.Entry.Name", indexEntries).
+				Build()
+
+			_, _, err := rrsetReconcile(ctx, rrset, zone, false, true, nil, nil, false, nil, RetryBackoff{}, tc.finalizerTimeout, scheme, cl, pdnsClient, log)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("got error %v, want error %v", err, tc.expectedErr)
+			}
+			abandoned := meta.IsStatusConditionTrue(rrset.Status.Conditions, dnsv1alpha2.CLEANUP_ABANDONED_CONDITION)
+			if abandoned != tc.expectedAbandoned || controllerutil.ContainsFinalizer(rrset, RESOURCES_FINALIZER_NAME) == tc.expectedAbandoned {
+				t.Errorf("got finalizers %v and conditions %v, want finalizer removed %v", rrset.Finalizers, rrset.Status.Conditions, tc.expectedAbandoned)
+			}
+		})
+	}
+}
+
+func TestRecordCleanupAbandonedEvent(t *testing.T) {
+	abandoned := metav1.Condition{Type: dnsv1alpha2.CLEANUP_ABANDONED_CONDITION, Status: metav1.ConditionTrue, Reason: dnsv1alpha2.FINALIZER_TIMEOUT_REASON, Message: dnsv1alpha2.FINALIZER_TIMEOUT_MESSAGE + "connection refused"}
+
+	var testCases = []struct {
+		description string
+		previous    []metav1.Condition
+		current     []metav1.Condition
+		expected    []string
+	}{
+		{"Cleanup abandoned", nil, []metav1.Condition{abandoned}, []string{"Warning " + dnsv1alpha2.FINALIZER_TIMEOUT_REASON + " " + abandoned.Message}},
+		{"Already reported", []metav1.Condition{abandoned}, []metav1.Condition{abandoned}, nil},
+		{"Cleanup completed", nil, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			recorder := events.NewFakeRecorder(10)
+			recordCleanupAbandonedEvent(recorder, &dnsv1alpha2.Zone{}, tc.previous, tc.current)
+			close(recorder.Events)
+			var result []string
+			for e := range recorder.Events {
+				result = append(result, e)
+			}
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestZoneRefChangeReconcile(t *testing.T) {
+	var (
+		namespace   = "example"
+		soaEditApi  = "DEFAULT"
+		catalog     = "catalog.org."
+		nameservers = []string{"ns1.example.org", "ns2.example.org"}
+		records     = []string{"1.1.1.5"}
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	previous := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: MASTER_KIND_ZONE, Nameservers: nameservers, Catalog: &catalog, SOAEditAPI: &soaEditApi}}
+	rrset := &dnsv1alpha2.RRset{
+		ObjectMeta: metav1.ObjectMeta{Name: "moved", Namespace: namespace, OwnerReferences: []metav1.OwnerReference{{Kind: "Zone", Name: "example.org", Controller: ptr.To(true)}}},
+		Spec:       dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"}, Type: "A", Name: "moved", TTL: 300, Records: records},
+		Status:     dnsv1alpha2.RRsetStatus{DnsEntryName: ptr.To("moved.example.org."), ZoneRef: &dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"}},
+	}
+	if _, err := createOrUpdateRrsetExternalResources(ctx, previous, rrset, nil, PDNSClient); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Unchanged zoneRef
+	if err := zoneRefChangeReconcile(ctx, rrset, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := getMockedRecordsForType("moved.example.org.", "A"); !cmp.Equal(result, records) {
+		t.Errorf("got %v, want %v", result, records)
+	}
+	if len(rrset.OwnerReferences) != 1 {
+		t.Errorf("got %v, want %v", len(rrset.OwnerReferences), 1)
+	}
+
+	// The RRset is moved to another zone
+	rrset.Spec.ZoneRef = dnsv1alpha2.ZoneRef{Name: "example.com", Kind: "ClusterZone"}
+	if err := zoneRefChangeReconcile(ctx, rrset, PDNSClient, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := getMockedRecordsForType("moved.example.org.", "A"); len(result) != 0 {
+		t.Errorf("got %v, want no records", result)
+	}
+	if len(rrset.OwnerReferences) != 0 {
+		t.Errorf("got %v, want %v", rrset.OwnerReferences, nil)
+	}
+	if rrset.Status.ZoneRef != nil {
+		t.Errorf("got %v, want %v", rrset.Status.ZoneRef, nil)
+	}
+}
+
+// soaRecordsClient stores the RRsets of a zone, served by soaZonesClient
+type soaRecordsClient struct {
+	pdnsRecordsClienter
+	rrsets []powerdns.RRset
+}
+
+func (c *soaRecordsClient) Change(ctx context.Context, domain string, name string, recordType powerdns.RRType, ttl uint32, content []string, options ...func(*powerdns.RRset)) error {
+	rrset := powerdns.RRset{Name: ptr.To(name), Type: ptr.To(recordType), TTL: ptr.To(ttl)}
+	for _, r := range content {
+		rrset.Records = append(rrset.Records, powerdns.Record{Content: ptr.To(r)})
+	}
+	c.rrsets = slices.DeleteFunc(c.rrsets, func(rr powerdns.RRset) bool { return *rr.Name == name && *rr.Type == recordType })
+	c.rrsets = append(c.rrsets, rrset)
+	return nil
+}
+
+// soaZonesClient serves a zone with the RRsets of its records client
+type soaZonesClient struct {
+	pdnsZonesClienter
+	records *soaRecordsClient
+}
+
+func (c soaZonesClient) Get(ctx context.Context, domain string) (*powerdns.Zone, error) {
+	return &powerdns.Zone{Name: ptr.To(makeCanonical(domain)), RRsets: c.records.rrsets}, nil
+}
+
+func TestSerialExternalResources(t *testing.T) {
+	zoneName := "serial.org"
+	ctx := context.Background()
+	records := &soaRecordsClient{}
+	_ = records.Change(ctx, zoneName, makeCanonical(zoneName), powerdns.RRTypeSOA, 3600, []string{"ns1.serial.org. hostmaster.serial.org. 2025010101 10800 3600 604800 3600"})
+	pdnsClient := PdnsClienter{Records: records, Zones: soaZonesClient{records: records}}
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: "example"}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE}}
+
+	var testCases = []struct {
+		description           string
+		strategy              *string
+		records               []string
+		expectedSerial        string
+		expectedAppliedSerial *uint32
+	}{
+		{"Serial managed by PowerDNS", nil, nil, "2025010101", nil},
+		{"Serial applied", ptr.To(dnsv1alpha2.SERIAL_STRATEGY_INCREMENT), nil, "2025010102", ptr.To(uint32(2025010102))},
+		{"Records unchanged", ptr.To(dnsv1alpha2.SERIAL_STRATEGY_INCREMENT), nil, "2025010102", ptr.To(uint32(2025010102))},
+		{"Records changed", ptr.To(dnsv1alpha2.SERIAL_STRATEGY_INCREMENT), []string{"192.0.2.1"}, "2025010103", ptr.To(uint32(2025010103))},
+		{"Default strategy", ptr.To(dnsv1alpha2.SERIAL_STRATEGY_DEFAULT), []string{"192.0.2.2"}, "2025010103", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if tc.records != nil {
+				_ = records.Change(ctx, zoneName, "www.serial.org.", powerdns.RRTypeA, 300, tc.records)
+			}
+			zone.Spec.SerialStrategy = tc.strategy
+			if err := serialExternalResourcesReconcile(ctx, zone, pdnsClient, log.FromContext(ctx)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			soa := filterRRset(records.rrsets, zoneName, powerdns.RRTypeSOA)
+			if serial := strings.Fields(*soa.Records[0].Content)[2]; serial != tc.expectedSerial {
+				t.Errorf("got %v, want %v", serial, tc.expectedSerial)
+			}
+			if !cmp.Equal(zone.Status.AppliedSerial, tc.expectedAppliedSerial) {
+				t.Errorf("got %v, want %v", ptr.Deref(zone.Status.AppliedSerial, 0), ptr.Deref(tc.expectedAppliedSerial, 0))
+			}
+		})
+	}
+}
+
+func TestFailedStatusReconcileNotSynced(t *testing.T) {
+	var (
+		zoneName  = "example.org"
+		namespace = "example"
+	)
+	ctx := context.Background()
+	log := log.FromContext(ctx)
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: namespace, UID: "zone-uid"}, Spec: dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE}}
+	lastUpdateTime := &metav1.Time{Time: time.Now().UTC()}
+	failed := &dnsv1alpha2.RRset{
+		ObjectMeta: metav1.ObjectMeta{Name: "failed", Namespace: namespace, UID: "failed-uid", Finalizers: []string{RESOURCES_FINALIZER_NAME}},
+		Spec:       dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: "failed", Records: []string{"192.0.2.1"}},
+	}
+	failed.SetSynchronizationFailed(lastUpdateTime, errors.New("connection refused"))
+	available := &dnsv1alpha2.RRset{
+		ObjectMeta: metav1.ObjectMeta{Name: "available", Namespace: namespace, UID: "available-uid", Finalizers: []string{RESOURCES_FINALIZER_NAME}},
+		Spec:       dnsv1alpha2.RRsetSpec{ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"}, Type: "A", Name: "available", Records: []string{"192.0.2.2"}},
+	}
+	scheme := runtime.NewScheme()
+	_ = dnsv1alpha2.AddToScheme(scheme)
+	indexEntries := func(o client.Object) []string {
+		return getRRsetEntries(o.(dnsv1alpha2.GenericRRset))
+	}
+	indexFQDN := func(o client.Object) []string {
+		return []string{getRRsetName(o.(dnsv1alpha2.GenericRRset))}
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(zone, failed, available).
+		WithIndex(&dnsv1alpha2.RRset{}, "RRset.Entry.Name", indexEntries).
+		WithIndex(&dnsv1alpha2.ClusterRRset{}, "ClusterRRset.Entry.Name", indexEntries).
+		WithIndex(&dnsv1alpha2.ClusterRRset{}, "ClusterRRset.Entry.Override", func(o client.Object) []string { return []string{""} }).
+		WithIndex(&dnsv1alpha2.RRset{}, "RRset.Entry.FQDN", indexFQDN).
+		WithIndex(&dnsv1alpha2.ClusterRRset{}, "ClusterRRset.Entry.FQDN", indexFQDN).
+		Build()
+	retryBackoff := RetryBackoff{Base: 30 * time.Second, Max: 10 * time.Minute}
+	controllerName := "failed-status-test"
+
+	var testCases = []struct {
+		description    string
+		rrset          *dnsv1alpha2.RRset
+		expectedSynced bool
+	}{
+		{"Failed RRset within its retry backoff", failed, false},
+		{"Synchronized RRset", available, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			lastSuccessfulReconcileMetric.WithLabelValues(controllerName).Set(0)
+			result, synced, err := rrsetReconcile(ctx, tc.rrset, zone, false, false, lastUpdateTime, nil, false, nil, retryBackoff, 0, scheme, cl, PDNSClient, log)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if synced != tc.expectedSynced {
+				t.Errorf("got %v, want %v", synced, tc.expectedSynced)
+			}
+			_, _ = observeReconcile(controllerName, synced, result, err)
+			if got := getLastSuccessfulReconcileMetric(controllerName) > 0; got != tc.expectedSynced {
+				t.Errorf("got timestamp advanced %v, want %v", got, tc.expectedSynced)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+// CryptokeyReconciler reconciles a Cryptokey object
+type CryptokeyReconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	PDNSClient PdnsClienter
+	// RateLimiter defines the requeue delays of the failed reconciles, the controller-runtime default when zero
+	RateLimiter RateLimiter
+}
+
+// +kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=cryptokeys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=cryptokeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=cryptokeys/finalizers,verbs=update
+
+func (r *CryptokeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("Reconcile Cryptokey", "Cryptokey.Name", req.Name)
+
+	// Get Cryptokey
+	cryptokey := &dnsv1alpha2.Cryptokey{}
+	err := r.Get(ctx, req.NamespacedName, cryptokey)
+	if err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Initialize variable to represent Cryptokey situation
+	isModified := cryptokey.Status.ObservedGeneration != nil && *cryptokey.Status.ObservedGeneration != cryptokey.GetGeneration()
+	isDeleted := !cryptokey.DeletionTimestamp.IsZero()
+	log.V(1).Info("Cryptokey situation", "isModified", isModified, "isDeleted", isDeleted)
+
+	original := cryptokey.DeepCopy()
+	// Ensure we update the status in case of early return
+	defer func() {
+		if err := r.Status().Patch(ctx, cryptokey, client.MergeFrom(original)); err != nil {
+			log.Error(err, "unable to patch Cryptokey status")
+		}
+	}()
+
+	// When updating a Cryptokey, if 'Status' is not changed, 'LastTransitionTime' will not be updated
+	// So we delete condition to force new 'LastTransitionTime'
+	if !isDeleted && isModified {
+		meta.RemoveStatusCondition(&cryptokey.Status.Conditions, "Available")
+	}
+
+	// Zone
+	var zone dnsv1alpha2.GenericZone
+	switch cryptokey.Spec.ZoneRef.Kind {
+	case "Zone":
+		zone = &dnsv1alpha2.Zone{}
+	case "ClusterZone":
+		zone = &dnsv1alpha2.ClusterZone{}
+	}
+	log.V(1).Info("Getting associated Zone", "Zone.Name", cryptokey.Spec.ZoneRef.Name, "Zone.Kind", cryptokey.Spec.ZoneRef.Kind)
+	err = r.Get(ctx, client.ObjectKey{Namespace: cryptokey.Namespace, Name: cryptokey.Spec.ZoneRef.Name}, zone)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Zone not found, its keys have been deleted with it: remove finalizer and requeue
+			log.V(1).Info("Zone not found", "Zone.Name", cryptokey.Spec.ZoneRef.Name)
+			if controllerutil.ContainsFinalizer(cryptokey, RESOURCES_FINALIZER_NAME) {
+				controllerutil.RemoveFinalizer(cryptokey, RESOURCES_FINALIZER_NAME)
+				if err := r.Update(ctx, cryptokey); err != nil {
+					log.Error(err, "Failed to remove finalizer")
+					return ctrl.Result{}, err
+				}
+			}
+
+			// If Cryptokey is under deletion, no need to update its status
+			if isDeleted {
+				return ctrl.Result{}, nil
+			}
+			cryptokey.SetMissingZone(err)
+			return ctrl.Result{RequeueAfter: 2 * time.Second}, nil
+		}
+		log.Error(err, "Failed to get zone")
+		cryptokey.SetZoneNotAvailable(cryptokey.Spec.ZoneRef.Name)
+		return ctrl.Result{}, err
+	}
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if isDeleted {
+		if controllerutil.ContainsFinalizer(cryptokey, RESOURCES_FINALIZER_NAME) {
+			// our finalizer is present, so lets handle any external dependency
+			if err := deleteCryptokeyExternalResources(ctx, zone, cryptokey, r.PDNSClient, log); err != nil {
+				// if fail to delete the external resource, return with error
+				// so that it can be retried
+				return ctrl.Result{}, err
+			}
+			controllerutil.RemoveFinalizer(cryptokey, RESOURCES_FINALIZER_NAME)
+			if err := r.Update(ctx, cryptokey); err != nil {
+				log.Error(err, "Failed to remove finalizer")
+				return ctrl.Result{}, err
+			}
+		}
+		// Stop reconciliation as the item is being deleted
+		return ctrl.Result{}, nil
+	}
+
+	// If a Zone/ClusterZone exists but is in Failed Status
+	if zone.GetStatus().SyncStatus != nil && *zone.GetStatus().SyncStatus == dnsv1alpha2.FAILED_STATUS {
+		log.V(1).Info("Zone is in failed status, setting zone not available")
+		cryptokey.SetZoneNotAvailable(zone.GetName())
+		return ctrl.Result{}, nil
+	}
+
+	// The keys of a presigned zone are managed outside of PowerDNS
+	if isPresignedZone(zone) {
+		log.V(1).Info("Zone is presigned, its keys are not managed")
+		cryptokey.SetSynchronizationFailed(fmt.Errorf("zone %s is presigned, its keys are not managed by PowerDNS", zone.GetName()))
+		return ctrl.Result{}, nil
+	}
+
+	// The object is not being deleted, so if it does not have our finalizer,
+	// then lets add the finalizer and set the Zone as owner of the Cryptokey.
+	if !controllerutil.ContainsFinalizer(cryptokey, RESOURCES_FINALIZER_NAME) {
+		controllerutil.AddFinalizer(cryptokey, RESOURCES_FINALIZER_NAME)
+		if err := ctrl.SetControllerReference(zone, cryptokey, r.Scheme); err != nil {
+			log.Error(err, "Failed to set owner reference")
+			return ctrl.Result{}, err
+		}
+		if err := r.Update(ctx, cryptokey); err != nil {
+			log.Error(err, "Failed to add finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	cryptokeyRes, err := cryptokeyExternalResourcesReconcile(ctx, zone, cryptokey, r.PDNSClient, log)
+	if err != nil {
+		cryptokey.SetSynchronizationFailed(err)
+		return ctrl.Result{}, err
+	}
+	cryptokey.SetAvailable(cryptokeyRes)
+
+	return observeReconcile(CRYPTOKEY_CONTROLLER_NAME, true, ctrl.Result{}, nil)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CryptokeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&dnsv1alpha2.Cryptokey{}).
+		WithOptions(r.RateLimiter.controllerOptions()).
+		Complete(r)
+}
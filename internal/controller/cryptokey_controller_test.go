@@ -0,0 +1,162 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+//nolint:goconst
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+var _ = Describe("Cryptokey Controller", func() {
+
+	const (
+		// Zone
+		zoneName      = "example8.org"
+		zoneNamespace = "example8"
+		zoneKind      = NATIVE_KIND_ZONE
+		zoneNS1       = "ns1.example8.org"
+
+		// Cryptokey
+		resourceName      = "ksk"
+		resourceNamespace = zoneNamespace
+		resourceKeyType   = "ksk"
+
+		timeout  = time.Second * 5
+		interval = time.Millisecond * 250
+	)
+
+	zoneLookupKey := types.NamespacedName{
+		Name:      zoneName,
+		Namespace: zoneNamespace,
+	}
+	cryptokeyLookupKey := types.NamespacedName{
+		Name:      resourceName,
+		Namespace: resourceNamespace,
+	}
+
+	BeforeEach(func() {
+		ctx := context.Background()
+		By("Creating the Zone resource")
+		zone := &dnsv1alpha2.Zone{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      zoneName,
+				Namespace: zoneNamespace,
+			},
+		}
+		_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, zone, func() error {
+			zone.Spec = dnsv1alpha2.ZoneSpec{
+				Kind:        zoneKind,
+				Nameservers: []string{zoneNS1},
+			}
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(func() bool {
+			_, found := readFromZonesMap(makeCanonical(zoneName))
+			return found
+		}, timeout, interval).Should(BeTrue())
+
+		By("Creating the Cryptokey resource")
+		resource := &dnsv1alpha2.Cryptokey{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceName,
+				Namespace: resourceNamespace,
+			},
+		}
+		_, err = controllerutil.CreateOrUpdate(ctx, k8sClient, resource, func() error {
+			resource.Spec = dnsv1alpha2.CryptokeySpec{
+				ZoneRef: dnsv1alpha2.ZoneRef{
+					Name: zoneName,
+					Kind: "Zone",
+				},
+				KeyType: resourceKeyType,
+			}
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		// Confirm that resource is created in the backend
+		Eventually(func() int {
+			return len(readFromCryptokeysMap(makeCanonical(zoneName)))
+		}, timeout, interval).Should(Equal(1))
+	})
+
+	AfterEach(func() {
+		ctx := context.Background()
+		resource := &dnsv1alpha2.Cryptokey{}
+		err := k8sClient.Get(ctx, cryptokeyLookupKey, resource)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Cleaning up the specific resource instance Cryptokey")
+		Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, cryptokeyLookupKey, resource)
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+		// Confirm that resource is deleted in the backend
+		Eventually(func() int {
+			return len(readFromCryptokeysMap(makeCanonical(zoneName)))
+		}, timeout, interval).Should(Equal(0))
+
+		By("Cleaning up the specific resource instance Zone")
+		zone := &dnsv1alpha2.Zone{}
+		Expect(k8sClient.Get(ctx, zoneLookupKey, zone)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, zone)).To(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, zoneLookupKey, zone)
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	Context("When existing resource", func() {
+		It("should expose the DS records", Label("cryptokey-initialization"), func() {
+			ctx := context.Background()
+			resource := &dnsv1alpha2.Cryptokey{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, cryptokeyLookupKey, resource)
+				return err == nil && resource.IsInExpectedStatus(FIRST_GENERATION, dnsv1alpha2.SUCCEEDED_STATUS, metav1.ConditionTrue)
+			}, timeout, interval).Should(BeTrue())
+			Expect(resource.Status.ID).NotTo(BeNil(), "ID should be set")
+			Expect(resource.Status.KeyTag).NotTo(BeNil(), "KeyTag should be set")
+			Expect(resource.Status.DS).NotTo(BeEmpty(), "DS records should be set")
+			Expect(ptr.Deref(resource.Status.Active, false)).To(BeTrue(), "Cryptokey should be active")
+			Expect(resource.GetFinalizers()).To(ContainElement(RESOURCES_FINALIZER_NAME), "Cryptokey should contain the finalizer")
+		})
+	})
+
+	Context("When deactivating the Cryptokey", func() {
+		It("should deactivate the key in PowerDNS", Label("cryptokey-modification"), func() {
+			ctx := context.Background()
+			resource := &dnsv1alpha2.Cryptokey{}
+			Expect(k8sClient.Get(ctx, cryptokeyLookupKey, resource)).To(Succeed())
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, resource, func() error {
+				resource.Spec.Active = ptr.To(false)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() bool {
+				keys := readFromCryptokeysMap(makeCanonical(zoneName))
+				return len(keys) == 1 && !ptr.Deref(keys[0].Active, true)
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+})
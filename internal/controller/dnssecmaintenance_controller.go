@@ -0,0 +1,169 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+// DNSSECMaintenanceReconciler reconciles a DNSSECMaintenance object
+type DNSSECMaintenanceReconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	PDNSClient PdnsClienter
+	// RateLimiter defines the requeue delays of the failed reconciles, the controller-runtime default when zero
+	RateLimiter RateLimiter
+}
+
+// +kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=dnssecmaintenances,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=dnssecmaintenances/status,verbs=get;update;patch
+
+func (r *DNSSECMaintenanceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("Reconcile DNSSECMaintenance", "DNSSECMaintenance.Name", req.Name)
+
+	// Get DNSSECMaintenance
+	maintenance := &dnsv1alpha2.DNSSECMaintenance{}
+	err := r.Get(ctx, req.NamespacedName, maintenance)
+	if err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	// Nothing to clean up in PowerDNS on deletion
+	if !maintenance.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// A change of the spec runs the maintenance again
+	isModified := maintenance.Status.ObservedGeneration != nil && *maintenance.Status.ObservedGeneration != maintenance.GetGeneration()
+	log.V(1).Info("DNSSECMaintenance situation", "isModified", isModified)
+
+	original := maintenance.DeepCopy()
+	// Ensure we update the status in case of early return
+	defer func() {
+		if err := r.Status().Patch(ctx, maintenance, client.MergeFrom(original)); err != nil {
+			log.Error(err, "unable to patch DNSSECMaintenance status")
+		}
+	}()
+
+	if isModified {
+		maintenance.Status.Zones = nil
+		maintenance.Status.CompletionTime = nil
+		meta.RemoveStatusCondition(&maintenance.Status.Conditions, dnsv1alpha2.MAINTENANCE_COMPLETED_CONDITION)
+	}
+	// A completed maintenance is not run again, so that a new reconcile (e.g. on operator restart) does not roll the keys again
+	if maintenance.Status.CompletionTime != nil {
+		return ctrl.Result{}, nil
+	}
+
+	zones, err := getDNSSECMaintenanceZones(ctx, r.Client, maintenance.Spec.Zones)
+	if err != nil {
+		log.Error(err, "Failed to list zones")
+		maintenance.SetSynchronizationFailed(err)
+		return ctrl.Result{}, err
+	}
+	cryptokeyZones, err := getCryptokeyZones(ctx, r.Client)
+	if err != nil {
+		log.Error(err, "Failed to list cryptokeys")
+		maintenance.SetSynchronizationFailed(err)
+		return ctrl.Result{}, err
+	}
+
+	// The zones deleted during the maintenance are not expected anymore
+	maintenance.Status.Zones = slices.DeleteFunc(maintenance.Status.Zones, func(z dnsv1alpha2.DNSSECMaintenanceZoneStatus) bool {
+		return !slices.ContainsFunc(zones, func(gz dnsv1alpha2.GenericZone) bool { return gz.GetObjectMeta().Name == z.Name })
+	})
+
+	var requeueAfter time.Duration
+	for _, gz := range zones {
+		zoneName := gz.GetObjectMeta().Name
+		zoneStatus := dnsv1alpha2.DNSSECMaintenanceZoneStatus{Name: zoneName, Phase: dnsv1alpha2.MAINTENANCE_PENDING_PHASE}
+		if current := maintenance.GetZoneStatus(zoneName); current != nil {
+			zoneStatus = *current
+		}
+		if zoneStatus.IsZoneDone() {
+			continue
+		}
+		// Presigned zones are signed outside of PowerDNS, they can neither be rectified nor rolled over
+		if ptr.Deref(gz.GetStatus().Presigned, false) {
+			zoneStatus.Phase = dnsv1alpha2.MAINTENANCE_SKIPPED_PHASE
+			zoneStatus.Message = ptr.To("Maintenance skipped, the zone is presigned")
+			maintenance.SetZoneStatus(zoneStatus)
+			continue
+		}
+		wait := dnssecMaintenanceZoneReconcile(ctx, maintenance, zoneName, cryptokeyZones[zoneName], &zoneStatus, r.PDNSClient, log.WithValues("Zone.Name", zoneName))
+		maintenance.SetZoneStatus(zoneStatus)
+		if wait > 0 && (requeueAfter == 0 || wait < requeueAfter) {
+			requeueAfter = wait
+		}
+	}
+	maintenance.SetProgress(len(zones))
+
+	return observeReconcile(DNSSEC_MAINTENANCE_CONTROLLER_NAME, true, ctrl.Result{RequeueAfter: requeueAfter}, nil)
+}
+
+// getDNSSECMaintenanceZones returns the DNSSEC signed Zones and ClusterZones, restricted to names when not empty
+func getDNSSECMaintenanceZones(ctx context.Context, cl client.Client, names []string) ([]dnsv1alpha2.GenericZone, error) {
+	var zoneList dnsv1alpha2.ZoneList
+	if err := cl.List(ctx, &zoneList); err != nil {
+		return nil, err
+	}
+	var clusterZoneList dnsv1alpha2.ClusterZoneList
+	if err := cl.List(ctx, &clusterZoneList); err != nil {
+		return nil, err
+	}
+
+	var zones []dnsv1alpha2.GenericZone
+	for i := range zoneList.Items {
+		zones = append(zones, &zoneList.Items[i])
+	}
+	for i := range clusterZoneList.Items {
+		zones = append(zones, &clusterZoneList.Items[i])
+	}
+	return slices.DeleteFunc(zones, func(gz dnsv1alpha2.GenericZone) bool {
+		if !ptr.Deref(gz.GetStatus().DNSsec, false) {
+			return true
+		}
+		return len(names) > 0 && !slices.Contains(names, gz.GetObjectMeta().Name)
+	}), nil
+}
+
+// getCryptokeyZones returns the names of the zones whose keys are managed through Cryptokey resources
+func getCryptokeyZones(ctx context.Context, cl client.Client) (map[string]bool, error) {
+	var cryptokeys dnsv1alpha2.CryptokeyList
+	if err := cl.List(ctx, &cryptokeys); err != nil {
+		return nil, err
+	}
+	zones := make(map[string]bool, len(cryptokeys.Items))
+	for _, cryptokey := range cryptokeys.Items {
+		zones[cryptokey.Spec.ZoneRef.Name] = true
+	}
+	return zones, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DNSSECMaintenanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&dnsv1alpha2.DNSSECMaintenance{}).
+		WithOptions(r.RateLimiter.controllerOptions()).
+		Complete(r)
+}
@@ -0,0 +1,127 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+//nolint:goconst
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+var _ = Describe("DNSSECMaintenance Controller", func() {
+
+	const (
+		// ClusterZone
+		zoneName = "example10.org"
+		zoneKind = NATIVE_KIND_ZONE
+		zoneNS1  = "ns1.example10.org"
+
+		// DNSSECMaintenance
+		resourceName = "rectify"
+
+		timeout  = time.Second * 5
+		interval = time.Millisecond * 250
+	)
+
+	zoneLookupKey := types.NamespacedName{
+		Name: zoneName,
+	}
+	maintenanceLookupKey := types.NamespacedName{
+		Name: resourceName,
+	}
+
+	BeforeEach(func() {
+		ctx := context.Background()
+		By("Creating the ClusterZone resource")
+		zone := &dnsv1alpha2.ClusterZone{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: zoneName,
+			},
+		}
+		_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, zone, func() error {
+			zone.Spec = dnsv1alpha2.ZoneSpec{
+				Kind:        zoneKind,
+				Nameservers: []string{zoneNS1},
+				DNSSEC:      ptr.To(true),
+			}
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, zoneLookupKey, zone)
+			return err == nil && ptr.Deref(zone.Status.DNSsec, false)
+		}, timeout, interval).Should(BeTrue())
+
+		By("Creating the DNSSECMaintenance resource")
+		resource := &dnsv1alpha2.DNSSECMaintenance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+			},
+		}
+		_, err = controllerutil.CreateOrUpdate(ctx, k8sClient, resource, func() error {
+			resource.Spec = dnsv1alpha2.DNSSECMaintenanceSpec{
+				Rectify: ptr.To(true),
+				Zones:   []string{zoneName},
+			}
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctx := context.Background()
+		resource := &dnsv1alpha2.DNSSECMaintenance{}
+		Expect(k8sClient.Get(ctx, maintenanceLookupKey, resource)).To(Succeed())
+
+		By("Cleaning up the specific resource instance DNSSECMaintenance")
+		Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, maintenanceLookupKey, resource)
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+
+		By("Cleaning up the specific resource instance ClusterZone")
+		zone := &dnsv1alpha2.ClusterZone{}
+		Expect(k8sClient.Get(ctx, zoneLookupKey, zone)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, zone)).To(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, zoneLookupKey, zone)
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	Context("When existing resource", func() {
+		It("should rectify the signed zones and complete", Label("dnssecmaintenance-initialization"), func() {
+			ctx := context.Background()
+			resource := &dnsv1alpha2.DNSSECMaintenance{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, maintenanceLookupKey, resource)
+				return err == nil && resource.IsInExpectedStatus(FIRST_GENERATION, dnsv1alpha2.SUCCEEDED_STATUS, metav1.ConditionTrue)
+			}, timeout, interval).Should(BeTrue())
+			Expect(ptr.Deref(resource.Status.Progress, "")).To(Equal("1/1"))
+			Expect(resource.Status.CompletionTime).NotTo(BeNil(), "CompletionTime should be set")
+			Expect(resource.Status.Zones).To(HaveLen(1))
+			Expect(resource.Status.Zones[0].Phase).To(Equal(dnsv1alpha2.MAINTENANCE_SUCCEEDED_PHASE))
+			Expect(ptr.Deref(resource.Status.Zones[0].Rectify, "")).To(Equal("Rectified"))
+		})
+	})
+})
@@ -0,0 +1,37 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// ParseLogLevel converts a log level name ("debug", "info", "warn", "error") or a verbosity (e.g. "2" to display
+// the log.V(2) logs) into a zapcore.Level
+func ParseLogLevel(level string) (zapcore.Level, error) {
+	level = strings.TrimSpace(level)
+	if verbosity, err := strconv.Atoi(level); err == nil {
+		if verbosity < 0 {
+			return 0, fmt.Errorf("invalid log verbosity %d, it must be positive", verbosity)
+		}
+		return zapcore.Level(-verbosity), nil
+	}
+	var l zapcore.Level
+	if err := l.UnmarshalText([]byte(strings.ToLower(level))); err != nil {
+		return 0, fmt.Errorf("invalid log level %q", level)
+	}
+	return l, nil
+}
@@ -0,0 +1,48 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestParseLogLevel(t *testing.T) {
+	var testCases = []struct {
+		description string
+		level       string
+		expected    zapcore.Level
+		expectedErr bool
+	}{
+		{"Info level", "info", zapcore.InfoLevel, false},
+		{"Uppercase level", "DEBUG", zapcore.DebugLevel, false},
+		{"Error level", "error", zapcore.ErrorLevel, false},
+		{"Verbosity", "2", zapcore.Level(-2), false},
+		{"Verbosity 0", "0", zapcore.InfoLevel, false},
+		{"Negative verbosity", "-1", 0, true},
+		{"Unknown level", "verbose", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, err := ParseLogLevel(tc.level)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
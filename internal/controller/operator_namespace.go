@@ -0,0 +1,43 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"os"
+	"strings"
+)
+
+const (
+	// Namespace of the operator Pod, given by the downward API
+	OPERATOR_NAMESPACE_ENV = "POD_NAMESPACE"
+	// Namespace of the service account mounted in the operator Pod
+	SERVICE_ACCOUNT_NAMESPACE_FILE = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+	DEFAULT_OPERATOR_NAMESPACE     = "powerdns-operator-system"
+)
+
+// GetOperatorNamespace returns the namespace the operator runs in: the POD_NAMESPACE environment variable,
+// then the namespace of the mounted service account, then the namespace of the default installation
+func GetOperatorNamespace() string {
+	return getOperatorNamespace(SERVICE_ACCOUNT_NAMESPACE_FILE)
+}
+
+func getOperatorNamespace(namespaceFile string) string {
+	if namespace := strings.TrimSpace(os.Getenv(OPERATOR_NAMESPACE_ENV)); namespace != "" {
+		return namespace
+	}
+	if content, err := os.ReadFile(namespaceFile); err == nil {
+		if namespace := strings.TrimSpace(string(content)); namespace != "" {
+			return namespace
+		}
+	}
+	return DEFAULT_OPERATOR_NAMESPACE
+}
@@ -0,0 +1,51 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetOperatorNamespace(t *testing.T) {
+	dir := t.TempDir()
+	namespaceFile := filepath.Join(dir, "namespace")
+	if err := os.WriteFile(namespaceFile, []byte("dns-system\n"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	emptyFile := filepath.Join(dir, "empty")
+	if err := os.WriteFile(emptyFile, nil, 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var testCases = []struct {
+		description   string
+		env           string
+		namespaceFile string
+		expected      string
+	}{
+		{"Namespace from the environment", "operators", namespaceFile, "operators"},
+		{"Namespace of the service account", "", namespaceFile, "dns-system"},
+		{"Empty service account namespace", "", emptyFile, DEFAULT_OPERATOR_NAMESPACE},
+		{"Outside of a cluster", "", filepath.Join(dir, "missing"), DEFAULT_OPERATOR_NAMESPACE},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Setenv(OPERATOR_NAMESPACE_ENV, tc.env)
+			if result := getOperatorNamespace(tc.namespaceFile); result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/joeig/go-powerdns/v3"
+)
+
+// pdnsAPIRequester sends the requests to PowerDNS API endpoints which are not covered by go-powerdns
+type pdnsAPIRequester struct {
+	baseURL    string
+	vhost      string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// newPdnsAPIRequester initializes a pdnsAPIRequester sharing the configuration of the PowerDNS client
+func newPdnsAPIRequester(client *powerdns.Client, apiKey string, httpClient *http.Client) pdnsAPIRequester {
+	return pdnsAPIRequester{
+		baseURL:    client.BaseURL,
+		vhost:      client.VHost,
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}
+}
+
+func (c pdnsAPIRequester) do(ctx context.Context, method, pathFragment string, body, v any) error {
+	apiURL, err := url.Parse(c.baseURL)
+	if err != nil {
+		return err
+	}
+	apiURL.Path = path.Join("/api/v1/servers", c.vhost, pathFragment)
+
+	// Some endpoints (e.g. rectify) take no body
+	var payload []byte
+	if body != nil {
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, apiURL.String(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	// Errors are reported the same way as go-powerdns does
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiError := &powerdns.Error{}
+		content, _ := io.ReadAll(resp.Body)
+		if err := json.Unmarshal(content, apiError); err != nil || apiError.Message == "" {
+			apiError.Message = string(content)
+		}
+		apiError.Status = resp.Status
+		apiError.StatusCode = resp.StatusCode
+		return apiError
+	}
+
+	if v != nil && resp.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			return fmt.Errorf("unable to decode PowerDNS API response: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joeig/go-powerdns/v3"
+)
+
+// RecordsBatcher groups the RRsets changes of a zone requested within Window into a single PATCH of PowerDNS API.
+// Each Change blocks until the batch it belongs to is applied. When a batch is rejected by PowerDNS,
+// its RRsets are applied one by one, so that an invalid RRset does not fail the other RRsets of the batch.
+// Get and Delete are passed through to Records.
+type RecordsBatcher struct {
+	Records pdnsRecordsClienter
+	// Window during which the changes of a zone are grouped
+	Window time.Duration
+
+	mu      sync.Mutex
+	pending map[string]*recordsBatch
+}
+
+type recordsBatch struct {
+	// Zone the batch is applied to, as given by the first change of the batch
+	domain  string
+	entries []*recordsBatchEntry
+	done    chan struct{}
+}
+
+type recordsBatchEntry struct {
+	rrset powerdns.RRset
+	err   error
+}
+
+// Change schedules the replacement of the RRset in the pending batch of the zone and waits for the batch to be applied
+func (b *RecordsBatcher) Change(ctx context.Context, domain string, name string, recordType powerdns.RRType, ttl uint32, content []string, options ...func(*powerdns.RRset)) error {
+	rrset := powerdns.RRset{
+		Name:       powerdns.String(makeCanonical(name)),
+		Type:       &recordType,
+		TTL:        &ttl,
+		ChangeType: powerdns.ChangeTypePtr(powerdns.ChangeTypeReplace),
+		Records:    make([]powerdns.Record, len(content)),
+	}
+	for _, opt := range options {
+		opt(&rrset)
+	}
+	for i, c := range content {
+		rrset.Records[i] = powerdns.Record{Content: powerdns.String(c), Disabled: powerdns.Bool(false), SetPTR: powerdns.Bool(false)}
+	}
+
+	entry, batch := b.add(ctx, domain, rrset)
+	select {
+	case <-batch.done:
+		return entry.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// add appends the RRset to the pending batch of the zone, the batch is applied at the end of the window.
+// The batches are keyed as the zone locks, so that the changes of a zone are grouped whatever the case or the trailing dot.
+func (b *RecordsBatcher) add(ctx context.Context, domain string, rrset powerdns.RRset) (*recordsBatchEntry, *recordsBatch) {
+	key := strings.ToLower(makeCanonical(domain))
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.pending == nil {
+		b.pending = map[string]*recordsBatch{}
+	}
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &recordsBatch{domain: domain, done: make(chan struct{})}
+		b.pending[key] = batch
+		time.AfterFunc(b.Window, func() {
+			b.flush(context.WithoutCancel(ctx), key)
+		})
+	}
+	entry := &recordsBatchEntry{rrset: rrset}
+	batch.entries = append(batch.entries, entry)
+	return entry, batch
+}
+
+// flush applies the pending batch of the zone and releases the waiting changes
+func (b *RecordsBatcher) flush(ctx context.Context, key string) {
+	b.mu.Lock()
+	batch := b.pending[key]
+	delete(b.pending, key)
+	b.mu.Unlock()
+	defer close(batch.done)
+	domain := batch.domain
+
+	rrsets := &powerdns.RRsets{}
+	for _, entry := range batch.entries {
+		rrsets.Sets = append(rrsets.Sets, entry.rrset)
+	}
+	// The batch is applied under the write lock of the zone, as the unbatched writes
+	defer lockZone(domain)()
+	err := b.Records.Patch(ctx, domain, rrsets)
+	if err == nil || len(batch.entries) == 1 {
+		for _, entry := range batch.entries {
+			entry.err = err
+		}
+		return
+	}
+	for _, entry := range batch.entries {
+		entry.err = b.Records.Patch(ctx, domain, &powerdns.RRsets{Sets: []powerdns.RRset{entry.rrset}})
+	}
+}
+
+// Get retrieves the RRsets from PowerDNS, it is not batched
+func (b *RecordsBatcher) Get(ctx context.Context, domain, name string, recordType *powerdns.RRType) ([]powerdns.RRset, error) {
+	return b.Records.Get(ctx, domain, name, recordType)
+}
+
+// Delete deletes the RRset from PowerDNS, it is not batched
+func (b *RecordsBatcher) Delete(ctx context.Context, domain string, name string, recordType powerdns.RRType) error {
+	return b.Records.Delete(ctx, domain, name, recordType)
+}
+
+// Patch applies the RRsets to PowerDNS, it is not batched
+func (b *RecordsBatcher) Patch(ctx context.Context, domain string, rrSets *powerdns.RRsets) error {
+	return b.Records.Patch(ctx, domain, rrSets)
+}
@@ -0,0 +1,87 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/joeig/go-powerdns/v3"
+)
+
+// patchCountingRecordsClient records the PATCH calls and rejects the batches containing an "invalid" RRset
+type patchCountingRecordsClient struct {
+	pdnsRecordsClienter
+	mu      sync.Mutex
+	patches [][]powerdns.RRset
+}
+
+func (c *patchCountingRecordsClient) Patch(ctx context.Context, domain string, rrSets *powerdns.RRsets) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.patches = append(c.patches, rrSets.Sets)
+	for _, rrset := range rrSets.Sets {
+		if *rrset.Name == "invalid.example.org." {
+			return &powerdns.Error{StatusCode: 422, Message: "invalid RRset"}
+		}
+	}
+	return nil
+}
+
+func TestRecordsBatcher(t *testing.T) {
+	var testCases = []struct {
+		description     string
+		domains         []string
+		names           []string
+		expectedPatches int
+		expectedErrors  int
+	}{
+		{"Single change", []string{"example.org"}, []string{"www0"}, 1, 0},
+		{"Changes collapsed into one request", []string{"example.org"}, []string{"www0", "www1", "www2", "www3", "www4", "www5", "www6", "www7", "www8", "www9"}, 1, 0},
+		{"Rejected batch applied one by one", []string{"example.org"}, []string{"www0", "invalid", "www2"}, 4, 1},
+		{"Zone names differing by case or trailing dot", []string{"example.org", "example.org.", "Example.ORG"}, []string{"www0", "www1", "www2"}, 1, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			records := &patchCountingRecordsClient{}
+			batcher := &RecordsBatcher{Records: records, Window: 50 * time.Millisecond}
+
+			var wg sync.WaitGroup
+			errs := make(chan error, len(tc.names))
+			for i, name := range tc.names {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := batcher.Change(context.Background(), tc.domains[i%len(tc.domains)], fmt.Sprintf("%s.example.org", name), powerdns.RRTypeA, 300, []string{"192.0.2.1"}); err != nil {
+						errs <- err
+					}
+				}()
+			}
+			wg.Wait()
+			close(errs)
+
+			if len(records.patches) != tc.expectedPatches {
+				t.Errorf("got %v, want %v", len(records.patches), tc.expectedPatches)
+			}
+			if len(records.patches[0]) != len(tc.names) {
+				t.Errorf("got %v, want %v", len(records.patches[0]), len(tc.names))
+			}
+			if len(errs) != tc.expectedErrors {
+				t.Errorf("got %v, want %v", len(errs), tc.expectedErrors)
+			}
+		})
+	}
+}
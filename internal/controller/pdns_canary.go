@@ -0,0 +1,98 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/joeig/go-powerdns/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	CANARY_RECORD_PREFIX = "_powerdns-operator-canary"
+	CANARY_RECORD_TTL    = uint32(60)
+)
+
+var writeHealthyMetric = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "pdns_write_healthy",
+		Help: "Whether the last canary record write on PowerDNS API succeeded (1) or failed (0)",
+	},
+)
+
+// WriteCanary periodically creates and deletes a canary TXT record in a dedicated zone
+// to verify that the PowerDNS API credentials allow writes, not only reads
+type WriteCanary struct {
+	PDNSClient PdnsClienter
+	// Zone is the existing zone in which the canary record is written
+	Zone string
+	// Interval between two canary checks
+	Interval time.Duration
+}
+
+// SetupWithManager registers the canary metric and adds the canary to the Manager
+func (c *WriteCanary) SetupWithManager(mgr ctrl.Manager) error {
+	if c.Zone == "" {
+		return fmt.Errorf("a canary zone is required")
+	}
+	if c.Interval <= 0 {
+		return fmt.Errorf("canary interval must be positive")
+	}
+	metrics.Registry.MustRegister(writeHealthyMetric)
+	return mgr.Add(c)
+}
+
+// NeedLeaderElection ensures only the leader writes the canary record
+func (c *WriteCanary) NeedLeaderElection() bool {
+	return true
+}
+
+// Start runs the canary check at each interval until the context is cancelled
+func (c *WriteCanary) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		_ = c.check(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// check creates then deletes the canary record and reports the result in writeHealthyMetric
+func (c *WriteCanary) check(ctx context.Context) error {
+	log := log.FromContext(ctx).WithValues("zone", c.Zone)
+	zone := makeCanonical(c.Zone)
+	name := CANARY_RECORD_PREFIX + "." + zone
+	content := fmt.Sprintf("%q", "powerdns-operator canary "+time.Now().UTC().Format(time.RFC3339))
+
+	err := c.PDNSClient.Records.Change(ctx, zone, name, powerdns.RRTypeTXT, CANARY_RECORD_TTL, []string{content})
+	if err == nil {
+		err = c.PDNSClient.Records.Delete(ctx, zone, name, powerdns.RRTypeTXT)
+	}
+	if err != nil {
+		log.Error(err, "PowerDNS API canary write failed")
+		writeHealthyMetric.Set(0)
+		return err
+	}
+	log.V(1).Info("PowerDNS API canary write succeeded")
+	writeHealthyMetric.Set(1)
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestWriteCanaryCheck(t *testing.T) {
+	var testCases = []struct {
+		description    string
+		zone           string
+		expectedErr    bool
+		expectedMetric float64
+	}{
+		{"Writable zone", "example.org", false, 1},
+		{"Write failure", FAKE_SITE, true, 0},
+	}
+
+	// Mock initialization
+	teardownTestCase := setupTestCase()
+	defer teardownTestCase()
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			canary := &WriteCanary{PDNSClient: PDNSClient, Zone: tc.zone, Interval: time.Minute}
+			err := canary.check(context.Background())
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if got := testutil.ToFloat64(writeHealthyMetric); got != tc.expectedMetric {
+				t.Errorf("got %v, want %v", got, tc.expectedMetric)
+			}
+			// The canary record never remains in the zone
+			if _, found := readFromRecordsMap(makeCanonical(CANARY_RECORD_PREFIX + "." + tc.zone)); found {
+				t.Errorf("canary record should have been deleted")
+			}
+		})
+	}
+}
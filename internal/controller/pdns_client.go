@@ -0,0 +1,378 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// PDNSTLSConfig holds the TLS settings applied to the connection with PowerDNS API
+type PDNSTLSConfig struct {
+	// Insecure disables the verification of the PowerDNS API certificate
+	Insecure bool
+	// CAPath is the path to the certificate authority used to verify the PowerDNS API certificate
+	CAPath string
+	// MinVersion is the minimum TLS version accepted (e.g. "1.2", "TLS1.3"), empty means Go default
+	MinVersion string
+	// CipherSuites is the list of cipher suites names accepted, empty means Go default
+	CipherSuites []string
+	// CertPath and KeyPath are the paths to the client certificate and key presented to PowerDNS API (mutual TLS)
+	CertPath string
+	KeyPath  string
+}
+
+// PDNSTransportConfig holds the connection pooling settings of the connection with PowerDNS API
+type PDNSTransportConfig struct {
+	// MaxIdleConnsPerHost is the number of idle (keep-alive) connections kept per PowerDNS API endpoint, 0 means Go default (2)
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout is the time an idle connection is kept before being closed, 0 means no limit
+	IdleConnTimeout time.Duration
+	// HTTP2 enables HTTP/2 with PowerDNS API when negotiated over TLS
+	HTTP2 bool
+}
+
+// PDNSHTTPConfig holds all the settings of the http.Client used to communicate with PowerDNS API
+type PDNSHTTPConfig struct {
+	TLS       PDNSTLSConfig
+	Transport PDNSTransportConfig
+	// Endpoints are the PowerDNS API URLs in order of preference, a failover is set up when several are given
+	Endpoints []string
+	// APIKeyFile is the path to a file holding the PowerDNS API key, read again when it changes (e.g. rotated by a Vault Agent)
+	APIKeyFile string
+}
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// parseTLSVersion converts a TLS version name ("1.2", "TLS1.2", "TLS 1.2", "TLS12") into its tls.VersionTLS* value
+func parseTLSVersion(version string) (uint16, error) {
+	v := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(version), " ", ""))
+	v = strings.TrimPrefix(v, "TLS")
+	if len(v) == 2 && !strings.Contains(v, ".") {
+		v = v[:1] + "." + v[1:]
+	}
+	if id, ok := tlsVersions[v]; ok {
+		return id, nil
+	}
+	return 0, fmt.Errorf("unknown TLS version %q", version)
+}
+
+// parseCipherSuites converts cipher suites names into their IDs, insecure cipher suites are rejected
+func parseCipherSuites(names []string) ([]uint16, error) {
+	secure := map[string]uint16{}
+	for _, c := range tls.CipherSuites() {
+		secure[c.Name] = c.ID
+	}
+	insecure := map[string]bool{}
+	for _, c := range tls.InsecureCipherSuites() {
+		insecure[c.Name] = true
+	}
+
+	ids := make([]uint16, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if insecure[name] {
+			return nil, fmt.Errorf("cipher suite %s is insecure", name)
+		}
+		id, ok := secure[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown cipher suite %s", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// newTLSConfig builds the tls.Config used to communicate with PowerDNS API
+// Insecure combinations (TLS < 1.2, insecure cipher suites, cipher suites with TLS 1.3 only) are rejected
+func newTLSConfig(config PDNSTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.Insecure, //nolint:gosec // Explicitly requested by the user
+	}
+
+	if config.MinVersion != "" {
+		minVersion, err := parseTLSVersion(config.MinVersion)
+		if err != nil {
+			return nil, err
+		}
+		if minVersion < tls.VersionTLS12 {
+			return nil, fmt.Errorf("TLS minimum version %s is insecure, at least TLS 1.2 is required", config.MinVersion)
+		}
+		tlsConfig.MinVersion = minVersion
+	}
+
+	if len(config.CipherSuites) > 0 {
+		if tlsConfig.MinVersion == tls.VersionTLS13 {
+			return nil, fmt.Errorf("cipher suites are not configurable with TLS 1.3")
+		}
+		cipherSuites, err := parseCipherSuites(config.CipherSuites)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.CipherSuites = cipherSuites
+	}
+
+	if config.CAPath != "" {
+		caCert, err := os.ReadFile(config.CAPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load CA certificate: %w", err)
+		}
+		caCertPool := x509.NewCertPool()
+		if ok := caCertPool.AppendCertsFromPEM(caCert); !ok {
+			return nil, fmt.Errorf("unable to parse CA certificate")
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	if config.CertPath != "" || config.KeyPath != "" {
+		if config.CertPath == "" || config.KeyPath == "" {
+			return nil, fmt.Errorf("both the client certificate and key are required")
+		}
+		if _, err := tls.LoadX509KeyPair(config.CertPath, config.KeyPath); err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		// The client certificate is loaded on each handshake, so that a renewed certificate is used without restart
+		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(config.CertPath, config.KeyPath)
+			if err != nil {
+				return nil, fmt.Errorf("unable to load client certificate: %w", err)
+			}
+			return &cert, nil
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// NewHTTPClient initializes the http.Client used to communicate with PowerDNS API.
+// Its transport records the rate limited (HTTP 429) responses and, when several endpoints are given,
+// fails over to the next endpoint when the active one is unreachable.
+func NewHTTPClient(config PDNSHTTPConfig) (*http.Client, error) {
+	tlsConfig, err := newTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	if config.Transport.MaxIdleConnsPerHost < 0 || config.Transport.IdleConnTimeout < 0 {
+		return nil, fmt.Errorf("idle connections settings must be positive")
+	}
+	var transport http.RoundTripper = &http.Transport{
+		TLSClientConfig:     tlsConfig,
+		MaxIdleConnsPerHost: config.Transport.MaxIdleConnsPerHost,
+		IdleConnTimeout:     config.Transport.IdleConnTimeout,
+		// HTTP/2 is not attempted by default with a custom TLS configuration
+		ForceAttemptHTTP2: config.Transport.HTTP2,
+	}
+
+	// The Retry-After header of the rate limited responses delays the reconcile of the resources
+	transport = &RateLimitTransport{Base: transport}
+
+	if config.APIKeyFile != "" {
+		transport, err = NewAPIKeyFileTransport(transport, config.APIKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(config.Endpoints) > 1 {
+		transport, err = NewFailoverTransport(transport, config.Endpoints)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+// APIKeyFileTransport authenticates the PowerDNS API requests with the API key held in a file.
+// The file is read again when its modification time or size changes, so that a rotated key is used without restart.
+type APIKeyFileTransport struct {
+	base http.RoundTripper
+	path string
+
+	mu      sync.Mutex
+	key     string
+	modTime time.Time
+	size    int64
+}
+
+// NewAPIKeyFileTransport initializes an APIKeyFileTransport, the file must hold a key
+func NewAPIKeyFileTransport(base http.RoundTripper, path string) (*APIKeyFileTransport, error) {
+	t := &APIKeyFileTransport{base: base, path: path}
+	if _, err := t.APIKey(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ReadAPIKeyFile returns the PowerDNS API key held in a file, without surrounding whitespaces
+func ReadAPIKeyFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the PowerDNS API key file: %w", err)
+	}
+	key := strings.TrimSpace(string(content))
+	if key == "" {
+		return "", fmt.Errorf("the PowerDNS API key file %s is empty", path)
+	}
+	return key, nil
+}
+
+// APIKey returns the current API key, the last key read is kept when the file is temporarily unreadable or empty
+// (e.g. while being rewritten)
+func (t *APIKeyFileTransport) APIKey() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	info, err := os.Stat(t.path)
+	if err == nil && t.key != "" && info.ModTime().Equal(t.modTime) && info.Size() == t.size {
+		return t.key, nil
+	}
+	key, readErr := ReadAPIKeyFile(t.path)
+	if readErr != nil {
+		if t.key != "" {
+			return t.key, nil
+		}
+		return "", readErr
+	}
+	t.key = key
+	if err == nil {
+		t.modTime, t.size = info.ModTime(), info.Size()
+	}
+	return t.key, nil
+}
+
+// RoundTrip implements http.RoundTripper, replacing the API key of the request with the current one
+func (t *APIKeyFileTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key, err := t.APIKey()
+	if err != nil {
+		return nil, err
+	}
+	keyReq := req.Clone(req.Context())
+	keyReq.Header.Set("X-API-Key", key)
+	return t.base.RoundTrip(keyReq)
+}
+
+// FailoverTransport sends the PowerDNS API requests to the first reachable of several API endpoints.
+// The requests are expected to target the first endpoint, their path prefix is replaced by the one of the endpoint they are sent to.
+// A request is only sent to the next endpoint when the connection to the active one cannot be established,
+// so that a request already received by PowerDNS is never applied twice (e.g. a duplicate zone creation).
+type FailoverTransport struct {
+	base      http.RoundTripper
+	endpoints []*url.URL
+	active    atomic.Int32
+}
+
+// NewFailoverTransport initializes a FailoverTransport over the endpoints, in order of preference
+func NewFailoverTransport(base http.RoundTripper, endpoints []string) (*FailoverTransport, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("at least one PowerDNS API endpoint is required")
+	}
+	t := &FailoverTransport{base: base}
+	for _, e := range endpoints {
+		u, err := url.Parse(strings.TrimSpace(e))
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid PowerDNS API endpoint %q", e)
+		}
+		u.Path = strings.TrimSuffix(u.Path, "/")
+		u.RawPath = strings.TrimSuffix(u.RawPath, "/")
+		t.endpoints = append(t.endpoints, u)
+	}
+	t.updateActiveEndpointMetric(0)
+	return t, nil
+}
+
+// ActiveEndpoint returns the endpoint the requests are currently sent to
+func (t *FailoverTransport) ActiveEndpoint() string {
+	return t.endpoints[t.active.Load()].String()
+}
+
+// updateActiveEndpointMetric sets pdnsAPIActiveEndpointMetric to 1 for the active endpoint and 0 for the others
+func (t *FailoverTransport) updateActiveEndpointMetric(active int) {
+	for i, e := range t.endpoints {
+		value := 0.
+		if i == active {
+			value = 1
+		}
+		pdnsAPIActiveEndpointMetric.WithLabelValues(e.Redacted()).Set(value)
+	}
+}
+
+// endpointURL returns a copy of u sent to the endpoint at index, replacing the path prefix of the first endpoint
+func (t *FailoverTransport) endpointURL(u *url.URL, index int) *url.URL {
+	primary, endpoint := t.endpoints[0], t.endpoints[index]
+	result := *u
+	result.Scheme = endpoint.Scheme
+	result.Host = endpoint.Host
+	if index == 0 {
+		return &result
+	}
+	result.Path = endpoint.Path + strings.TrimPrefix(u.Path, primary.Path)
+	if u.RawPath != "" {
+		result.RawPath = endpoint.EscapedPath() + strings.TrimPrefix(u.RawPath, primary.EscapedPath())
+	}
+	return &result
+}
+
+// RoundTrip implements http.RoundTripper, starting with the active endpoint
+func (t *FailoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	active := int(t.active.Load())
+	var err error
+	for i := range t.endpoints {
+		index := (active + i) % len(t.endpoints)
+		endpointReq := req.Clone(req.Context())
+		endpointReq.URL = t.endpointURL(req.URL, index)
+		endpointReq.Host = ""
+		// The body of the failed request has been closed, it can only be sent again if it can be recreated
+		if i > 0 && req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return nil, err
+			}
+			if endpointReq.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+		var resp *http.Response
+		resp, err = t.base.RoundTrip(endpointReq)
+		if err == nil || !isDialError(err) {
+			if index != active && t.active.CompareAndSwap(int32(active), int32(index)) {
+				log.FromContext(req.Context()).Info("PowerDNS API endpoint failover", "endpoint", t.endpoints[index].Redacted())
+				t.updateActiveEndpointMetric(index)
+			}
+			return resp, err
+		}
+	}
+	return nil, err
+}
+
+// isDialError returns true if err happened while establishing the connection, before the request was sent
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,428 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	stdlog "log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestParseTLSVersion(t *testing.T) {
+	var testCases = []struct {
+		description string
+		version     string
+		expected    uint16
+		expectedErr bool
+	}{
+		{"Short version", "1.2", tls.VersionTLS12, false},
+		{"Prefixed version", "TLS1.3", tls.VersionTLS13, false},
+		{"Spaced version", "TLS 1.2", tls.VersionTLS12, false},
+		{"Compact version", "tls13", tls.VersionTLS13, false},
+		{"Unknown version", "2.0", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, err := parseTLSVersion(tc.version)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+// baseTransport returns the http.Transport wrapped by the transports of a PowerDNS API http.Client
+func baseTransport(httpClient *http.Client) *http.Transport {
+	transport := httpClient.Transport
+	if failover, ok := transport.(*FailoverTransport); ok {
+		transport = failover.base
+	}
+	return transport.(*RateLimitTransport).Base.(*http.Transport)
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	var testCases = []struct {
+		description          string
+		config               PDNSTLSConfig
+		expectedMinVersion   uint16
+		expectedCipherSuites []uint16
+		expectedErr          bool
+	}{
+		{
+			"Default configuration",
+			PDNSTLSConfig{},
+			0,
+			nil,
+			false,
+		},
+		{
+			"TLS 1.3 minimum version",
+			PDNSTLSConfig{MinVersion: "1.3"},
+			tls.VersionTLS13,
+			nil,
+			false,
+		},
+		{
+			"TLS 1.2 minimum version with cipher suites",
+			PDNSTLSConfig{MinVersion: "TLS1.2", CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256", " TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"}},
+			tls.VersionTLS12,
+			[]uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384},
+			false,
+		},
+		{
+			"Insecure minimum version",
+			PDNSTLSConfig{MinVersion: "1.0"},
+			0,
+			nil,
+			true,
+		},
+		{
+			"Insecure cipher suite",
+			PDNSTLSConfig{CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}},
+			0,
+			nil,
+			true,
+		},
+		{
+			"Unknown cipher suite",
+			PDNSTLSConfig{CipherSuites: []string{"TLS_UNKNOWN"}},
+			0,
+			nil,
+			true,
+		},
+		{
+			"Cipher suites with TLS 1.3 minimum version",
+			PDNSTLSConfig{MinVersion: "1.3", CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"}},
+			0,
+			nil,
+			true,
+		},
+		{
+			"Missing CA certificate",
+			PDNSTLSConfig{CAPath: "/nonexistent/ca.crt"},
+			0,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			httpClient, err := NewHTTPClient(PDNSHTTPConfig{TLS: tc.config})
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("an error was expected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tlsConfig := baseTransport(httpClient).TLSClientConfig
+			if !cmp.Equal(tlsConfig.MinVersion, tc.expectedMinVersion) {
+				t.Errorf("got %v, want %v", tlsConfig.MinVersion, tc.expectedMinVersion)
+			}
+			if !cmp.Equal(tlsConfig.CipherSuites, tc.expectedCipherSuites) {
+				t.Errorf("got %v, want %v", tlsConfig.CipherSuites, tc.expectedCipherSuites)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientTransport(t *testing.T) {
+	var testCases = []struct {
+		description string
+		config      PDNSTransportConfig
+		expectedErr bool
+	}{
+		{"Default configuration", PDNSTransportConfig{}, false},
+		{"Tuned configuration", PDNSTransportConfig{MaxIdleConnsPerHost: 20, IdleConnTimeout: time.Minute, HTTP2: true}, false},
+		{"Negative idle connections", PDNSTransportConfig{MaxIdleConnsPerHost: -1}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			httpClient, err := NewHTTPClient(PDNSHTTPConfig{Transport: tc.config})
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("an error was expected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tr := baseTransport(httpClient)
+			result := PDNSTransportConfig{MaxIdleConnsPerHost: tr.MaxIdleConnsPerHost, IdleConnTimeout: tr.IdleConnTimeout, HTTP2: tr.ForceAttemptHTTP2}
+			if !cmp.Equal(result, tc.config) {
+				t.Errorf("got %v, want %v", result, tc.config)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientEndpoints(t *testing.T) {
+	var testCases = []struct {
+		description      string
+		endpoints        []string
+		expectedFailover bool
+		expectedErr      bool
+	}{
+		{"No endpoint", nil, false, false},
+		{"Single endpoint", []string{"https://pdns-1:8081"}, false, false},
+		{"Several endpoints", []string{"https://pdns-1:8081", " https://pdns-2:8081"}, true, false},
+		{"Invalid endpoint", []string{"https://pdns-1:8081", "not-an-url"}, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			httpClient, err := NewHTTPClient(PDNSHTTPConfig{Endpoints: tc.endpoints})
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("an error was expected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// The rate limited responses are always recorded, beneath the failover between endpoints
+			failover, isFailover := httpClient.Transport.(*FailoverTransport)
+			if isFailover != tc.expectedFailover {
+				t.Errorf("got %v, want %v", isFailover, tc.expectedFailover)
+			}
+			if isFailover && failover.ActiveEndpoint() != tc.endpoints[0] {
+				t.Errorf("got %v, want %v", failover.ActiveEndpoint(), tc.endpoints[0])
+			}
+			if baseTransport(httpClient) == nil {
+				t.Errorf("got nil, want a http.Transport")
+			}
+		})
+	}
+}
+
+// writeSelfSignedCertificate writes a self-signed certificate and its key in dir, returning their paths
+func writeSelfSignedCertificate(t *testing.T, dir, commonName string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	certPath := filepath.Join(dir, commonName+".crt")
+	keyPath := filepath.Join(dir, commonName+".key")
+	if err := os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return certPath, keyPath
+}
+
+func TestNewHTTPClientMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	certPath, keyPath := writeSelfSignedCertificate(t, dir, "powerdns-operator")
+
+	// The server requires a client certificate and returns its common name
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.Config.ErrorLog = stdlog.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+	caPath := filepath.Join(dir, "ca.crt")
+	if err := os.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	httpClient, err := NewHTTPClient(PDNSHTTPConfig{TLS: PDNSTLSConfig{CAPath: caPath, CertPath: certPath, KeyPath: keyPath}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := httpClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if string(body) != "powerdns-operator" {
+		t.Errorf("got %v, want %v", string(body), "powerdns-operator")
+	}
+
+	// Without client certificate, the handshake fails
+	httpClient, err = NewHTTPClient(PDNSHTTPConfig{TLS: PDNSTLSConfig{CAPath: caPath}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp, err := httpClient.Get(server.URL); err == nil {
+		_ = resp.Body.Close()
+		t.Errorf("an error was expected")
+	}
+
+	// The certificate and the key are both required and must be valid
+	if _, err := NewHTTPClient(PDNSHTTPConfig{TLS: PDNSTLSConfig{CertPath: certPath}}); err == nil {
+		t.Errorf("an error was expected")
+	}
+	if _, err := NewHTTPClient(PDNSHTTPConfig{TLS: PDNSTLSConfig{CertPath: certPath, KeyPath: caPath}}); err == nil {
+		t.Errorf("an error was expected")
+	}
+}
+
+func TestFailoverTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write([]byte(r.URL.Path + " " + string(body)))
+	}))
+	defer server.Close()
+
+	// Reserve a port and release it, so that nothing listens on it
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	unreachable := "http://" + listener.Addr().String()
+	_ = listener.Close()
+
+	// The path prefix of the first endpoint is replaced by the one of the endpoint the request is sent to
+	transport, err := NewFailoverTransport(http.DefaultTransport, []string{unreachable + "/primary", server.URL + "/pdns/"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := testutil.ToFloat64(pdnsAPIActiveEndpointMetric.WithLabelValues(unreachable + "/primary")); result != 1 {
+		t.Errorf("got %v, want %v", result, 1)
+	}
+	httpClient := &http.Client{Transport: transport}
+
+	for range 2 {
+		resp, err := httpClient.Post(unreachable+"/primary/api/v1/servers/localhost/zones", "application/json", strings.NewReader("zone"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		expected := "/pdns/api/v1/servers/localhost/zones zone"
+		if string(body) != expected {
+			t.Errorf("got %v, want %v", string(body), expected)
+		}
+		if transport.ActiveEndpoint() != server.URL+"/pdns" {
+			t.Errorf("got %v, want %v", transport.ActiveEndpoint(), server.URL+"/pdns")
+		}
+	}
+	if result := testutil.ToFloat64(pdnsAPIActiveEndpointMetric.WithLabelValues(unreachable + "/primary")); result != 0 {
+		t.Errorf("got %v, want %v", result, 0)
+	}
+	if result := testutil.ToFloat64(pdnsAPIActiveEndpointMetric.WithLabelValues(server.URL + "/pdns")); result != 1 {
+		t.Errorf("got %v, want %v", result, 1)
+	}
+
+	if _, err := NewFailoverTransport(http.DefaultTransport, []string{"not-an-url"}); err == nil {
+		t.Errorf("an error was expected")
+	}
+}
+
+func TestAPIKeyFileTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("X-API-Key")))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "api-key")
+	if _, err := NewAPIKeyFileTransport(http.DefaultTransport, path); err == nil {
+		t.Errorf("an error was expected for a missing file")
+	}
+	if err := os.WriteFile(path, []byte("  \n"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := NewAPIKeyFileTransport(http.DefaultTransport, path); err == nil {
+		t.Errorf("an error was expected for an empty file")
+	}
+
+	if err := os.WriteFile(path, []byte("first-key\n"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	transport, err := NewAPIKeyFileTransport(http.DefaultTransport, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	httpClient := &http.Client{Transport: transport}
+	get := func() string {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		req.Header.Set("X-API-Key", "static-key")
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	if got := get(); got != "first-key" {
+		t.Errorf("got %v, want %v", got, "first-key")
+	}
+
+	// The rotated key is used without restart
+	if err := os.WriteFile(path, []byte("rotated-key"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := get(); got != "rotated-key" {
+		t.Errorf("got %v, want %v", got, "rotated-key")
+	}
+
+	// The last key is kept while the file is missing
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := get(); got != "rotated-key" {
+		t.Errorf("got %v, want %v", got, "rotated-key")
+	}
+}
@@ -0,0 +1,48 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/joeig/go-powerdns/v3"
+)
+
+// CryptokeysClient extends the go-powerdns Cryptokeys service, which is read-only,
+// with the creation and the (de)activation of Cryptokeys
+type CryptokeysClient struct {
+	*powerdns.CryptokeysService
+	pdnsAPIRequester
+}
+
+// NewCryptokeysClient initializes a CryptokeysClient sharing the configuration of the PowerDNS client
+func NewCryptokeysClient(client *powerdns.Client, apiKey string, httpClient *http.Client) *CryptokeysClient {
+	return &CryptokeysClient{
+		CryptokeysService: client.Cryptokeys,
+		pdnsAPIRequester:  newPdnsAPIRequester(client, apiKey, httpClient),
+	}
+}
+
+// Add creates a Cryptokey in a zone, PowerDNS generates the key material
+func (c *CryptokeysClient) Add(ctx context.Context, domain string, cryptokey *powerdns.Cryptokey) (*powerdns.Cryptokey, error) {
+	result := &powerdns.Cryptokey{}
+	err := c.do(ctx, http.MethodPost, path.Join("zones", makeCanonical(domain), "cryptokeys"), cryptokey, result)
+	return result, err
+}
+
+// Change activates or deactivates a Cryptokey
+func (c *CryptokeysClient) Change(ctx context.Context, domain string, id uint64, active bool) error {
+	return c.do(ctx, http.MethodPut, path.Join("zones", makeCanonical(domain), "cryptokeys", strconv.FormatUint(id, 10)), &powerdns.Cryptokey{Active: &active}, nil)
+}
@@ -0,0 +1,83 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/joeig/go-powerdns/v3"
+	"k8s.io/utils/ptr"
+)
+
+func TestCryptokeysClient(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Header.Get("X-API-Key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var body powerdns.Cryptokey
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		switch {
+		case r.Method == http.MethodPost:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(powerdns.Cryptokey{ID: ptr.To(uint64(1)), KeyType: body.KeyType, Active: body.Active})
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/servers/localhost/zones/example.org./cryptokeys/1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": "Could not find domain"}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	pdnsClient := powerdns.New(server.URL, "localhost", powerdns.WithAPIKey("secret"))
+	client := NewCryptokeysClient(pdnsClient, "secret", server.Client())
+
+	cryptokey, err := client.Add(ctx, "example.org", &powerdns.Cryptokey{KeyType: ptr.To("csk"), Active: ptr.To(true)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cmp.Equal(ptr.Deref(cryptokey.KeyType, ""), "csk") {
+		t.Errorf("got %v, want %v", ptr.Deref(cryptokey.KeyType, ""), "csk")
+	}
+
+	if err := client.Change(ctx, "example.org", 1, false); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err = client.Change(ctx, "example.org", 2, false)
+	if !isPdnsNotFound(err) {
+		t.Errorf("got %v, want a not found error", err)
+	}
+	if !cmp.Equal(err.Error(), "Could not find domain") {
+		t.Errorf("got %v, want %v", err.Error(), "Could not find domain")
+	}
+
+	expectedRequests := []string{
+		"POST /api/v1/servers/localhost/zones/example.org./cryptokeys",
+		"PUT /api/v1/servers/localhost/zones/example.org./cryptokeys/1",
+		"PUT /api/v1/servers/localhost/zones/example.org./cryptokeys/2",
+	}
+	if !cmp.Equal(requests, expectedRequests) {
+		t.Errorf("got %v, want %v", requests, expectedRequests)
+	}
+}
@@ -0,0 +1,184 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/joeig/go-powerdns/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+// DryRunError is returned instead of performing a write on PowerDNS API in dry-run mode,
+// it describes the change which would have been made
+type DryRunError struct {
+	Operation string
+	Target    string
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry-run, %s %s not applied", e.Operation, e.Target)
+}
+
+// NewDryRunPdnsClienter wraps each PowerDNS API client of c so that reads are performed
+// while writes are only logged and fail with a DryRunError
+func NewDryRunPdnsClienter(c PdnsClienter) PdnsClienter {
+	dryRun := PdnsClienter{}
+	if c.Records != nil {
+		dryRun.Records = &dryRunRecordsClient{c.Records}
+	}
+	if c.Zones != nil {
+		dryRun.Zones = &dryRunZonesClient{c.Zones}
+	}
+	if c.Cryptokeys != nil {
+		dryRun.Cryptokeys = &dryRunCryptokeysClient{c.Cryptokeys}
+	}
+	if c.Metadata != nil {
+		dryRun.Metadata = &dryRunMetadataClient{c.Metadata}
+	}
+	if c.TSIGKeys != nil {
+		dryRun.TSIGKeys = &dryRunTSIGKeysClient{c.TSIGKeys}
+	}
+	// Statistics are only read
+	dryRun.Statistics = c.Statistics
+	return dryRun
+}
+
+// DryRunNotice emits a Warning event on the operator Pod at startup, so that operators know
+// the changes on PowerDNS are suppressed (dry-run or read-only mode)
+type DryRunNotice struct {
+	Recorder events.EventRecorder
+	// PodName and PodNamespace identify the operator Pod, the event is only logged when they are empty
+	PodName      string
+	PodNamespace string
+}
+
+// SetupWithManager adds the notice to the Manager
+func (n *DryRunNotice) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(n)
+}
+
+// NeedLeaderElection ensures every replica reports its own mode, not only the leader
+func (n *DryRunNotice) NeedLeaderElection() bool {
+	return false
+}
+
+// Start emits the Warning event once
+func (n *DryRunNotice) Start(ctx context.Context) error {
+	log.FromContext(ctx).Info("Dry-run mode, changes are not applied on PowerDNS")
+	if n.Recorder == nil || n.PodName == "" || n.PodNamespace == "" {
+		return nil
+	}
+	pod := &corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: n.PodName, Namespace: n.PodNamespace}
+	n.Recorder.Eventf(pod, nil, corev1.EventTypeWarning, dnsv1alpha2.DRY_RUN_REASON, "Start", "%s", DRY_RUN_NOTICE_MESSAGE)
+	return nil
+}
+
+// dryRun logs the write which would have been made and returns the matching DryRunError
+func dryRun(ctx context.Context, operation, target string) error {
+	log.FromContext(ctx).Info("Dry-run, change not applied on PowerDNS", "operation", operation, "target", target)
+	return &DryRunError{Operation: operation, Target: target}
+}
+
+type dryRunRecordsClient struct {
+	pdnsRecordsClienter
+}
+
+func (c *dryRunRecordsClient) Delete(ctx context.Context, domain string, name string, recordType powerdns.RRType) error {
+	return dryRun(ctx, "records.delete", fmt.Sprintf("%s %s", makeCanonical(name), recordType))
+}
+
+func (c *dryRunRecordsClient) Change(ctx context.Context, domain string, name string, recordType powerdns.RRType, ttl uint32, content []string, options ...func(*powerdns.RRset)) error {
+	return dryRun(ctx, "records.change", fmt.Sprintf("%s %d %s %v", makeCanonical(name), ttl, recordType, content))
+}
+
+func (c *dryRunRecordsClient) Patch(ctx context.Context, domain string, rrSets *powerdns.RRsets) error {
+	return dryRun(ctx, "records.patch", fmt.Sprintf("%s (%d RRsets)", makeCanonical(domain), len(rrSets.Sets)))
+}
+
+type dryRunZonesClient struct {
+	pdnsZonesClienter
+}
+
+func (c *dryRunZonesClient) Delete(ctx context.Context, domain string) error {
+	return dryRun(ctx, "zones.delete", makeCanonical(domain))
+}
+
+func (c *dryRunZonesClient) Change(ctx context.Context, domain string, zone *powerdns.Zone) error {
+	return dryRun(ctx, "zones.change", makeCanonical(domain))
+}
+
+func (c *dryRunZonesClient) Add(ctx context.Context, zone *powerdns.Zone) (*powerdns.Zone, error) {
+	return nil, dryRun(ctx, "zones.add", makeCanonical(*zone.Name))
+}
+
+func (c *dryRunZonesClient) AxfrRetrieve(ctx context.Context, domain string) (*powerdns.AxfrRetrieveResult, error) {
+	return nil, dryRun(ctx, "zones.axfr_retrieve", makeCanonical(domain))
+}
+
+func (c *dryRunZonesClient) Rectify(ctx context.Context, domain string) (*RectifyResult, error) {
+	return nil, dryRun(ctx, "zones.rectify", makeCanonical(domain))
+}
+
+func (c *dryRunZonesClient) Notify(ctx context.Context, domain string) (*powerdns.NotifyResult, error) {
+	return nil, dryRun(ctx, "zones.notify", makeCanonical(domain))
+}
+
+type dryRunCryptokeysClient struct {
+	pdnsCryptokeysClienter
+}
+
+func (c *dryRunCryptokeysClient) Add(ctx context.Context, domain string, cryptokey *powerdns.Cryptokey) (*powerdns.Cryptokey, error) {
+	return nil, dryRun(ctx, "cryptokeys.add", makeCanonical(domain))
+}
+
+func (c *dryRunCryptokeysClient) Change(ctx context.Context, domain string, id uint64, active bool) error {
+	return dryRun(ctx, "cryptokeys.change", fmt.Sprintf("%s %d", makeCanonical(domain), id))
+}
+
+func (c *dryRunCryptokeysClient) Delete(ctx context.Context, domain string, id uint64) error {
+	return dryRun(ctx, "cryptokeys.delete", fmt.Sprintf("%s %d", makeCanonical(domain), id))
+}
+
+type dryRunMetadataClient struct {
+	pdnsMetadataClienter
+}
+
+func (c *dryRunMetadataClient) Set(ctx context.Context, domain string, kind powerdns.MetadataKind, values []string) (*powerdns.Metadata, error) {
+	return nil, dryRun(ctx, "metadata.set", fmt.Sprintf("%s %s %v", makeCanonical(domain), kind, values))
+}
+
+func (c *dryRunMetadataClient) Delete(ctx context.Context, domain string, kind powerdns.MetadataKind) error {
+	return dryRun(ctx, "metadata.delete", fmt.Sprintf("%s %s", makeCanonical(domain), kind))
+}
+
+type dryRunTSIGKeysClient struct {
+	pdnsTSIGKeysClienter
+}
+
+func (c *dryRunTSIGKeysClient) Create(ctx context.Context, name, algorithm, key string) (*powerdns.TSIGKey, error) {
+	return nil, dryRun(ctx, "tsigkeys.create", name)
+}
+
+func (c *dryRunTSIGKeysClient) Change(ctx context.Context, id string, newKey powerdns.TSIGKey) (*powerdns.TSIGKey, error) {
+	return nil, dryRun(ctx, "tsigkeys.change", id)
+}
+
+func (c *dryRunTSIGKeysClient) Delete(ctx context.Context, id string) error {
+	return dryRun(ctx, "tsigkeys.delete", id)
+}
@@ -13,19 +13,29 @@ package controller
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
+	"net/netip"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/joeig/go-powerdns/v3"
 	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type pdnsRecordsClienter interface {
 	Delete(ctx context.Context, domain string, name string, recordType powerdns.RRType) error
 	Change(ctx context.Context, domain string, name string, recordType powerdns.RRType, ttl uint32, content []string, options ...func(*powerdns.RRset)) error
 	Get(ctx context.Context, domain, name string, recordType *powerdns.RRType) ([]powerdns.RRset, error)
+	Patch(ctx context.Context, domain string, rrSets *powerdns.RRsets) error
 }
 
 type pdnsZonesClienter interface {
@@ -33,44 +43,376 @@ type pdnsZonesClienter interface {
 	Delete(ctx context.Context, domain string) error
 	Change(ctx context.Context, domain string, zone *powerdns.Zone) error
 	Add(ctx context.Context, zone *powerdns.Zone) (*powerdns.Zone, error)
+	AxfrRetrieve(ctx context.Context, domain string) (*powerdns.AxfrRetrieveResult, error)
+	Notify(ctx context.Context, domain string) (*powerdns.NotifyResult, error)
+	Rectify(ctx context.Context, domain string) (*RectifyResult, error)
+	Export(ctx context.Context, domain string) (powerdns.Export, error)
+}
+
+type pdnsCryptokeysClienter interface {
+	List(ctx context.Context, domain string) ([]powerdns.Cryptokey, error)
+	Get(ctx context.Context, domain string, id uint64) (*powerdns.Cryptokey, error)
+	Add(ctx context.Context, domain string, cryptokey *powerdns.Cryptokey) (*powerdns.Cryptokey, error)
+	Change(ctx context.Context, domain string, id uint64, active bool) error
+	Delete(ctx context.Context, domain string, id uint64) error
+}
+
+type pdnsTSIGKeysClienter interface {
+	Get(ctx context.Context, id string) (*powerdns.TSIGKey, error)
+	Create(ctx context.Context, name, algorithm, key string) (*powerdns.TSIGKey, error)
+	Change(ctx context.Context, id string, newKey powerdns.TSIGKey) (*powerdns.TSIGKey, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type pdnsStatisticsClienter interface {
+	List(ctx context.Context) ([]powerdns.Statistic, error)
+}
+
+type pdnsMetadataClienter interface {
+	List(ctx context.Context, domain string) ([]powerdns.Metadata, error)
+	Get(ctx context.Context, domain string, kind powerdns.MetadataKind) (*powerdns.Metadata, error)
+	Set(ctx context.Context, domain string, kind powerdns.MetadataKind, values []string) (*powerdns.Metadata, error)
+	Delete(ctx context.Context, domain string, kind powerdns.MetadataKind) error
+}
+
+// reservedMetadataKinds are the metadata kinds managed through dedicated fields of the zone
+// or read-only in PowerDNS API
+var reservedMetadataKinds = []powerdns.MetadataKind{
+	powerdns.MetadataAlsoNotify,
+	powerdns.MetadataTSIGAllowAXFR,
+	powerdns.MetadataTSIGAllowDNSUpdate,
+	powerdns.MetadataNSEC3Param,
+	powerdns.MetadataSOAEditAPI,
+	powerdns.MetadataAPIRectify,
+	powerdns.MetadataPresigned,
+	powerdns.MetadataLuaAXFRScript,
+	"NSEC3NARROW",
+	OWNER_METADATA_KIND,
+}
+
+// SVCB_TYPE and HTTPS_TYPE are the record types with SvcParams, not defined by go-powerdns
+const (
+	SVCB_TYPE  = "SVCB"
+	HTTPS_TYPE = "HTTPS"
+)
+
+// LUA_TYPE is the type of the records dynamically generated by a Lua snippet, not defined by go-powerdns
+const LUA_TYPE = "LUA"
+
+// MAX_TXT_STRING_LENGTH is the maximum length in bytes of a character-string of a TXT record (RFC 1035)
+const MAX_TXT_STRING_LENGTH = 255
+
+// svcParamKeys are the numbers of the SvcParamKeys registered by IANA (RFC 9460)
+var svcParamKeys = map[string]int{
+	"mandatory":       0,
+	"alpn":            1,
+	"no-default-alpn": 2,
+	"port":            3,
+	"ipv4hint":        4,
+	"ech":             5,
+	"ipv6hint":        6,
+	"dohpath":         7,
+	"ohttp":           8,
 }
 
 type PdnsClienter struct {
-	Records pdnsRecordsClienter
-	Zones   pdnsZonesClienter
+	Records    pdnsRecordsClienter
+	Zones      pdnsZonesClienter
+	Cryptokeys pdnsCryptokeysClienter
+	Metadata   pdnsMetadataClienter
+	TSIGKeys   pdnsTSIGKeysClienter
+	Statistics pdnsStatisticsClienter
 }
 
-// zoneIsIdenticalToExternalZone return True, True if respectively kind, soa_edit_api and catalog are identical
+// zoneIsIdenticalToExternalZone return True, True if respectively kind, soa_edit_api, catalog, masters, dnssec, presigned and account (when managed) are identical
 // and nameservers are identical between Zone and External Resource
+// Nameservers of Slave zones are transferred from the masters, they are always considered identical
 func zoneIsIdenticalToExternalZone(zone dnsv1alpha2.GenericZone, externalZone *powerdns.Zone, ns []string) (bool, bool) {
+	mastersIdentical := slices.Equal(zone.GetSpec().Masters, externalZone.Masters)
+	dnssecIdentical := zone.GetSpec().DNSSEC == nil || *zone.GetSpec().DNSSEC == ptr.Deref(externalZone.DNSsec, false)
+	presignedIdentical := zone.GetSpec().Presigned == nil || *zone.GetSpec().Presigned == ptr.Deref(externalZone.Presigned, false)
 	zoneCatalog := makeCanonical(ptr.Deref(zone.GetSpec().Catalog, ""))
 	externalZoneCatalog := ptr.Deref(externalZone.Catalog, "")
-	zoneSOAEditAPI := ptr.Deref(zone.GetSpec().SOAEditAPI, "")
+	zoneSOAEditAPI := ptr.Deref(getSOAEditAPI(zone), "")
 	externalZoneSOAEditAPI := ptr.Deref(externalZone.SOAEditAPI, "")
-	return zone.GetSpec().Kind == string(*externalZone.Kind) && zoneCatalog == externalZoneCatalog && zoneSOAEditAPI == externalZoneSOAEditAPI, reflect.DeepEqual(zone.GetSpec().Nameservers, ns)
+	// The account is only compared when managed
+	accountIdentical := zone.GetSpec().Account == nil || *zone.GetSpec().Account == ptr.Deref(externalZone.Account, "")
+	return zone.GetSpec().Kind == string(*externalZone.Kind) && zoneCatalog == externalZoneCatalog && zoneSOAEditAPI == externalZoneSOAEditAPI && dnssecIdentical && presignedIdentical && mastersIdentical && accountIdentical,
+		!isNSManaged(zone) || reflect.DeepEqual(zone.GetSpec().Nameservers, ns)
 }
 
-// rrsetIsIdenticalToExternalRRset return True if Comments, Name, Type, TTL and Records are identical between RRSet and External Resource
-func rrsetIsIdenticalToExternalRRset(rrset dnsv1alpha2.GenericRRset, externalRecord powerdns.RRset) bool {
-	commentsIdentical := true
-	if len(externalRecord.Comments) != 0 {
-		if rrset.GetSpec().Comment != nil {
-			commentsIdentical = *rrset.GetSpec().Comment == *(externalRecord.Comments[0].Content)
-		} else {
-			commentsIdentical = false
+// getNameserverTTL returns the TTL of the NS records of the zone: the nameserverTTL of the zone if defined,
+// otherwise the TTL of the existing NS records (externalTTL), otherwise DEFAULT_TTL_FOR_NS_RECORDS
+func getNameserverTTL(zone dnsv1alpha2.GenericZone, externalTTL *uint32) uint32 {
+	if zone.GetSpec().NameserverTTL != nil {
+		return *zone.GetSpec().NameserverTTL
+	}
+	return ptr.Deref(externalTTL, DEFAULT_TTL_FOR_NS_RECORDS)
+}
+
+// getSOAContent returns the content of the SOA record with the parameters of soa applied to the current content
+// ("<mname> <rname> <serial> <refresh> <retry> <expire> <minimum>"), the serial is kept
+func getSOAContent(current string, soa *dnsv1alpha2.SOASpec) (string, error) {
+	fields := strings.Fields(current)
+	if len(fields) != 7 {
+		return "", fmt.Errorf("invalid SOA record %q", current)
+	}
+	if soa.MName != nil {
+		fields[0] = makeCanonical(*soa.MName)
+	}
+	if soa.RName != nil {
+		fields[1] = makeCanonical(*soa.RName)
+	}
+	for i, value := range []*uint32{soa.Refresh, soa.Retry, soa.Expire, soa.NegativeTTL} {
+		if value != nil {
+			fields[3+i] = strconv.FormatUint(uint64(*value), 10)
 		}
-	} else {
-		if rrset.GetSpec().Comment != nil {
-			commentsIdentical = false
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// isSerialManaged returns true if the SOA serial of the zone is set by the operator ("Increment" or "DateBased" serial strategy),
+// the serial of Slave and Consumer zones is transferred from their primary
+func isSerialManaged(zone dnsv1alpha2.GenericZone) bool {
+	strategy := ptr.Deref(zone.GetSpec().SerialStrategy, dnsv1alpha2.SERIAL_STRATEGY_DEFAULT)
+	return strategy != dnsv1alpha2.SERIAL_STRATEGY_DEFAULT && !isSlaveZone(zone) && zone.GetSpec().Kind != string(powerdns.ConsumerZoneKind)
+}
+
+// getSOAEditAPI returns the SOA-EDIT-API setting of a zone sent to PowerDNS, "DEFAULT" when omitted.
+// It is disabled when the serial is managed by the operator, PowerDNS would otherwise change it on each API call
+func getSOAEditAPI(zone dnsv1alpha2.GenericZone) *string {
+	if isSerialManaged(zone) {
+		return ptr.To("")
+	}
+	return ptr.To(ptr.Deref(zone.GetSpec().SOAEditAPI, dnsv1alpha2.SOA_EDIT_API_DEFAULT))
+}
+
+// getNextSerial returns the serial following current with the serial strategy:
+// current + 1 with "Increment", the first serial of the day ("YYYYMMDD01") with "DateBased", unless current already reached it
+func getNextSerial(strategy string, current uint32, now time.Time) uint32 {
+	if strategy == dnsv1alpha2.SERIAL_STRATEGY_DATE_BASED {
+		year, month, day := now.UTC().Date()
+		daily := uint32(year*1000000+int(month)*10000+day*100) + 1
+		if current < daily {
+			return daily
+		}
+	}
+	return current + 1
+}
+
+// getRecordsDigest returns a digest of the records of a zone, the serial of its apex SOA record is ignored
+func getRecordsDigest(zoneName string, rrsets []powerdns.RRset) string {
+	var lines []string
+	for _, rr := range rrsets {
+		if rr.Name == nil || rr.Type == nil {
+			continue
+		}
+		for _, r := range rr.Records {
+			content := ptr.Deref(r.Content, "")
+			if *rr.Type == powerdns.RRTypeSOA && strings.EqualFold(makeCanonical(*rr.Name), makeCanonical(zoneName)) {
+				if fields := strings.Fields(content); len(fields) == 7 {
+					fields[2] = ""
+					content = strings.Join(fields, " ")
+				}
+			}
+			lines = append(lines, fmt.Sprintf("%s %s %d %s %t", strings.ToLower(*rr.Name), *rr.Type, ptr.Deref(rr.TTL, 0), content, ptr.Deref(r.Disabled, false)))
 		}
 	}
+	slices.Sort(lines)
+	digest := sha1.Sum([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(digest[:])
+}
+
+// rrsetIsIdenticalToExternalRRset return True if Comments, Name, Type, TTL and Records are identical between RRSet and External Resource
+// ttl is the TTL resolved for the RRSet (see getRRsetTTL)
+func rrsetIsIdenticalToExternalRRset(rrset dnsv1alpha2.GenericRRset, ttl uint32, externalRecord powerdns.RRset) bool {
+	commentsIdentical := commentsAreIdentical(getRRsetComments(rrset), externalRecord.Comments)
 
 	externalRecordsSlice := make([]string, 0, len(externalRecord.Records))
 	for _, r := range externalRecord.Records {
 		externalRecordsSlice = append(externalRecordsSlice, *r.Content)
 	}
+	// Names are compared regardless of their case, PowerDNS returns them in lowercase
 	name := getRRsetName(rrset)
-	return name == *externalRecord.Name && rrset.GetSpec().Type == string(*externalRecord.Type) && rrset.GetSpec().TTL == *(externalRecord.TTL) && commentsIdentical && reflect.DeepEqual(rrset.GetSpec().Records, externalRecordsSlice)
+	externalDisabledRecords := make([]string, 0, len(externalRecord.Records))
+	for _, r := range externalRecord.Records {
+		if ptr.Deref(r.Disabled, false) {
+			externalDisabledRecords = append(externalDisabledRecords, *r.Content)
+		}
+	}
+	disabledIdentical := recordsAreIdentical(rrset.GetSpec().Type, getRRsetDisabledRecords(rrset), externalDisabledRecords)
+	return strings.EqualFold(name, *externalRecord.Name) && rrset.GetSpec().Type == string(*externalRecord.Type) && ttl == *(externalRecord.TTL) && commentsIdentical && disabledIdentical && recordsAreIdentical(rrset.GetSpec().Type, getRRsetRecords(rrset), externalRecordsSlice)
+}
+
+// getRRsetDisabledRecords returns the disabled records of a RRset, the ones which are not records of the RRset are ignored
+func getRRsetDisabledRecords(rrset dnsv1alpha2.GenericRRset) []string {
+	records := getRRsetRecords(rrset)
+	disabled := make([]string, 0, len(rrset.GetSpec().DisabledRecords))
+	for _, r := range rrset.GetSpec().DisabledRecords {
+		if strings.EqualFold(rrset.GetSpec().Type, string(powerdns.RRTypeTXT)) {
+			r = chunkTXTRecord(r)
+		}
+		if slices.Contains(records, r) && !slices.Contains(disabled, r) {
+			disabled = append(disabled, r)
+		}
+	}
+	return disabled
+}
+
+// getRRsetPdnsRecords returns the records of a RRset with their disabled and set-ptr flags
+func getRRsetPdnsRecords(rrset dnsv1alpha2.GenericRRset) []powerdns.Record {
+	disabled := getRRsetDisabledRecords(rrset)
+	setPTR := ptr.Deref(rrset.GetSpec().SetPTR, false)
+	records := getRRsetRecords(rrset)
+	result := make([]powerdns.Record, 0, len(records))
+	for _, r := range records {
+		result = append(result, powerdns.Record{Content: ptr.To(r), Disabled: ptr.To(slices.Contains(disabled, r)), SetPTR: ptr.To(setPTR)})
+	}
+	return result
+}
+
+// getRRsetComments returns the comments of a RRset, the legacy Comment field first
+// Comments without account are attributed to the operator
+func getRRsetComments(rrset dnsv1alpha2.GenericRRset) []powerdns.Comment {
+	spec := rrset.GetSpec()
+	comments := make([]powerdns.Comment, 0, len(spec.Comments)+1)
+	if spec.Comment != nil {
+		comments = append(comments, powerdns.Comment{Content: ptr.To(*spec.Comment), Account: ptr.To(OPERATOR_COMMENT_ACCOUNT)})
+	}
+	for _, c := range spec.Comments {
+		comments = append(comments, powerdns.Comment{Content: ptr.To(c.Content), Account: ptr.To(ptr.Deref(c.Account, OPERATOR_COMMENT_ACCOUNT))})
+	}
+	return comments
+}
+
+// commentsAreIdentical compares the content and account of the comments of a RRset with the ones of the External Resource
+func commentsAreIdentical(comments, externalComments []powerdns.Comment) bool {
+	return slices.EqualFunc(comments, externalComments, func(c, e powerdns.Comment) bool {
+		return ptr.Deref(c.Content, "") == ptr.Deref(e.Content, "") && ptr.Deref(c.Account, "") == ptr.Deref(e.Account, "")
+	})
+}
+
+// recordsAreIdentical compares the records of a RRset with the ones of the External Resource, regardless of their order
+// as PowerDNS returns the records of a RRset in its own order (e.g. round-robin A records)
+// ALIAS targets are hostnames, compared regardless of their case
+// SVCB and HTTPS params are compared regardless of their order, PowerDNS returns them ordered by key
+// TXT character-strings are compared once split into chunks of MAX_TXT_STRING_LENGTH bytes
+// CAA tags are compared regardless of their case and values regardless of their quoting
+func recordsAreIdentical(rrType string, records, externalRecords []string) bool {
+	normalize := func(records []string) []string {
+		result := make([]string, 0, len(records))
+		for _, r := range records {
+			switch {
+			case strings.EqualFold(rrType, string(powerdns.RRTypeALIAS)):
+				r = strings.ToLower(r)
+			case strings.EqualFold(rrType, string(powerdns.RRTypeTXT)):
+				r = chunkTXTRecord(r)
+			case isSVCBType(rrType):
+				r = canonicalSVCBRecord(r)
+			case strings.EqualFold(rrType, string(powerdns.RRTypeCAA)):
+				r = canonicalCAARecord(r)
+			}
+			result = append(result, r)
+		}
+		slices.Sort(result)
+		return result
+	}
+	return slices.Equal(normalize(records), normalize(externalRecords))
+}
+
+// isSlaveZone returns true if the zone content is transferred from masters
+func isSlaveZone(zone dnsv1alpha2.GenericZone) bool {
+	return zone.GetSpec().Kind == string(powerdns.SlaveZoneKind)
+}
+
+// isPresignedZone returns true if the zone is signed outside of PowerDNS
+func isPresignedZone(zone dnsv1alpha2.GenericZone) bool {
+	return ptr.Deref(zone.GetSpec().Presigned, false)
+}
+
+// getZoneSigning returns the dnssec and presigned settings of a zone sent to PowerDNS.
+// The dnssec setting of a presigned zone is left untouched, PowerDNS would generate keys for it
+// (PowerDNS reports a presigned zone as signed).
+func getZoneSigning(zone dnsv1alpha2.GenericZone) (*bool, *bool) {
+	if isPresignedZone(zone) {
+		return nil, ptr.To(true)
+	}
+	return zone.GetSpec().DNSSEC, zone.GetSpec().Presigned
+}
+
+// isNSManaged returns true if the apex NS records of the zone are managed by the operator from its nameservers
+func isNSManaged(zone dnsv1alpha2.GenericZone) bool {
+	return !isSlaveZone(zone) && ptr.Deref(zone.GetSpec().ManageNS, true)
+}
+
+// isCatalogZone returns true if the members of the zone are managed by the operator: a "Producer" ClusterZone
+func isCatalogZone(zone dnsv1alpha2.GenericZone) bool {
+	_, ok := zone.(*dnsv1alpha2.ClusterZone)
+	return ok && zone.GetSpec().Kind == string(powerdns.ProducerZoneKind)
+}
+
+// getCatalogMemberName returns the name of the member PTR record of a zone in a catalog (RFC 9432),
+// its unique ID is derived from the name of the member zone
+func getCatalogMemberName(catalog, member string) string {
+	id := sha1.Sum([]byte(strings.ToLower(makeCanonical(member)))) //nolint:gosec // Not used for security
+	return hex.EncodeToString(id[:]) + ".zones." + makeCanonical(catalog)
+}
+
+// isCnameConflict returns true if a RRset of type rrType named name breaks the CNAME rules of RFC 1034:
+// a CNAME at the zone apex, or a CNAME and another type (otherTypes) at the same name
+func isCnameConflict(rrType, name, zoneName string, otherTypes []string) bool {
+	isCname := strings.EqualFold(rrType, "CNAME")
+	if isCname && name == makeCanonical(zoneName) {
+		return true
+	}
+	for _, otherType := range otherTypes {
+		if isCname != strings.EqualFold(otherType, "CNAME") {
+			return true
+		}
+	}
+	return false
+}
+
+// metadataIsIdentical returns true if both metadata values contain the same entries, regardless of their order
+func metadataIsIdentical(a, b []string) bool {
+	return slices.Equal(slices.Sorted(slices.Values(a)), slices.Sorted(slices.Values(b)))
+}
+
+// isReservedMetadataKind returns true if the metadata kind is managed through a dedicated field of the zone
+// or is read-only in PowerDNS API, it cannot be managed through spec.metadata
+func isReservedMetadataKind(kind string) bool {
+	return slices.Contains(reservedMetadataKinds, powerdns.MetadataKind(kind))
+}
+
+// isFieldManagedMetadataKind returns true if the metadata kind is managed through an optional field set on the zone
+func isFieldManagedMetadataKind(gz dnsv1alpha2.GenericZone, kind string) bool {
+	return kind == string(powerdns.MetadataAllowAXFRFrom) && gz.GetSpec().AllowAXFRFrom != nil
+}
+
+// isOrphanedOnDelete returns true if the resource is kept in PowerDNS on deletion (orphan-on-delete annotation)
+func isOrphanedOnDelete(obj metav1.Object) bool {
+	return obj.GetAnnotations()[ORPHAN_ANNOTATION] == "true"
+}
+
+// deletesManagedRecords returns true if the RRsets managed by the operator are deleted from an orphaned zone
+// (delete-managed-records annotation)
+func deletesManagedRecords(obj metav1.Object) bool {
+	return isOrphanedOnDelete(obj) && obj.GetAnnotations()[DELETE_MANAGED_RECORDS_ANNOTATION] == "true"
+}
+
+// isPaused returns true if the reconciliation of the resource is paused (paused annotation)
+func isPaused(obj metav1.Object) bool {
+	return obj.GetAnnotations()[PAUSED_ANNOTATION] == "true"
+}
+
+// isPdnsNotFound returns true if err is a PowerDNS API error with a 404 status code
+func isPdnsNotFound(err error) bool {
+	return dnsv1alpha2.PDNSErrorStatusCode(err) == ZONE_NOT_FOUND_CODE
+}
+
+// isPdnsConflict returns true if err is a PowerDNS API error with a 409 status code
+func isPdnsConflict(err error) bool {
+	return dnsv1alpha2.PDNSErrorStatusCode(err) == ZONE_CONFLICT_CODE
 }
 
 func makeCanonical(in string) string {
@@ -87,3 +429,504 @@ func getRRsetName(rrset dnsv1alpha2.GenericRRset) string {
 	}
 	return makeCanonical(rrset.GetSpec().Name)
 }
+
+// newDefaultRRset returns the RRset (ClusterRRset for a ClusterZone) of a template of the zone spec.defaultRecords,
+// named after the zone, the name relative to the zone ("apex" for the zone apex) and the type, and labeled as a default record
+func newDefaultRRset(gz dnsv1alpha2.GenericZone, template dnsv1alpha2.RRsetTemplate) dnsv1alpha2.GenericRRset {
+	spec := dnsv1alpha2.RRsetSpec{
+		Type:    strings.ToUpper(template.Type),
+		Name:    template.Name,
+		TTL:     template.TTL,
+		Records: slices.Clone(template.Records),
+		Comment: template.Comment,
+	}
+	var rrset dnsv1alpha2.GenericRRset
+	switch gz.(type) {
+	case *dnsv1alpha2.ClusterZone:
+		spec.ZoneRef = dnsv1alpha2.ZoneRef{Name: gz.GetName(), Kind: "ClusterZone"}
+		rrset = &dnsv1alpha2.ClusterRRset{Spec: spec}
+	default:
+		spec.ZoneRef = dnsv1alpha2.ZoneRef{Name: gz.GetName(), Kind: "Zone"}
+		rrset = &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Namespace: gz.GetNamespace()}, Spec: spec}
+	}
+
+	label := "apex"
+	if fqdn, zone := getRRsetName(rrset), makeCanonical(gz.GetName()); fqdn != zone {
+		label = strings.TrimSuffix(strings.TrimSuffix(fqdn, "."+zone), ".")
+	}
+	suffix := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '-'
+	}, strings.ReplaceAll(strings.ToLower(label+"-"+spec.Type), "*", "wildcard"))
+	rrset.SetName(strings.ToLower(strings.TrimSuffix(gz.GetName(), ".")) + "-" + suffix)
+	rrset.SetLabels(map[string]string{DEFAULT_RECORD_LABEL: "true"})
+	return rrset
+}
+
+// getAppliedDefaultRecords returns the "<FQDN> <type>" of the templates of spec.defaultRecords already applied to the zone
+func getAppliedDefaultRecords(gz dnsv1alpha2.GenericZone) []string {
+	value := gz.GetAnnotations()[DEFAULT_RECORDS_ANNOTATION]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// filterRRset returns the RRset with the given name and type among the RRsets returned by Records.Get,
+// or an empty RRset (nil Name) when absent. The name is compared in its canonical form, the type case-insensitively.
+// Records.Get also returns the RRsets having comments at the same name, whatever their type, see
+// https://github.com/PowerDNS/pdns/issues/14539 and https://github.com/PowerDNS/pdns/pull/14045
+func filterRRset(rrsets []powerdns.RRset, name string, rrType powerdns.RRType) powerdns.RRset {
+	for _, rr := range rrsets {
+		if rr.Name == nil || rr.Type == nil {
+			continue
+		}
+		if strings.EqualFold(makeCanonical(*rr.Name), makeCanonical(name)) && strings.EqualFold(string(*rr.Type), string(rrType)) {
+			return rr
+		}
+	}
+	return powerdns.RRset{}
+}
+
+// getRRsetRecords returns the records of a RRset, MX, SRV, SVCB and CAA structured records are rendered in their presentation format
+func getRRsetRecords(rrset dnsv1alpha2.GenericRRset) []string {
+	spec := rrset.GetSpec()
+	switch {
+	case len(spec.MX) > 0:
+		records := make([]string, 0, len(spec.MX))
+		for _, mx := range spec.MX {
+			records = append(records, fmt.Sprintf("%d %s", mx.Preference, makeCanonical(mx.Exchange)))
+		}
+		return records
+	case len(spec.SRV) > 0:
+		records := make([]string, 0, len(spec.SRV))
+		for _, srv := range spec.SRV {
+			records = append(records, fmt.Sprintf("%d %d %d %s", srv.Priority, srv.Weight, srv.Port, makeCanonical(srv.Target)))
+		}
+		return records
+	case len(spec.SVCB) > 0:
+		records := make([]string, 0, len(spec.SVCB))
+		for _, svcb := range spec.SVCB {
+			params := make([]string, 0, len(svcb.Params))
+			for key, value := range svcb.Params {
+				if value == "" {
+					params = append(params, key)
+				} else {
+					params = append(params, key+"="+value)
+				}
+			}
+			records = append(records, formatSVCBRecord(svcb.Priority, svcb.Target, params))
+		}
+		return records
+	case len(spec.CAA) > 0:
+		records := make([]string, 0, len(spec.CAA))
+		for _, caa := range spec.CAA {
+			records = append(records, formatCAARecord(caa.Flag, caa.Tag, caa.Value))
+		}
+		return records
+	case strings.EqualFold(spec.Type, string(powerdns.RRTypeTXT)):
+		records := make([]string, 0, len(spec.Records))
+		for _, record := range spec.Records {
+			records = append(records, chunkTXTRecord(record))
+		}
+		return records
+	case strings.EqualFold(spec.Type, string(powerdns.RRTypeALIAS)):
+		// ALIAS targets are returned canonical by PowerDNS
+		records := make([]string, 0, len(spec.Records))
+		for _, record := range spec.Records {
+			records = append(records, makeCanonical(record))
+		}
+		return records
+	}
+	return spec.Records
+}
+
+// chunkTXTRecord splits the character-strings of a TXT record longer than MAX_TXT_STRING_LENGTH bytes
+// into consecutive quoted chunks (e.g. a DKIM key), escape sequences are never split.
+// The records which are not a list of quoted character-strings are returned as is.
+func chunkTXTRecord(record string) string {
+	var chunks []string
+	rest := strings.TrimSpace(record)
+	for rest != "" {
+		if rest[0] != '"' {
+			return record
+		}
+		var chunk strings.Builder
+		length := 0
+		i := 1
+		for i < len(rest) && rest[i] != '"' {
+			// A character is an escaped character (\" or \\), a decimal escape (\DDD) or a UTF-8 encoded character,
+			// only the latter is longer than a byte
+			var token string
+			size := 1
+			switch {
+			case rest[i] == '\\' && i+3 < len(rest) && isDigits(rest[i+1:i+4]):
+				token = rest[i : i+4]
+			case rest[i] == '\\' && i+1 < len(rest):
+				token = rest[i : i+2]
+			default:
+				_, size = utf8.DecodeRuneInString(rest[i:])
+				token = rest[i : i+size]
+			}
+			if length+size > MAX_TXT_STRING_LENGTH {
+				chunks = append(chunks, `"`+chunk.String()+`"`)
+				chunk.Reset()
+				length = 0
+			}
+			chunk.WriteString(token)
+			length += size
+			i += len(token)
+		}
+		if i >= len(rest) {
+			return record
+		}
+		chunks = append(chunks, `"`+chunk.String()+`"`)
+		rest = strings.TrimSpace(rest[i+1:])
+	}
+	return strings.Join(chunks, " ")
+}
+
+// isDigits returns true if s only contains decimal digits
+func isDigits(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
+}
+
+// isSVCBType returns true for the types sharing the SVCB format (RFC 9460)
+func isSVCBType(rrType string) bool {
+	return strings.EqualFold(rrType, SVCB_TYPE) || strings.EqualFold(rrType, HTTPS_TYPE)
+}
+
+// DUAL_STACK_TYPE is the pseudo-type of the RRsets holding both IPv4 and IPv6 addresses,
+// synchronized as an A and an AAAA RRsets in PowerDNS
+const DUAL_STACK_TYPE = "A_AAAA"
+
+// isDualStackType returns true for the RRsets holding both IPv4 and IPv6 addresses
+func isDualStackType(rrType string) bool {
+	return strings.EqualFold(rrType, DUAL_STACK_TYPE)
+}
+
+// getRRsetTypes returns the types of the PowerDNS RRsets of a RRset, A and AAAA for a dual-stack RRset
+func getRRsetTypes(rrset dnsv1alpha2.GenericRRset) []string {
+	if isDualStackType(rrset.GetSpec().Type) {
+		return []string{string(powerdns.RRTypeA), string(powerdns.RRTypeAAAA)}
+	}
+	return []string{rrset.GetSpec().Type}
+}
+
+// getRRsetEntries returns the "<FQDN>/<type>" DNS entries of a RRset, one per address family for a dual-stack RRset
+func getRRsetEntries(rrset dnsv1alpha2.GenericRRset) []string {
+	var entries []string
+	for _, rrType := range getRRsetTypes(rrset) {
+		entries = append(entries, getRRsetName(rrset)+"/"+rrType)
+	}
+	return entries
+}
+
+// splitDualStackRRset returns the A and AAAA RRsets of a dual-stack RRset, holding respectively its IPv4 and IPv6 addresses,
+// one of them may have no records
+func splitDualStackRRset(rrset dnsv1alpha2.GenericRRset) []dnsv1alpha2.GenericRRset {
+	var result []dnsv1alpha2.GenericRRset
+	for _, rrType := range getRRsetTypes(rrset) {
+		family := rrset.Copy()
+		spec := family.GetSpec()
+		spec.Type = rrType
+		spec.Records = slices.DeleteFunc(slices.Clone(spec.Records), func(r string) bool {
+			addr, err := netip.ParseAddr(strings.TrimSpace(r))
+			return (err == nil && addr.Is4()) != (rrType == string(powerdns.RRTypeA))
+		})
+		result = append(result, family)
+	}
+	return result
+}
+
+// isLuaType returns true for the LUA records
+func isLuaType(rrType string) bool {
+	return strings.EqualFold(rrType, LUA_TYPE)
+}
+
+// getLuaEmbeddedTypes returns the sorted types of the records generated by LUA records ("<type> <snippet>")
+func getLuaEmbeddedTypes(records []string) []string {
+	var types []string
+	for _, record := range records {
+		if fields := strings.Fields(record); len(fields) > 0 {
+			types = append(types, strings.ToUpper(fields[0]))
+		}
+	}
+	slices.Sort(types)
+	return slices.Compact(types)
+}
+
+// svcParamKeyNumber returns the number of a SvcParamKey, given by name or in the generic "keyNNNNN" form
+// Unknown keys are ordered last
+func svcParamKeyNumber(key string) int {
+	if n, ok := svcParamKeys[key]; ok {
+		return n
+	}
+	if n, err := strconv.Atoi(strings.TrimPrefix(key, "key")); err == nil && strings.HasPrefix(key, "key") {
+		return n
+	}
+	return 1 << 16
+}
+
+// formatSVCBRecord renders a SVCB or HTTPS record with a canonical target and its params ordered by key number,
+// as PowerDNS returns them
+func formatSVCBRecord(priority uint16, target string, params []string) string {
+	slices.SortFunc(params, func(a, b string) int {
+		keyA, _, _ := strings.Cut(a, "=")
+		keyB, _, _ := strings.Cut(b, "=")
+		if n := svcParamKeyNumber(keyA) - svcParamKeyNumber(keyB); n != 0 {
+			return n
+		}
+		return strings.Compare(a, b)
+	})
+	return strings.Join(append([]string{strconv.Itoa(int(priority)), makeCanonical(target)}, params...), " ")
+}
+
+// canonicalSVCBRecord returns a SVCB or HTTPS record in the format of formatSVCBRecord,
+// with lowercase keys and unquoted values, the records which cannot be parsed are returned as is
+func canonicalSVCBRecord(record string) string {
+	fields := strings.Fields(record)
+	if len(fields) < 2 {
+		return record
+	}
+	priority, err := strconv.ParseUint(fields[0], 10, 16)
+	if err != nil {
+		return record
+	}
+	params := make([]string, 0, len(fields)-2)
+	for _, param := range fields[2:] {
+		key, value, hasValue := strings.Cut(param, "=")
+		key = strings.ToLower(key)
+		if hasValue {
+			params = append(params, key+"="+strings.Trim(value, `"`))
+		} else {
+			params = append(params, key)
+		}
+	}
+	return formatSVCBRecord(uint16(priority), strings.ToLower(fields[1]), params)
+}
+
+// formatCAARecord renders a CAA record with its value quoted, as PowerDNS returns it
+func formatCAARecord(flag uint8, tag, value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+	return fmt.Sprintf("%d %s \"%s\"", flag, strings.ToLower(tag), value)
+}
+
+// canonicalCAARecord returns a CAA record in the format of formatCAARecord, its value being quoted or not,
+// the records which cannot be parsed are returned as is
+func canonicalCAARecord(record string) string {
+	flag, rest, _ := strings.Cut(strings.TrimSpace(record), " ")
+	tag, value, _ := strings.Cut(strings.TrimSpace(rest), " ")
+	value = strings.TrimSpace(value)
+	n, err := strconv.ParseUint(flag, 10, 8)
+	if err != nil || tag == "" || value == "" {
+		return record
+	}
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		value = strings.NewReplacer(`\\`, `\`, `\"`, `"`).Replace(value[1 : len(value)-1])
+	}
+	return formatCAARecord(uint8(n), tag, value)
+}
+
+// DEFAULT_TTL_ANY_TYPE is the key of the default TTL applied to the record types absent from the default TTLs by type
+const DEFAULT_TTL_ANY_TYPE = "*"
+
+// getRRsetTTL resolves the TTL of a RRset, a TTL of 0 inherits with the following precedence:
+// Zone default TTL, default TTL of the record type, operator default TTL ("*" type), DEFAULT_TTL_FOR_RRSETS
+func getRRsetTTL(zone dnsv1alpha2.GenericZone, rrset dnsv1alpha2.GenericRRset, defaultTTLByType map[string]uint32) uint32 {
+	if rrset.GetSpec().TTL != 0 {
+		return rrset.GetSpec().TTL
+	}
+	if zone.GetSpec().DefaultTTL != nil {
+		return *zone.GetSpec().DefaultTTL
+	}
+	if ttl, ok := defaultTTLByType[strings.ToUpper(rrset.GetSpec().Type)]; ok {
+		return ttl
+	}
+	if ttl, ok := defaultTTLByType[DEFAULT_TTL_ANY_TYPE]; ok {
+		return ttl
+	}
+	return DEFAULT_TTL_FOR_RRSETS
+}
+
+// ParseTTLByType parses a comma-separated list of TYPE=TTL pairs (e.g. "A=60,NS=86400,*=300"),
+// the "*" type is the default TTL of the other types
+func ParseTTLByType(value string) (map[string]uint32, error) {
+	result := map[string]uint32{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		rrType, ttlStr, found := strings.Cut(pair, "=")
+		rrType = strings.ToUpper(strings.TrimSpace(rrType))
+		if !found || rrType == "" {
+			return nil, fmt.Errorf("invalid TTL definition %q, expected TYPE=TTL", pair)
+		}
+		ttl, err := strconv.ParseUint(strings.TrimSpace(ttlStr), 10, 32)
+		if err != nil || ttl == 0 {
+			return nil, fmt.Errorf("invalid TTL for type %s: %q", rrType, ttlStr)
+		}
+		result[rrType] = uint32(ttl)
+	}
+	return result, nil
+}
+
+// getManagedRecords returns the sorted "<FQDN> <type>" of the RRsets managed by the operator,
+// the RRsets being deleted and the overridden ClusterRRsets are excluded, duplicated RRsets are listed once
+func getManagedRecords(rrsets []dnsv1alpha2.GenericRRset) []string {
+	var records []string
+	for _, rrset := range rrsets {
+		if !rrset.GetDeletionTimestamp().IsZero() || isOverridden(rrset) {
+			continue
+		}
+		for _, rrType := range getRRsetTypes(rrset) {
+			records = append(records, getRRsetName(rrset)+" "+strings.ToUpper(rrType))
+		}
+	}
+	slices.Sort(records)
+	return slices.Compact(records)
+}
+
+// findTTLInconsistencies returns, for each RRset type, the distinct TTLs found when RRsets of this type do not share the same TTL,
+// the TTLs are resolved as in PowerDNS (see getRRsetTTL)
+func findTTLInconsistencies(zone dnsv1alpha2.GenericZone, rrsets []dnsv1alpha2.GenericRRset, defaultTTLByType map[string]uint32) []dnsv1alpha2.TTLInconsistency {
+	ttlsByType := map[string][]uint32{}
+	for _, rrset := range rrsets {
+		rrType := rrset.GetSpec().Type
+		ttl := getRRsetTTL(zone, rrset, defaultTTLByType)
+		if !slices.Contains(ttlsByType[rrType], ttl) {
+			ttlsByType[rrType] = append(ttlsByType[rrType], ttl)
+		}
+	}
+
+	var result []dnsv1alpha2.TTLInconsistency
+	for rrType, ttls := range ttlsByType {
+		if len(ttls) > 1 {
+			slices.Sort(ttls)
+			result = append(result, dnsv1alpha2.TTLInconsistency{Type: rrType, TTLs: ttls})
+		}
+	}
+	slices.SortFunc(result, func(a, b dnsv1alpha2.TTLInconsistency) int {
+		return strings.Compare(a.Type, b.Type)
+	})
+	return result
+}
+
+// isZoneAllowed returns true if the zone is one of the allowed suffixes or one of their subdomains, any zone is allowed without suffix
+func isZoneAllowed(zoneName string, allowedSuffixes []string) bool {
+	if len(allowedSuffixes) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(allowedSuffixes, func(suffix string) bool {
+		return isInBailiwick(zoneName, suffix)
+	})
+}
+
+// isInBailiwick returns true if the nameserver is the zone itself or one of its subdomains
+func isInBailiwick(nameserver, zone string) bool {
+	ns := strings.ToLower(makeCanonical(nameserver))
+	z := strings.ToLower(makeCanonical(zone))
+	return ns == z || strings.HasSuffix(ns, "."+z)
+}
+
+// reverseName returns the name of the PTR record of an IP address, in in-addr.arpa or ip6.arpa
+func reverseName(addr netip.Addr) string {
+	addr = addr.Unmap()
+	var labels []string
+	if addr.Is4() {
+		ip := addr.As4()
+		for i := len(ip) - 1; i >= 0; i-- {
+			labels = append(labels, strconv.Itoa(int(ip[i])))
+		}
+		return strings.Join(labels, ".") + ".in-addr.arpa."
+	}
+	ip := addr.As16()
+	for i := len(ip) - 1; i >= 0; i-- {
+		labels = append(labels, strconv.FormatUint(uint64(ip[i]&0x0f), 16), strconv.FormatUint(uint64(ip[i]>>4), 16))
+	}
+	return strings.Join(labels, ".") + ".ip6.arpa."
+}
+
+// findReverseZone returns the most specific available zone the PTR record name belongs to, nil if there is none
+func findReverseZone(name string, zones []dnsv1alpha2.GenericZone) dnsv1alpha2.GenericZone {
+	var result dnsv1alpha2.GenericZone
+	for _, zone := range zones {
+		if !zone.GetDeletionTimestamp().IsZero() || ptr.Deref(zone.GetStatus().SyncStatus, "") == dnsv1alpha2.FAILED_STATUS {
+			continue
+		}
+		if !isInBailiwick(name, zone.GetName()) {
+			continue
+		}
+		if result == nil || len(zone.GetName()) > len(result.GetName()) {
+			result = zone
+		}
+	}
+	return result
+}
+
+// getGlueRecords splits the glue addresses of a nameserver into A and AAAA records contents
+func getGlueRecords(addresses []string) (map[powerdns.RRType][]string, error) {
+	result := map[powerdns.RRType][]string{}
+	for _, address := range addresses {
+		ip, err := netip.ParseAddr(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid glue address %q: %w", address, err)
+		}
+		if ip.Is4() {
+			result[powerdns.RRTypeA] = append(result[powerdns.RRTypeA], ip.String())
+		} else {
+			result[powerdns.RRTypeAAAA] = append(result[powerdns.RRTypeAAAA], ip.String())
+		}
+	}
+	return result, nil
+}
+
+// validateNameserverGlue ensures glue is only defined for valid addresses of in-bailiwick nameservers of the zone
+func validateNameserverGlue(zone dnsv1alpha2.GenericZone) error {
+	for nameserver, addresses := range zone.GetSpec().NameserverGlue {
+		if !slices.ContainsFunc(zone.GetSpec().Nameservers, func(ns string) bool {
+			return makeCanonical(ns) == makeCanonical(nameserver)
+		}) {
+			return fmt.Errorf("glue defined for %s which is not a nameserver of the zone", nameserver)
+		}
+		if !isInBailiwick(nameserver, zone.GetObjectMeta().Name) {
+			return fmt.Errorf("glue defined for %s which is out of bailiwick of zone %s", nameserver, zone.GetObjectMeta().Name)
+		}
+		if len(addresses) == 0 {
+			return fmt.Errorf("no glue address defined for %s", nameserver)
+		}
+		if _, err := getGlueRecords(addresses); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTSIGKeyRefKey returns the key of a TSIGKey referenced by the zone: a name in the namespace of a Zone,
+// "<namespace>/<name>" for a ClusterZone
+func getTSIGKeyRefKey(gz dnsv1alpha2.GenericZone, ref string) (client.ObjectKey, error) {
+	if _, ok := gz.(*dnsv1alpha2.Zone); ok {
+		return client.ObjectKey{Namespace: gz.GetNamespace(), Name: ref}, nil
+	}
+	namespace, name, found := strings.Cut(ref, "/")
+	if !found || namespace == "" || name == "" {
+		return client.ObjectKey{}, fmt.Errorf("invalid TSIGKey reference %q, expected <namespace>/<name>", ref)
+	}
+	return client.ObjectKey{Namespace: namespace, Name: name}, nil
+}
+
+// getTSIGKeyPDNSNames returns the names in PowerDNS of the TSIGKeys referenced by the zone
+func getTSIGKeyPDNSNames(gz dnsv1alpha2.GenericZone, refs []string) ([]string, error) {
+	var names []string
+	for _, ref := range refs {
+		key, err := getTSIGKeyRefKey(gz, ref)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, dnsv1alpha2.TSIGKeyPDNSName(key.Namespace, key.Name))
+	}
+	return names, nil
+}
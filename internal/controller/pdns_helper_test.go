@@ -12,9 +12,14 @@
 package controller
 
 import (
+	"net/netip"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/joeig/go-powerdns/v3"
 	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -168,6 +173,114 @@ func TestZoneIsIdenticalToExternalZone(t *testing.T) {
 			false,
 			true,
 		},
+		{
+			"Different Slave Zones on masters",
+			&dnsv1alpha2.Zone{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: dnsv1alpha2.ZoneSpec{
+					Kind:       SLAVE_KIND_ZONE,
+					Masters:    []string{"192.0.2.1", "192.0.2.2"},
+					Catalog:    &catalog,
+					SOAEditAPI: &soaEditApi,
+				},
+			},
+			&powerdns.Zone{
+				ID:         &name,
+				Name:       &name,
+				Kind:       powerdns.ZoneKindPtr(SLAVE_KIND_ZONE),
+				Masters:    []string{"192.0.2.1"},
+				Catalog:    &catalog,
+				SOAEditAPI: &soaEditApi,
+			},
+			nameservers1,
+			false,
+			true,
+		},
+		{
+			"Different Zones on account",
+			&dnsv1alpha2.Zone{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: dnsv1alpha2.ZoneSpec{
+					Kind:        MASTER_KIND_ZONE,
+					Nameservers: nameservers,
+					Catalog:     &catalog,
+					SOAEditAPI:  &soaEditApi,
+					Account:     ptr.To("tenant-1"),
+				},
+			},
+			&powerdns.Zone{
+				ID:         &name,
+				Name:       &name,
+				Kind:       &kind,
+				Catalog:    &catalog,
+				SOAEditAPI: &soaEditApi,
+				Account:    ptr.To(""),
+			},
+			nameservers,
+			false,
+			true,
+		},
+		{
+			"Unmanaged account",
+			&dnsv1alpha2.Zone{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: dnsv1alpha2.ZoneSpec{
+					Kind:        MASTER_KIND_ZONE,
+					Nameservers: nameservers,
+					Catalog:     &catalog,
+					SOAEditAPI:  &soaEditApi,
+				},
+			},
+			&powerdns.Zone{
+				ID:         &name,
+				Name:       &name,
+				Kind:       &kind,
+				Catalog:    &catalog,
+				SOAEditAPI: &soaEditApi,
+				Account:    ptr.To("tenant-1"),
+			},
+			nameservers,
+			true,
+			true,
+		},
+		{
+			"Different Zones on presigned",
+			&dnsv1alpha2.Zone{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: dnsv1alpha2.ZoneSpec{
+					Kind:        MASTER_KIND_ZONE,
+					Nameservers: nameservers,
+					Catalog:     &catalog,
+					SOAEditAPI:  &soaEditApi,
+					DNSSEC:      ptr.To(true),
+					Presigned:   ptr.To(true),
+				},
+			},
+			&powerdns.Zone{
+				ID:         &name,
+				Name:       &name,
+				Kind:       &kind,
+				Catalog:    &catalog,
+				SOAEditAPI: &soaEditApi,
+				DNSsec:     ptr.To(true),
+				Presigned:  ptr.To(false),
+			},
+			nameservers,
+			false,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -246,6 +359,7 @@ func TestRrsetIsIdenticalToExternalRRset(t *testing.T) {
 				Comments: []powerdns.Comment{
 					{
 						Content: &recordComment1,
+						Account: ptr.To(OPERATOR_COMMENT_ACCOUNT),
 					},
 				},
 			},
@@ -289,6 +403,7 @@ func TestRrsetIsIdenticalToExternalRRset(t *testing.T) {
 				Comments: []powerdns.Comment{
 					{
 						Content: &recordComment2,
+						Account: ptr.To(OPERATOR_COMMENT_ACCOUNT),
 					},
 				},
 			},
@@ -327,6 +442,7 @@ func TestRrsetIsIdenticalToExternalRRset(t *testing.T) {
 				Comments: []powerdns.Comment{
 					{
 						Content: &recordComment1,
+						Account: ptr.To(OPERATOR_COMMENT_ACCOUNT),
 					},
 				},
 			},
@@ -370,6 +486,7 @@ func TestRrsetIsIdenticalToExternalRRset(t *testing.T) {
 				Comments: []powerdns.Comment{
 					{
 						Content: &recordComment1,
+						Account: ptr.To(OPERATOR_COMMENT_ACCOUNT),
 					},
 				},
 			},
@@ -413,6 +530,152 @@ func TestRrsetIsIdenticalToExternalRRset(t *testing.T) {
 				Comments: []powerdns.Comment{
 					{
 						Content: &recordComment1,
+						Account: ptr.To(OPERATOR_COMMENT_ACCOUNT),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"Identical ALIAS RRsets with non canonical target",
+			&dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: dnsv1alpha2.RRsetSpec{
+					Name:    recordName,
+					Type:    "ALIAS",
+					TTL:     recordTtl1,
+					Records: []string{"Target.Example.org"},
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneName,
+						Kind: "Zone",
+					},
+				},
+			},
+			&powerdns.RRset{
+				Name: &fqdnName,
+				Type: ptr.To(powerdns.RRTypeALIAS),
+				TTL:  &recordTtl1,
+				Records: []powerdns.Record{
+					{
+						Content:  ptr.To("target.example.org."),
+						Disabled: ptr.To(false),
+						SetPTR:   ptr.To(false),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"Identical wildcard RRsets",
+			&dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: dnsv1alpha2.RRsetSpec{
+					Name:    "*.Sub",
+					Type:    recordType1,
+					TTL:     recordTtl1,
+					Records: records,
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneName,
+						Kind: "Zone",
+					},
+				},
+			},
+			&powerdns.RRset{
+				Name: ptr.To("*.sub." + zoneName + "."),
+				Type: (*powerdns.RRType)(&recordType1),
+				TTL:  &recordTtl1,
+				Records: []powerdns.Record{
+					{
+						Content:  &recordContent1,
+						Disabled: ptr.To(false),
+						SetPTR:   ptr.To(false),
+					},
+					{
+						Content:  &recordContent2,
+						Disabled: ptr.To(false),
+						SetPTR:   ptr.To(false),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"Identical RRsets with disabled record",
+			&dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: dnsv1alpha2.RRsetSpec{
+					Name:            recordName,
+					Type:            recordType1,
+					TTL:             recordTtl1,
+					Records:         records,
+					DisabledRecords: []string{recordContent2},
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneName,
+						Kind: "Zone",
+					},
+				},
+			},
+			&powerdns.RRset{
+				Name: &fqdnName,
+				Type: (*powerdns.RRType)(&recordType1),
+				TTL:  &recordTtl1,
+				Records: []powerdns.Record{
+					{
+						Content:  &recordContent1,
+						Disabled: ptr.To(false),
+						SetPTR:   ptr.To(false),
+					},
+					{
+						Content:  &recordContent2,
+						Disabled: ptr.To(true),
+						SetPTR:   ptr.To(false),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"Different RRsets on disabled record",
+			&dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: dnsv1alpha2.RRsetSpec{
+					Name:            recordName,
+					Type:            recordType1,
+					TTL:             recordTtl1,
+					Records:         records,
+					DisabledRecords: []string{recordContent2},
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneName,
+						Kind: "Zone",
+					},
+				},
+			},
+			&powerdns.RRset{
+				Name: &fqdnName,
+				Type: (*powerdns.RRType)(&recordType1),
+				TTL:  &recordTtl1,
+				Records: []powerdns.Record{
+					{
+						Content:  &recordContent1,
+						Disabled: ptr.To(false),
+						SetPTR:   ptr.To(false),
+					},
+					{
+						Content:  &recordContent2,
+						Disabled: ptr.To(false),
+						SetPTR:   ptr.To(false),
 					},
 				},
 			},
@@ -422,7 +685,7 @@ func TestRrsetIsIdenticalToExternalRRset(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.description, func(t *testing.T) {
-			ns := rrsetIsIdenticalToExternalRRset(tc.rrset, *tc.externalRrset)
+			ns := rrsetIsIdenticalToExternalRRset(tc.rrset, tc.rrset.GetSpec().TTL, *tc.externalRrset)
 			if !cmp.Equal(ns, tc.rrsetsIdentical) {
 				t.Errorf("got %v, want %v", ns, tc.rrsetsIdentical)
 			}
@@ -430,6 +693,55 @@ func TestRrsetIsIdenticalToExternalRRset(t *testing.T) {
 	}
 }
 
+func TestRrsetIsIdenticalToExternalRRsetRecordsOrder(t *testing.T) {
+	var (
+		zoneName   = "example.org"
+		recordName = "www"
+		fqdnName   = recordName + "." + zoneName + "."
+		recordType = "A"
+		recordTtl  = uint32(300)
+		records    = []string{"192.0.2.1", "192.0.2.2", "192.0.2.3", "192.0.2.4"}
+	)
+	rrset := &dnsv1alpha2.RRset{
+		ObjectMeta: metav1.ObjectMeta{Name: "www.example.org", Namespace: "example"},
+		Spec: dnsv1alpha2.RRsetSpec{
+			Name:    recordName,
+			Type:    recordType,
+			TTL:     recordTtl,
+			Records: records,
+			ZoneRef: dnsv1alpha2.ZoneRef{Name: zoneName, Kind: "Zone"},
+		},
+	}
+	externalRRset := func(contents ...string) powerdns.RRset {
+		result := powerdns.RRset{Name: &fqdnName, Type: (*powerdns.RRType)(&recordType), TTL: &recordTtl}
+		for _, c := range contents {
+			result.Records = append(result.Records, powerdns.Record{Content: ptr.To(c), Disabled: ptr.To(false), SetPTR: ptr.To(false)})
+		}
+		return result
+	}
+
+	var testCases = []struct {
+		description     string
+		externalRrset   powerdns.RRset
+		rrsetsIdentical bool
+	}{
+		{"Same order", externalRRset("192.0.2.1", "192.0.2.2", "192.0.2.3", "192.0.2.4"), true},
+		{"Records reordered by PowerDNS", externalRRset("192.0.2.3", "192.0.2.1", "192.0.2.4", "192.0.2.2"), true},
+		{"Different record", externalRRset("192.0.2.3", "192.0.2.1", "192.0.2.5", "192.0.2.2"), false},
+		{"Missing record", externalRRset("192.0.2.3", "192.0.2.1", "192.0.2.2"), false},
+		{"Duplicated record", externalRRset("192.0.2.3", "192.0.2.1", "192.0.2.4", "192.0.2.2", "192.0.2.2"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := rrsetIsIdenticalToExternalRRset(rrset, recordTtl, tc.externalRrset)
+			if result != tc.rrsetsIdentical {
+				t.Errorf("got %v, want %v", result, tc.rrsetsIdentical)
+			}
+		})
+	}
+}
+
 func TestMakeCanonical(t *testing.T) {
 	var testCases = []struct {
 		description string
@@ -519,13 +831,932 @@ func TestGetRRsetName(t *testing.T) {
 			},
 			"test.example.org.",
 		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.description, func(t *testing.T) {
-			fqdn := getRRsetName(tc.entry)
-			if !cmp.Equal(fqdn, tc.want) {
-				t.Errorf("got %v, want %v", fqdn, tc.want)
+		{
+			"Wildcard entry",
+			&dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: dnsv1alpha2.RRsetSpec{
+					Name:    "*",
+					Type:    recordType,
+					TTL:     recordTtl,
+					Records: records,
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneName,
+						Kind: "Zone",
+					},
+				},
+			},
+			"*.example.org.",
+		},
+		{
+			"Wildcard FQDN entry",
+			&dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: dnsv1alpha2.RRsetSpec{
+					Name:    "*.sub.example.org.",
+					Type:    recordType,
+					TTL:     recordTtl,
+					Records: records,
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneName,
+						Kind: "Zone",
+					},
+				},
+			},
+			"*.sub.example.org.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			fqdn := getRRsetName(tc.entry)
+			if !cmp.Equal(fqdn, tc.want) {
+				t.Errorf("got %v, want %v", fqdn, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewDefaultRRset(t *testing.T) {
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: "example"}}
+	clusterZone := &dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: "example.org"}}
+	var testCases = []struct {
+		description       string
+		zone              dnsv1alpha2.GenericZone
+		template          dnsv1alpha2.RRsetTemplate
+		expectedName      string
+		expectedNamespace string
+		expectedZoneRef   dnsv1alpha2.ZoneRef
+	}{
+		{"Relative name", zone, dnsv1alpha2.RRsetTemplate{Type: "a", Name: "www"}, "example.org-www-a", "example", dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"}},
+		{"Zone apex", zone, dnsv1alpha2.RRsetTemplate{Type: "MX", Name: "example.org."}, "example.org-apex-mx", "example", dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"}},
+		{"Fully qualified name", zone, dnsv1alpha2.RRsetTemplate{Type: "A", Name: "mail.example.org."}, "example.org-mail-a", "example", dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"}},
+		{"Wildcard name", zone, dnsv1alpha2.RRsetTemplate{Type: "A", Name: "*.sub"}, "example.org-wildcard-sub-a", "example", dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"}},
+		{"ClusterZone", clusterZone, dnsv1alpha2.RRsetTemplate{Type: "TXT", Name: "_dmarc"}, "example.org--dmarc-txt", "", dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "ClusterZone"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := newDefaultRRset(tc.zone, tc.template)
+			if result.GetName() != tc.expectedName || result.GetNamespace() != tc.expectedNamespace {
+				t.Errorf("got %s/%s, want %s/%s", result.GetNamespace(), result.GetName(), tc.expectedNamespace, tc.expectedName)
+			}
+			if !cmp.Equal(result.GetSpec().ZoneRef, tc.expectedZoneRef) {
+				t.Errorf("got %v, want %v", result.GetSpec().ZoneRef, tc.expectedZoneRef)
+			}
+		})
+	}
+}
+
+func TestSplitDualStackRRset(t *testing.T) {
+	var testCases = []struct {
+		description     string
+		rrType          string
+		records         []string
+		expectedTypes   []string
+		expectedRecords [][]string
+	}{
+		{"Dual-stack", "A_AAAA", []string{"2001:db8::1", "192.0.2.1", "192.0.2.2"}, []string{"A", "AAAA"}, [][]string{{"192.0.2.1", "192.0.2.2"}, {"2001:db8::1"}}},
+		{"IPv4 only", "A_AAAA", []string{"192.0.2.1"}, []string{"A", "AAAA"}, [][]string{{"192.0.2.1"}, {}}},
+		{"Single type", "A", []string{"192.0.2.1"}, []string{"A"}, [][]string{{"192.0.2.1"}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			rrset := &dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "example"},
+				Spec: dnsv1alpha2.RRsetSpec{
+					Type:    tc.rrType,
+					Name:    "www",
+					TTL:     300,
+					Records: tc.records,
+					ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"},
+				},
+			}
+			result := splitDualStackRRset(rrset)
+			if len(result) != len(tc.expectedTypes) {
+				t.Fatalf("got %d RRsets, want %d", len(result), len(tc.expectedTypes))
+			}
+			for i, family := range result {
+				if family.GetSpec().Type != tc.expectedTypes[i] {
+					t.Errorf("got type %s, want %s", family.GetSpec().Type, tc.expectedTypes[i])
+				}
+				if !cmp.Equal(family.GetSpec().Records, tc.expectedRecords[i], cmpopts.EquateEmpty()) {
+					t.Errorf("got records %v, want %v", family.GetSpec().Records, tc.expectedRecords[i])
+				}
+			}
+			if rrset.Spec.Type != tc.rrType || len(rrset.Spec.Records) != len(tc.records) {
+				t.Errorf("the RRset has been modified: %v", rrset.Spec)
+			}
+			expectedEntries := []string{}
+			for _, rrType := range tc.expectedTypes {
+				expectedEntries = append(expectedEntries, "www.example.org./"+rrType)
+			}
+			if entries := getRRsetEntries(rrset); !cmp.Equal(entries, expectedEntries) {
+				t.Errorf("got entries %v, want %v", entries, expectedEntries)
+			}
+		})
+	}
+}
+
+func TestGetManagedRecords(t *testing.T) {
+	newRRset := func(name, rrType string) *dnsv1alpha2.RRset {
+		return &dnsv1alpha2.RRset{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "example"},
+			Spec:       dnsv1alpha2.RRsetSpec{Name: name, Type: rrType, ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"}},
+		}
+	}
+	deleted := newRRset("old", "A")
+	deleted.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	overridden := &dnsv1alpha2.ClusterRRset{
+		Spec:   dnsv1alpha2.RRsetSpec{Name: "api", Type: "A", ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "ClusterZone"}},
+		Status: dnsv1alpha2.RRsetStatus{Conditions: []metav1.Condition{{Type: "Available", Status: metav1.ConditionFalse, Reason: dnsv1alpha2.OVERRIDDEN_REASON}}},
+	}
+
+	var testCases = []struct {
+		description string
+		rrsets      []dnsv1alpha2.GenericRRset
+		want        []string
+	}{
+		{"No RRset", nil, nil},
+		{
+			"Sorted RRsets",
+			[]dnsv1alpha2.GenericRRset{newRRset("www", "A"), newRRset("mail", "mx"), newRRset("www", "AAAA")},
+			[]string{"mail.example.org. MX", "www.example.org. A", "www.example.org. AAAA"},
+		},
+		{
+			"Duplicated RRsets listed once",
+			[]dnsv1alpha2.GenericRRset{newRRset("www", "A"), newRRset("www.example.org.", "A")},
+			[]string{"www.example.org. A"},
+		},
+		{
+			"Deleted and overridden RRsets excluded",
+			[]dnsv1alpha2.GenericRRset{newRRset("www", "A"), deleted, overridden},
+			[]string{"www.example.org. A"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			got := getManagedRecords(tc.rrsets)
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFindTTLInconsistencies(t *testing.T) {
+	newRRset := func(name, rrType string, ttl uint32) dnsv1alpha2.GenericRRset {
+		return &dnsv1alpha2.RRset{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "example",
+			},
+			Spec: dnsv1alpha2.RRsetSpec{
+				Name: name,
+				Type: rrType,
+				TTL:  ttl,
+				ZoneRef: dnsv1alpha2.ZoneRef{
+					Name: "example.org",
+					Kind: "Zone",
+				},
+			},
+		}
+	}
+	defaultTTLByType := map[string]uint32{"A": 300}
+	var testCases = []struct {
+		description string
+		defaultTTL  *uint32
+		rrsets      []dnsv1alpha2.GenericRRset
+		want        []dnsv1alpha2.TTLInconsistency
+	}{
+		{
+			"No RRset",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"Consistent TTLs",
+			nil,
+			[]dnsv1alpha2.GenericRRset{
+				newRRset("www", "A", 300),
+				newRRset("api", "A", 300),
+				newRRset("mail", "MX", 3600),
+			},
+			nil,
+		},
+		{
+			"Mismatched TTLs on one type",
+			nil,
+			[]dnsv1alpha2.GenericRRset{
+				newRRset("www", "A", 600),
+				newRRset("api", "A", 300),
+				newRRset("front", "A", 600),
+				newRRset("mail", "MX", 3600),
+			},
+			[]dnsv1alpha2.TTLInconsistency{
+				{Type: "A", TTLs: []uint32{300, 600}},
+			},
+		},
+		{
+			"Mismatched TTLs on several types",
+			nil,
+			[]dnsv1alpha2.GenericRRset{
+				newRRset("www", "AAAA", 600),
+				newRRset("www", "A", 300),
+				newRRset("api", "AAAA", 300),
+				newRRset("api", "A", 60),
+				newRRset("mail", "MX", 3600),
+			},
+			[]dnsv1alpha2.TTLInconsistency{
+				{Type: "A", TTLs: []uint32{60, 300}},
+				{Type: "AAAA", TTLs: []uint32{300, 600}},
+			},
+		},
+		{
+			"TTL-0 RRset inheriting the same TTL",
+			nil,
+			[]dnsv1alpha2.GenericRRset{
+				newRRset("www", "A", 0),
+				newRRset("api", "A", 300),
+			},
+			nil,
+		},
+		{
+			"TTL-0 RRset inheriting the default TTL of the zone",
+			ptr.To(uint32(600)),
+			[]dnsv1alpha2.GenericRRset{
+				newRRset("www", "A", 0),
+				newRRset("api", "A", 300),
+			},
+			[]dnsv1alpha2.TTLInconsistency{
+				{Type: "A", TTLs: []uint32{300, 600}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: "example"}, Spec: dnsv1alpha2.ZoneSpec{DefaultTTL: tc.defaultTTL}}
+			inconsistencies := findTTLInconsistencies(zone, tc.rrsets, defaultTTLByType)
+			if !cmp.Equal(inconsistencies, tc.want) {
+				t.Errorf("got %v, want %v", inconsistencies, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsInBailiwick(t *testing.T) {
+	var testCases = []struct {
+		description string
+		nameserver  string
+		zone        string
+		expected    bool
+	}{
+		{"Subdomain nameserver", "ns1.example.org", "example.org", true},
+		{"Canonical nameserver", "ns1.example.org.", "example.org", true},
+		{"Zone apex nameserver", "example.org", "example.org.", true},
+		{"Case insensitive nameserver", "NS1.Example.org", "example.org", true},
+		{"Out-of-bailiwick nameserver", "ns1.example.net", "example.org", false},
+		{"Suffix sharing nameserver", "ns1.myexample.org", "example.org", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := isInBailiwick(tc.nameserver, tc.zone)
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestIsZoneAllowed(t *testing.T) {
+	var testCases = []struct {
+		description     string
+		zone            string
+		allowedSuffixes []string
+		expected        bool
+	}{
+		{"No allowed suffix", "example.org", nil, true},
+		{"Allowed suffix", "example.org", []string{"example.org"}, true},
+		{"Subdomain of an allowed suffix", "team-a.example.org", []string{"example.net", "example.org."}, true},
+		{"Case insensitive suffix", "Team-A.Example.org", []string{"example.org"}, true},
+		{"Denied zone", "example.net", []string{"example.org"}, false},
+		{"Parent of an allowed suffix", "example.org", []string{"team-a.example.org"}, false},
+		{"Suffix sharing zone", "myexample.org", []string{"example.org"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := isZoneAllowed(tc.zone, tc.allowedSuffixes)
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestValidateNameserverGlue(t *testing.T) {
+	var (
+		name        = "example.org"
+		nameservers = []string{"ns1.example.org", "ns2.example.org", "ns1.example.net"}
+	)
+
+	var testCases = []struct {
+		description string
+		glue        map[string][]string
+		expectedErr bool
+	}{
+		{"No glue", nil, false},
+		{"In-bailiwick glue", map[string][]string{"ns1.example.org": {"192.0.2.1", "2001:db8::1"}, "ns2.example.org.": {"192.0.2.2"}}, false},
+		{"Out-of-bailiwick glue", map[string][]string{"ns1.example.net": {"192.0.2.1"}}, true},
+		{"Glue for unknown nameserver", map[string][]string{"ns3.example.org": {"192.0.2.3"}}, true},
+		{"Glue without address", map[string][]string{"ns1.example.org": {}}, true},
+		{"Glue with invalid address", map[string][]string{"ns1.example.org": {"192.0.2"}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			zone := &dnsv1alpha2.ClusterZone{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       dnsv1alpha2.ZoneSpec{Kind: NATIVE_KIND_ZONE, Nameservers: nameservers, NameserverGlue: tc.glue},
+			}
+			err := validateNameserverGlue(zone)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestGetRRsetTTL(t *testing.T) {
+	var (
+		rrsetTTL         = uint32(60)
+		zoneDefaultTTL   = uint32(300)
+		defaultTTLByType = map[string]uint32{"A": 120, "NS": 86400}
+	)
+
+	var testCases = []struct {
+		description string
+		rrsetTTL    uint32
+		zoneTTL     *uint32
+		rrsetType   string
+		expected    uint32
+	}{
+		{"RRset TTL", rrsetTTL, &zoneDefaultTTL, "A", rrsetTTL},
+		{"Zone default TTL", 0, &zoneDefaultTTL, "A", zoneDefaultTTL},
+		{"Type default TTL", 0, nil, "A", 120},
+		{"Type default TTL case insensitive", 0, nil, "ns", 86400},
+		{"Built-in default TTL", 0, nil, "TXT", DEFAULT_TTL_FOR_RRSETS},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			zone := &dnsv1alpha2.ClusterZone{Spec: dnsv1alpha2.ZoneSpec{DefaultTTL: tc.zoneTTL}}
+			rrset := &dnsv1alpha2.ClusterRRset{Spec: dnsv1alpha2.RRsetSpec{Type: tc.rrsetType, TTL: tc.rrsetTTL}}
+			result := getRRsetTTL(zone, rrset, defaultTTLByType)
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+
+	// The operator default TTL applies to the types without default TTL
+	operatorDefaultTTL := map[string]uint32{"A": 120, DEFAULT_TTL_ANY_TYPE: 600}
+	for _, tc := range []struct {
+		description string
+		rrsetType   string
+		expected    uint32
+	}{
+		{"Type default TTL over operator default TTL", "A", 120},
+		{"Operator default TTL", "TXT", 600},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			zone := &dnsv1alpha2.ClusterZone{}
+			rrset := &dnsv1alpha2.ClusterRRset{Spec: dnsv1alpha2.RRsetSpec{Type: tc.rrsetType}}
+			result := getRRsetTTL(zone, rrset, operatorDefaultTTL)
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestGetRRsetRecords(t *testing.T) {
+	var testCases = []struct {
+		description string
+		spec        dnsv1alpha2.RRsetSpec
+		expected    []string
+	}{
+		{"Raw records", dnsv1alpha2.RRsetSpec{Type: "A", Records: []string{"1.1.1.1"}}, []string{"1.1.1.1"}},
+		{
+			"MX records",
+			dnsv1alpha2.RRsetSpec{Type: "MX", MX: []dnsv1alpha2.MXRecord{{Preference: 10, Exchange: "mx1.example.org"}, {Preference: 20, Exchange: "mx2.example.org."}}},
+			[]string{"10 mx1.example.org.", "20 mx2.example.org."},
+		},
+		{
+			"Null MX record",
+			dnsv1alpha2.RRsetSpec{Type: "MX", MX: []dnsv1alpha2.MXRecord{{Preference: 0, Exchange: "."}}},
+			[]string{"0 ."},
+		},
+		{
+			"ALIAS record",
+			dnsv1alpha2.RRsetSpec{Type: "ALIAS", Records: []string{"target.example.org"}},
+			[]string{"target.example.org."},
+		},
+		{
+			"SRV records",
+			dnsv1alpha2.RRsetSpec{Type: "SRV", SRV: []dnsv1alpha2.SRVRecord{{Priority: 1, Weight: 50, Port: 25565, Target: "test2.example.org"}}},
+			[]string{"1 50 25565 test2.example.org."},
+		},
+		{
+			"HTTPS records",
+			dnsv1alpha2.RRsetSpec{Type: "HTTPS", SVCB: []dnsv1alpha2.SVCBRecord{
+				{Priority: 1, Target: ".", Params: map[string]string{"port": "8443", "alpn": "h3,h2", "no-default-alpn": "", "key65000": "x"}},
+				{Priority: 0, Target: "svc.example.org"},
+			}},
+			[]string{"1 . alpn=h3,h2 no-default-alpn port=8443 key65000=x", "0 svc.example.org."},
+		},
+		{
+			"CAA records",
+			dnsv1alpha2.RRsetSpec{Type: "CAA", CAA: []dnsv1alpha2.CAARecord{
+				{Flag: 0, Tag: "issue", Value: "letsencrypt.org"},
+				{Flag: 128, Tag: "iodef", Value: "mailto:security@example.org"},
+				{Flag: 0, Tag: "issuewild", Value: ";"},
+			}},
+			[]string{`0 issue "letsencrypt.org"`, `128 iodef "mailto:security@example.org"`, `0 issuewild ";"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := getRRsetRecords(&dnsv1alpha2.RRset{Spec: tc.spec})
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestChunkTXTRecord(t *testing.T) {
+	dkim := "v=DKIM1; k=rsa; p=" + strings.Repeat("MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A", 18) + "IDAQAB"
+	var testCases = []struct {
+		description string
+		record      string
+		expected    string
+	}{
+		{"Short string", `"v=spf1 -all"`, `"v=spf1 -all"`},
+		{"Several short strings", `"part1"  "part2"`, `"part1" "part2"`},
+		{"600 bytes DKIM value", `"` + dkim + `"`, `"` + dkim[:255] + `" "` + dkim[255:510] + `" "` + dkim[510:] + `"`},
+		{"Already chunked DKIM value", `"` + dkim[:255] + `" "` + dkim[255:510] + `" "` + dkim[510:] + `"`, `"` + dkim[:255] + `" "` + dkim[255:510] + `" "` + dkim[510:] + `"`},
+		{"Escape sequences not split", `"` + strings.Repeat("a", 254) + `\"b\065"`, `"` + strings.Repeat("a", 254) + `\"" "b\065"`},
+		{"Multi-bytes characters not split", `"` + strings.Repeat("a", 254) + `é"`, `"` + strings.Repeat("a", 254) + `" "é"`},
+		{"Unquoted content", "v=spf1 -all", "v=spf1 -all"},
+		{"Unterminated string", `"` + dkim, `"` + dkim},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := chunkTXTRecord(tc.record)
+			if result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+
+	// The unchunked value of a RRset is identical to the chunked value returned by PowerDNS
+	rrset := &dnsv1alpha2.RRset{Spec: dnsv1alpha2.RRsetSpec{Type: "TXT", Records: []string{`"` + dkim + `"`}}}
+	if !recordsAreIdentical("TXT", []string{`"` + dkim + `"`}, getRRsetRecords(rrset)) {
+		t.Errorf("got %v, want %v", false, true)
+	}
+}
+
+func TestRecordsAreIdenticalSVCB(t *testing.T) {
+	var testCases = []struct {
+		description     string
+		rrType          string
+		records         []string
+		externalRecords []string
+		expected        bool
+	}{
+		{"Params in canonical order", "HTTPS", []string{"1 . alpn=h2 port=443"}, []string{"1 . alpn=h2 port=443"}, true},
+		{"Params in another order", "HTTPS", []string{"1 . port=443 ALPN=h2"}, []string{"1 . alpn=h2 port=443"}, true},
+		{"Quoted values", "SVCB", []string{"1 svc.example.org ech=AEn+DQ=="}, []string{`1 svc.example.org. ech="AEn+DQ=="`}, true},
+		{"Different alpn order", "HTTPS", []string{"1 . alpn=h3,h2"}, []string{"1 . alpn=h2,h3"}, false},
+		{"Different priority", "HTTPS", []string{"2 . alpn=h2"}, []string{"1 . alpn=h2"}, false},
+		{"Other type", "TXT", []string{"1 . port=443 alpn=h2"}, []string{"1 . alpn=h2 port=443"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := recordsAreIdentical(tc.rrType, tc.records, tc.externalRecords)
+			if result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRecordsAreIdenticalCAA(t *testing.T) {
+	var testCases = []struct {
+		description     string
+		records         []string
+		externalRecords []string
+		expected        bool
+	}{
+		{"Quoted values", []string{`0 issue "letsencrypt.org"`}, []string{`0 issue "letsencrypt.org"`}, true},
+		{"Unquoted value", []string{"0 issue letsencrypt.org"}, []string{`0 issue "letsencrypt.org"`}, true},
+		{"Tag case", []string{`0 ISSUE "letsencrypt.org"`}, []string{`0 issue "letsencrypt.org"`}, true},
+		{"Extra spaces", []string{`0  issue  "letsencrypt.org"`}, []string{`0 issue "letsencrypt.org"`}, true},
+		{"Escaped quotes", []string{`0 issue "ca.example.net; account=\"a\""`}, []string{`0 issue "ca.example.net; account=\"a\""`}, true},
+		{"Different value", []string{`0 issue "letsencrypt.org"`}, []string{`0 issue "pki.goog"`}, false},
+		{"Different flag", []string{`128 issue "letsencrypt.org"`}, []string{`0 issue "letsencrypt.org"`}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := recordsAreIdentical("CAA", tc.records, tc.externalRecords)
+			if result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestGetRRsetComments(t *testing.T) {
+	var testCases = []struct {
+		description string
+		spec        dnsv1alpha2.RRsetSpec
+		expected    []powerdns.Comment
+	}{
+		{"No comment", dnsv1alpha2.RRsetSpec{}, []powerdns.Comment{}},
+		{
+			"Legacy comment",
+			dnsv1alpha2.RRsetSpec{Comment: ptr.To("legacy")},
+			[]powerdns.Comment{{Content: ptr.To("legacy"), Account: ptr.To(OPERATOR_COMMENT_ACCOUNT)}},
+		},
+		{
+			"Comments with and without account",
+			dnsv1alpha2.RRsetSpec{Comments: []dnsv1alpha2.Comment{{Content: "first"}, {Content: "second", Account: ptr.To("audit")}}},
+			[]powerdns.Comment{{Content: ptr.To("first"), Account: ptr.To(OPERATOR_COMMENT_ACCOUNT)}, {Content: ptr.To("second"), Account: ptr.To("audit")}},
+		},
+		{
+			"Legacy comment merged first",
+			dnsv1alpha2.RRsetSpec{Comment: ptr.To("legacy"), Comments: []dnsv1alpha2.Comment{{Content: "second", Account: ptr.To("audit")}}},
+			[]powerdns.Comment{{Content: ptr.To("legacy"), Account: ptr.To(OPERATOR_COMMENT_ACCOUNT)}, {Content: ptr.To("second"), Account: ptr.To("audit")}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := getRRsetComments(&dnsv1alpha2.RRset{Spec: tc.spec})
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFilterRRset(t *testing.T) {
+	rrset := func(name string, rrType powerdns.RRType, records []string, comments ...string) powerdns.RRset {
+		rr := powerdns.RRset{Name: ptr.To(name), Type: ptr.To(rrType)}
+		for _, r := range records {
+			rr.Records = append(rr.Records, powerdns.Record{Content: ptr.To(r)})
+		}
+		for _, c := range comments {
+			rr.Comments = append(rr.Comments, powerdns.Comment{Content: ptr.To(c)})
+		}
+		return rr
+	}
+	expected := rrset("www.example.org.", powerdns.RRTypeA, []string{"192.0.2.1"}, "web")
+	// RRsets with comments leaked by PowerDNS (pdns issue #14539)
+	leakedOtherName := rrset("mail.example.org.", powerdns.RRTypeA, nil, "mail")
+	leakedOtherType := rrset("www.example.org.", powerdns.RRTypeTXT, nil, "txt")
+
+	var testCases = []struct {
+		description string
+		rrsets      []powerdns.RRset
+		name        string
+		rrType      powerdns.RRType
+		expected    powerdns.RRset
+	}{
+		{"Single RRset", []powerdns.RRset{expected}, "www.example.org.", powerdns.RRTypeA, expected},
+		{"Leaked comments before", []powerdns.RRset{leakedOtherName, leakedOtherType, expected}, "www.example.org.", powerdns.RRTypeA, expected},
+		{"Leaked comments after", []powerdns.RRset{expected, leakedOtherName, leakedOtherType}, "www.example.org.", powerdns.RRTypeA, expected},
+		{"Only leaked comments", []powerdns.RRset{leakedOtherName, leakedOtherType}, "www.example.org.", powerdns.RRTypeA, powerdns.RRset{}},
+		{"Non-canonical name", []powerdns.RRset{leakedOtherName, expected}, "www.example.org", powerdns.RRTypeA, expected},
+		{"Name case", []powerdns.RRset{expected}, "WWW.example.org.", powerdns.RRTypeA, expected},
+		{"Lowercase type", []powerdns.RRset{leakedOtherType, expected}, "www.example.org.", powerdns.RRType("a"), expected},
+		{"RRset without name", []powerdns.RRset{{Type: ptr.To(powerdns.RRTypeA)}, expected}, "www.example.org.", powerdns.RRTypeA, expected},
+		{"No RRset", nil, "www.example.org.", powerdns.RRTypeA, powerdns.RRset{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := filterRRset(tc.rrsets, tc.name, tc.rrType)
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCommentsAreIdentical(t *testing.T) {
+	comments := []powerdns.Comment{{Content: ptr.To("first"), Account: ptr.To("audit")}}
+	var testCases = []struct {
+		description      string
+		externalComments []powerdns.Comment
+		expected         bool
+	}{
+		{"Identical comments", []powerdns.Comment{{Content: ptr.To("first"), Account: ptr.To("audit")}}, true},
+		{"Different content", []powerdns.Comment{{Content: ptr.To("other"), Account: ptr.To("audit")}}, false},
+		{"Different account", []powerdns.Comment{{Content: ptr.To("first"), Account: ptr.To(OPERATOR_COMMENT_ACCOUNT)}}, false},
+		{"Missing comment", []powerdns.Comment{}, false},
+		{"Additional comment", []powerdns.Comment{{Content: ptr.To("first"), Account: ptr.To("audit")}, {Content: ptr.To("second")}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := commentsAreIdentical(comments, tc.externalComments)
+			if result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestParseTTLByType(t *testing.T) {
+	var testCases = []struct {
+		description string
+		value       string
+		expected    map[string]uint32
+		expectedErr bool
+	}{
+		{"Empty value", "", map[string]uint32{}, false},
+		{"Multiple types", "A=60, aaaa=60,NS=86400", map[string]uint32{"A": 60, "AAAA": 60, "NS": 86400}, false},
+		{"Missing TTL", "A", nil, true},
+		{"Invalid TTL", "A=abc", nil, true},
+		{"Zero TTL", "A=0", nil, true},
+		{"Operator default TTL", "*=300,A=60", map[string]uint32{DEFAULT_TTL_ANY_TYPE: 300, "A": 60}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, err := ParseTTLByType(tc.value)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestMetadataIsIdentical(t *testing.T) {
+	var testCases = []struct {
+		description string
+		a           []string
+		b           []string
+		expected    bool
+	}{
+		{"Identical values", []string{"key1", "key2"}, []string{"key1", "key2"}, true},
+		{"Unordered values", []string{"key2", "key1"}, []string{"key1", "key2"}, true},
+		{"Nil and empty values", nil, []string{}, true},
+		{"Missing value", []string{"key1"}, []string{"key1", "key2"}, false},
+		{"Different values", []string{"key1"}, []string{"key3"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := metadataIsIdentical(tc.a, tc.b)
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestIsCnameConflict(t *testing.T) {
+	var testCases = []struct {
+		description string
+		rrType      string
+		name        string
+		zoneName    string
+		otherTypes  []string
+		expected    bool
+	}{
+		{"Lonely CNAME", "CNAME", "www.example.org.", "example.org", nil, false},
+		{"CNAME at the zone apex", "CNAME", "example.org.", "example.org", nil, true},
+		{"CNAME with another type", "CNAME", "www.example.org.", "example.org", []string{"TXT"}, true},
+		{"Type with a CNAME", "A", "www.example.org.", "example.org", []string{"AAAA", "cname"}, true},
+		{"Types without CNAME", "A", "www.example.org.", "example.org", []string{"AAAA", "TXT"}, false},
+		{"Duplicated CNAME", "CNAME", "www.example.org.", "example.org", []string{"CNAME"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := isCnameConflict(tc.rrType, tc.name, tc.zoneName, tc.otherTypes)
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestGetLuaEmbeddedTypes(t *testing.T) {
+	var testCases = []struct {
+		description string
+		records     []string
+		expected    []string
+	}{
+		{"Single record", []string{`A "ifportup(443, {'192.0.2.1', '192.0.2.2'})"`}, []string{"A"}},
+		{"Several types", []string{`aaaa "ifportup(443, {'2001:db8::1'})"`, `A "ifportup(443, {'192.0.2.1'})"`, `A "pickrandom({'192.0.2.3'})"`}, []string{"A", "AAAA"}},
+		{"No record", nil, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := getLuaEmbeddedTypes(tc.records)
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReverseName(t *testing.T) {
+	var testCases = []struct {
+		description string
+		address     string
+		expected    string
+	}{
+		{"IPv4 address", "192.168.0.1", "1.0.168.192.in-addr.arpa."},
+		{"IPv4-mapped IPv6 address", "::ffff:10.0.0.1", "1.0.0.10.in-addr.arpa."},
+		{"IPv6 address", "2001:db8::567:89ab", "b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := reverseName(netip.MustParseAddr(tc.address))
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFindReverseZone(t *testing.T) {
+	failed := dnsv1alpha2.FAILED_STATUS
+	zones := []dnsv1alpha2.GenericZone{
+		&dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: "168.192.in-addr.arpa"}},
+		&dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "0.168.192.in-addr.arpa", Namespace: "example"}},
+		&dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: "10.in-addr.arpa"}, Status: dnsv1alpha2.ZoneStatus{SyncStatus: &failed}},
+		&dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: "example.org"}},
+	}
+	var testCases = []struct {
+		description string
+		name        string
+		expected    string
+	}{
+		{"Most specific zone", "1.0.168.192.in-addr.arpa.", "0.168.192.in-addr.arpa"},
+		{"Less specific zone", "1.1.168.192.in-addr.arpa.", "168.192.in-addr.arpa"},
+		{"Failed zone", "1.0.0.10.in-addr.arpa.", ""},
+		{"No zone", "1.0.0.127.in-addr.arpa.", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var result string
+			if zone := findReverseZone(tc.name, zones); zone != nil {
+				result = zone.GetName()
+			}
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestGetSOAContent(t *testing.T) {
+	current := "ns1.example.org. hostmaster.example.org. 2024010101 10800 3600 604800 3600"
+	var testCases = []struct {
+		description string
+		current     string
+		soa         dnsv1alpha2.SOASpec
+		expected    string
+		expectedErr bool
+	}{
+		{"No parameters", current, dnsv1alpha2.SOASpec{}, current, false},
+		{"Timers", current, dnsv1alpha2.SOASpec{Refresh: ptr.To(uint32(7200)), NegativeTTL: ptr.To(uint32(300))}, "ns1.example.org. hostmaster.example.org. 2024010101 7200 3600 604800 300", false},
+		{"Names", current, dnsv1alpha2.SOASpec{MName: ptr.To("ns2.example.org"), RName: ptr.To("dns.example.org.")}, "ns2.example.org. dns.example.org. 2024010101 10800 3600 604800 3600", false},
+		{"Invalid SOA", "ns1.example.org. 2024010101", dnsv1alpha2.SOASpec{}, "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, err := getSOAContent(tc.current, &tc.soa)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestGetNextSerial(t *testing.T) {
+	now := time.Date(2026, time.March, 7, 12, 0, 0, 0, time.UTC)
+	var testCases = []struct {
+		description string
+		strategy    string
+		current     uint32
+		expected    uint32
+	}{
+		{"Increment", dnsv1alpha2.SERIAL_STRATEGY_INCREMENT, 41, 42},
+		{"Increment wraps around", dnsv1alpha2.SERIAL_STRATEGY_INCREMENT, 4294967295, 0},
+		{"First serial of the day", dnsv1alpha2.SERIAL_STRATEGY_DATE_BASED, 2026030512, 2026030701},
+		{"Serial of the day reached", dnsv1alpha2.SERIAL_STRATEGY_DATE_BASED, 2026030701, 2026030702},
+		{"Serial ahead of the day", dnsv1alpha2.SERIAL_STRATEGY_DATE_BASED, 2026040100, 2026040101},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if result := getNextSerial(tc.strategy, tc.current, now); result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestGetRecordsDigest(t *testing.T) {
+	rrsets := func(serial, address string) []powerdns.RRset {
+		return []powerdns.RRset{
+			{Name: ptr.To("example.org."), Type: ptr.To(powerdns.RRTypeSOA), TTL: ptr.To(uint32(3600)), Records: []powerdns.Record{{Content: ptr.To("ns1.example.org. hostmaster.example.org. " + serial + " 10800 3600 604800 3600")}}},
+			{Name: ptr.To("www.example.org."), Type: ptr.To(powerdns.RRTypeA), TTL: ptr.To(uint32(300)), Records: []powerdns.Record{{Content: ptr.To(address)}}},
+		}
+	}
+	digest := getRecordsDigest("example.org", rrsets("2025010101", "192.0.2.1"))
+	reordered := rrsets("2025010101", "192.0.2.1")
+	slices.Reverse(reordered)
+
+	var testCases = []struct {
+		description string
+		rrsets      []powerdns.RRset
+		expected    bool
+	}{
+		{"Serial changed", rrsets("2025010102", "192.0.2.1"), true},
+		{"RRsets reordered", reordered, true},
+		{"Record changed", rrsets("2025010101", "192.0.2.2"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if result := getRecordsDigest("example.org", tc.rrsets) == digest; result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestGetRRsetPdnsRecords(t *testing.T) {
+	var testCases = []struct {
+		description string
+		spec        dnsv1alpha2.RRsetSpec
+		expected    []powerdns.Record
+	}{
+		{
+			"Enabled records",
+			dnsv1alpha2.RRsetSpec{Type: "A", Records: []string{"1.1.1.1", "2.2.2.2"}},
+			[]powerdns.Record{{Content: ptr.To("1.1.1.1"), Disabled: ptr.To(false), SetPTR: ptr.To(false)}, {Content: ptr.To("2.2.2.2"), Disabled: ptr.To(false), SetPTR: ptr.To(false)}},
+		},
+		{
+			"Disabled record with set-ptr",
+			dnsv1alpha2.RRsetSpec{Type: "A", Records: []string{"1.1.1.1", "2.2.2.2"}, DisabledRecords: []string{"2.2.2.2", "3.3.3.3"}, SetPTR: ptr.To(true)},
+			[]powerdns.Record{{Content: ptr.To("1.1.1.1"), Disabled: ptr.To(false), SetPTR: ptr.To(true)}, {Content: ptr.To("2.2.2.2"), Disabled: ptr.To(true), SetPTR: ptr.To(true)}},
+		},
+		{
+			"Disabled MX record",
+			dnsv1alpha2.RRsetSpec{Type: "MX", MX: []dnsv1alpha2.MXRecord{{Preference: 10, Exchange: "mx1.example.org"}}, DisabledRecords: []string{"10 mx1.example.org."}},
+			[]powerdns.Record{{Content: ptr.To("10 mx1.example.org."), Disabled: ptr.To(true), SetPTR: ptr.To(false)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := getRRsetPdnsRecords(&dnsv1alpha2.RRset{Spec: tc.spec})
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
 			}
 		})
 	}
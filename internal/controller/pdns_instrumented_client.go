@@ -0,0 +1,264 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/joeig/go-powerdns/v3"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func init() {
+	// Register PowerDNS API metrics with the global prometheus registry
+	metrics.Registry.MustRegister(pdnsAPIRequestDurationMetric)
+	metrics.Registry.MustRegister(pdnsAPIErrorsMetric)
+	metrics.Registry.MustRegister(pdnsAPIRateLimitedMetric)
+	metrics.Registry.MustRegister(pdnsAPIActiveEndpointMetric)
+}
+
+// NewInstrumentedPdnsClienter wraps each PowerDNS API client of c
+// to record the duration and the errors of every call in Prometheus metrics
+func NewInstrumentedPdnsClienter(c PdnsClienter) PdnsClienter {
+	instrumented := PdnsClienter{}
+	if c.Records != nil {
+		instrumented.Records = &instrumentedRecordsClient{c.Records}
+	}
+	if c.Zones != nil {
+		instrumented.Zones = &instrumentedZonesClient{c.Zones}
+	}
+	if c.Cryptokeys != nil {
+		instrumented.Cryptokeys = &instrumentedCryptokeysClient{c.Cryptokeys}
+	}
+	if c.Metadata != nil {
+		instrumented.Metadata = &instrumentedMetadataClient{c.Metadata}
+	}
+	if c.TSIGKeys != nil {
+		instrumented.TSIGKeys = &instrumentedTSIGKeysClient{c.TSIGKeys}
+	}
+	if c.Statistics != nil {
+		instrumented.Statistics = &instrumentedStatisticsClient{c.Statistics}
+	}
+	return instrumented
+}
+
+// observe records the duration and the outcome of a PowerDNS API call started at start
+func observe(operation string, start time.Time, err error) {
+	observePdnsAPIRequest(operation, time.Since(start), err)
+}
+
+type instrumentedRecordsClient struct {
+	next pdnsRecordsClienter
+}
+
+func (c *instrumentedRecordsClient) Delete(ctx context.Context, domain string, name string, recordType powerdns.RRType) error {
+	start := time.Now()
+	err := c.next.Delete(ctx, domain, name, recordType)
+	observe("records.delete", start, err)
+	return err
+}
+
+func (c *instrumentedRecordsClient) Change(ctx context.Context, domain string, name string, recordType powerdns.RRType, ttl uint32, content []string, options ...func(*powerdns.RRset)) error {
+	start := time.Now()
+	err := c.next.Change(ctx, domain, name, recordType, ttl, content, options...)
+	observe("records.change", start, err)
+	return err
+}
+
+func (c *instrumentedRecordsClient) Get(ctx context.Context, domain, name string, recordType *powerdns.RRType) ([]powerdns.RRset, error) {
+	start := time.Now()
+	res, err := c.next.Get(ctx, domain, name, recordType)
+	observe("records.get", start, err)
+	return res, err
+}
+
+func (c *instrumentedRecordsClient) Patch(ctx context.Context, domain string, rrSets *powerdns.RRsets) error {
+	start := time.Now()
+	err := c.next.Patch(ctx, domain, rrSets)
+	observe("records.patch", start, err)
+	return err
+}
+
+type instrumentedZonesClient struct {
+	next pdnsZonesClienter
+}
+
+func (c *instrumentedZonesClient) Get(ctx context.Context, domain string) (*powerdns.Zone, error) {
+	start := time.Now()
+	res, err := c.next.Get(ctx, domain)
+	observe("zones.get", start, err)
+	return res, err
+}
+
+func (c *instrumentedZonesClient) Delete(ctx context.Context, domain string) error {
+	start := time.Now()
+	err := c.next.Delete(ctx, domain)
+	observe("zones.delete", start, err)
+	return err
+}
+
+func (c *instrumentedZonesClient) Change(ctx context.Context, domain string, zone *powerdns.Zone) error {
+	start := time.Now()
+	err := c.next.Change(ctx, domain, zone)
+	observe("zones.change", start, err)
+	return err
+}
+
+func (c *instrumentedZonesClient) Add(ctx context.Context, zone *powerdns.Zone) (*powerdns.Zone, error) {
+	start := time.Now()
+	res, err := c.next.Add(ctx, zone)
+	observe("zones.add", start, err)
+	return res, err
+}
+
+func (c *instrumentedZonesClient) AxfrRetrieve(ctx context.Context, domain string) (*powerdns.AxfrRetrieveResult, error) {
+	start := time.Now()
+	res, err := c.next.AxfrRetrieve(ctx, domain)
+	observe("zones.axfr_retrieve", start, err)
+	return res, err
+}
+
+func (c *instrumentedZonesClient) Rectify(ctx context.Context, domain string) (*RectifyResult, error) {
+	start := time.Now()
+	res, err := c.next.Rectify(ctx, domain)
+	observe("zones.rectify", start, err)
+	return res, err
+}
+
+func (c *instrumentedZonesClient) Notify(ctx context.Context, domain string) (*powerdns.NotifyResult, error) {
+	start := time.Now()
+	res, err := c.next.Notify(ctx, domain)
+	observe("zones.notify", start, err)
+	return res, err
+}
+
+func (c *instrumentedZonesClient) Export(ctx context.Context, domain string) (powerdns.Export, error) {
+	start := time.Now()
+	res, err := c.next.Export(ctx, domain)
+	observe("zones.export", start, err)
+	return res, err
+}
+
+type instrumentedCryptokeysClient struct {
+	next pdnsCryptokeysClienter
+}
+
+func (c *instrumentedCryptokeysClient) List(ctx context.Context, domain string) ([]powerdns.Cryptokey, error) {
+	start := time.Now()
+	res, err := c.next.List(ctx, domain)
+	observe("cryptokeys.list", start, err)
+	return res, err
+}
+
+func (c *instrumentedCryptokeysClient) Get(ctx context.Context, domain string, id uint64) (*powerdns.Cryptokey, error) {
+	start := time.Now()
+	res, err := c.next.Get(ctx, domain, id)
+	observe("cryptokeys.get", start, err)
+	return res, err
+}
+
+func (c *instrumentedCryptokeysClient) Add(ctx context.Context, domain string, cryptokey *powerdns.Cryptokey) (*powerdns.Cryptokey, error) {
+	start := time.Now()
+	res, err := c.next.Add(ctx, domain, cryptokey)
+	observe("cryptokeys.add", start, err)
+	return res, err
+}
+
+func (c *instrumentedCryptokeysClient) Change(ctx context.Context, domain string, id uint64, active bool) error {
+	start := time.Now()
+	err := c.next.Change(ctx, domain, id, active)
+	observe("cryptokeys.change", start, err)
+	return err
+}
+
+func (c *instrumentedCryptokeysClient) Delete(ctx context.Context, domain string, id uint64) error {
+	start := time.Now()
+	err := c.next.Delete(ctx, domain, id)
+	observe("cryptokeys.delete", start, err)
+	return err
+}
+
+type instrumentedMetadataClient struct {
+	next pdnsMetadataClienter
+}
+
+func (c *instrumentedMetadataClient) List(ctx context.Context, domain string) ([]powerdns.Metadata, error) {
+	start := time.Now()
+	res, err := c.next.List(ctx, domain)
+	observe("metadata.list", start, err)
+	return res, err
+}
+
+func (c *instrumentedMetadataClient) Get(ctx context.Context, domain string, kind powerdns.MetadataKind) (*powerdns.Metadata, error) {
+	start := time.Now()
+	res, err := c.next.Get(ctx, domain, kind)
+	observe("metadata.get", start, err)
+	return res, err
+}
+
+func (c *instrumentedMetadataClient) Set(ctx context.Context, domain string, kind powerdns.MetadataKind, values []string) (*powerdns.Metadata, error) {
+	start := time.Now()
+	res, err := c.next.Set(ctx, domain, kind, values)
+	observe("metadata.set", start, err)
+	return res, err
+}
+
+func (c *instrumentedMetadataClient) Delete(ctx context.Context, domain string, kind powerdns.MetadataKind) error {
+	start := time.Now()
+	err := c.next.Delete(ctx, domain, kind)
+	observe("metadata.delete", start, err)
+	return err
+}
+
+type instrumentedTSIGKeysClient struct {
+	next pdnsTSIGKeysClienter
+}
+
+func (c *instrumentedTSIGKeysClient) Get(ctx context.Context, id string) (*powerdns.TSIGKey, error) {
+	start := time.Now()
+	res, err := c.next.Get(ctx, id)
+	observe("tsigkeys.get", start, err)
+	return res, err
+}
+
+func (c *instrumentedTSIGKeysClient) Create(ctx context.Context, name, algorithm, key string) (*powerdns.TSIGKey, error) {
+	start := time.Now()
+	res, err := c.next.Create(ctx, name, algorithm, key)
+	observe("tsigkeys.create", start, err)
+	return res, err
+}
+
+func (c *instrumentedTSIGKeysClient) Change(ctx context.Context, id string, newKey powerdns.TSIGKey) (*powerdns.TSIGKey, error) {
+	start := time.Now()
+	res, err := c.next.Change(ctx, id, newKey)
+	observe("tsigkeys.change", start, err)
+	return res, err
+}
+
+func (c *instrumentedTSIGKeysClient) Delete(ctx context.Context, id string) error {
+	start := time.Now()
+	err := c.next.Delete(ctx, id)
+	observe("tsigkeys.delete", start, err)
+	return err
+}
+
+type instrumentedStatisticsClient struct {
+	next pdnsStatisticsClienter
+}
+
+func (c *instrumentedStatisticsClient) List(ctx context.Context) ([]powerdns.Statistic, error) {
+	start := time.Now()
+	res, err := c.next.List(ctx)
+	observe("statistics.list", start, err)
+	return res, err
+}
@@ -0,0 +1,100 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/joeig/go-powerdns/v3"
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+func TestPdnsAPIErrorType(t *testing.T) {
+	var testCases = []struct {
+		description string
+		err         error
+		expected    string
+	}{
+		{"Not found", &powerdns.Error{StatusCode: http.StatusNotFound}, PDNS_API_ERROR_CLIENT},
+		{"Unprocessable entity", powerdns.Error{StatusCode: http.StatusUnprocessableEntity}, PDNS_API_ERROR_CLIENT},
+		{"Internal server error", &powerdns.Error{StatusCode: http.StatusInternalServerError}, PDNS_API_ERROR_SERVER},
+		{"Wrapped bad gateway", fmt.Errorf("zone creation: %w", &powerdns.Error{StatusCode: http.StatusBadGateway}), PDNS_API_ERROR_SERVER},
+		{"Deadline exceeded", fmt.Errorf("request: %w", context.DeadlineExceeded), PDNS_API_ERROR_TIMEOUT},
+		{"Unknown error", errors.New("connection refused"), PDNS_API_ERROR_OTHER},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := pdnsAPIErrorType(tc.err)
+			if result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestSynchronizationFailedReason(t *testing.T) {
+	var testCases = []struct {
+		description string
+		err         error
+		expected    string
+	}{
+		{"Not found", &powerdns.Error{StatusCode: http.StatusNotFound, Message: "Not Found"}, dnsv1alpha2.PDNS_NOT_FOUND_REASON},
+		{"Conflict", powerdns.Error{StatusCode: http.StatusConflict, Message: "Conflict"}, dnsv1alpha2.PDNS_CONFLICT_REASON},
+		{"Too many requests", &powerdns.Error{StatusCode: http.StatusTooManyRequests}, dnsv1alpha2.PDNS_RATE_LIMITED_REASON},
+		{"Wrapped internal server error", fmt.Errorf("zone creation: %w", &powerdns.Error{StatusCode: http.StatusInternalServerError}), dnsv1alpha2.PDNS_SERVER_ERROR_REASON},
+		{"Unprocessable entity", &powerdns.Error{StatusCode: http.StatusUnprocessableEntity}, dnsv1alpha2.SYNCHRONIZATION_FAILED_REASON},
+		{"Unknown error", errors.New("connection refused"), dnsv1alpha2.SYNCHRONIZATION_FAILED_REASON},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := dnsv1alpha2.SynchronizationFailedReason(tc.err)
+			if result != tc.expected {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+type failingZonesClient struct {
+	pdnsZonesClienter
+	err error
+}
+
+func (c failingZonesClient) Get(ctx context.Context, domain string) (*powerdns.Zone, error) {
+	return nil, c.err
+}
+
+func TestInstrumentedPdnsClienter(t *testing.T) {
+	client := NewInstrumentedPdnsClienter(PdnsClienter{
+		Zones: failingZonesClient{err: &powerdns.Error{StatusCode: http.StatusServiceUnavailable}},
+	})
+	if client.Records != nil {
+		t.Errorf("got %v, want nil", client.Records)
+	}
+
+	before := getPdnsAPIErrorsMetric("zones.get", PDNS_API_ERROR_SERVER)
+	if _, err := client.Zones.Get(context.Background(), "example.org."); err == nil {
+		t.Errorf("an error was expected")
+	}
+	if result := getPdnsAPIErrorsMetric("zones.get", PDNS_API_ERROR_SERVER); result != before+1 {
+		t.Errorf("got %v, want %v", result, before+1)
+	}
+	if result := countPdnsAPIRequestDurationMetrics(); result == 0 {
+		t.Errorf("got %v, want at least 1", result)
+	}
+}
@@ -1,9 +1,15 @@
 package controller
 
 import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
 	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+	ctrl "sigs.k8s.io/controller-runtime"
 )
 
 var (
@@ -35,6 +41,81 @@ var (
 		},
 		[]string{"status", "name"},
 	)
+	lastSuccessfulReconcileMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "last_successful_reconcile_timestamp",
+			Help: "Unix timestamp of the last successful reconcile per controller",
+		},
+		[]string{"controller"},
+	)
+	zonesSerialMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "zone_serial",
+			Help: "Serial of Zones and ClusterZones in PowerDNS (empty namespace for ClusterZones)",
+		},
+		[]string{"name", "namespace"},
+	)
+	zonesNotifiedSerialMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "zone_notified_serial",
+			Help: "Last serial notified to secondaries of Zones and ClusterZones in PowerDNS (empty namespace for ClusterZones)",
+		},
+		[]string{"name", "namespace"},
+	)
+	pdnsAPIRequestDurationMetric = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "pdns_api_request_duration_seconds",
+			Help:    "Duration of PowerDNS API calls per operation and outcome",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"operation", "outcome"},
+	)
+	pdnsAPIErrorsMetric = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pdns_api_errors_total",
+			Help: "Number of PowerDNS API calls in error per operation and error type",
+		},
+		[]string{"operation", "type"},
+	)
+	pdnsAPIRateLimitedMetric = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pdns_api_rate_limited_total",
+			Help: "Number of PowerDNS API calls rate limited (HTTP 429) per operation",
+		},
+		[]string{"operation"},
+	)
+	pdnsAPIActiveEndpointMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "pdns_api_active_endpoint",
+			Help: "PowerDNS API endpoint the requests are sent to (1 active, 0 standby), only with several endpoints",
+		},
+		[]string{"endpoint"},
+	)
+)
+
+// Values of the outcome label of pdnsAPIRequestDurationMetric
+const (
+	PDNS_API_OUTCOME_SUCCESS = "success"
+	PDNS_API_OUTCOME_ERROR   = "error"
+)
+
+// Values of the type label of pdnsAPIErrorsMetric
+const (
+	PDNS_API_ERROR_CLIENT  = "4xx"
+	PDNS_API_ERROR_SERVER  = "5xx"
+	PDNS_API_ERROR_TIMEOUT = "timeout"
+	PDNS_API_ERROR_OTHER   = "other"
+)
+
+// Controllers names used as label of lastSuccessfulReconcileMetric
+const (
+	ZONE_CONTROLLER_NAME               = "zone"
+	CLUSTERZONE_CONTROLLER_NAME        = "clusterzone"
+	RRSET_CONTROLLER_NAME              = "rrset"
+	CLUSTERRRSET_CONTROLLER_NAME       = "clusterrrset"
+	CRYPTOKEY_CONTROLLER_NAME          = "cryptokey"
+	TSIGKEY_CONTROLLER_NAME            = "tsigkey"
+	DNSSEC_MAINTENANCE_CONTROLLER_NAME = "dnssecmaintenance"
 )
 
 func updateRrsetsMetrics(fqdn string, gr dnsv1alpha2.GenericRRset) {
@@ -90,6 +171,17 @@ func updateZonesMetrics(gz dnsv1alpha2.GenericZone) {
 			"name":   gz.GetName(),
 		}).Set(1)
 	}
+
+	labels := prometheus.Labels{
+		"name":      gz.GetName(),
+		"namespace": gz.GetNamespace(),
+	}
+	if serial := gz.GetStatus().Serial; serial != nil {
+		zonesSerialMetric.With(labels).Set(float64(*serial))
+	}
+	if notifiedSerial := gz.GetStatus().NotifiedSerial; notifiedSerial != nil {
+		zonesNotifiedSerialMetric.With(labels).Set(float64(*notifiedSerial))
+	}
 }
 func removeZonesMetrics(gz dnsv1alpha2.GenericZone) {
 	switch gz.(type) {
@@ -107,6 +199,52 @@ func removeZonesMetrics(gz dnsv1alpha2.GenericZone) {
 			},
 		)
 	}
+
+	labels := prometheus.Labels{
+		"name":      gz.GetName(),
+		"namespace": gz.GetNamespace(),
+	}
+	zonesSerialMetric.Delete(labels)
+	zonesNotifiedSerialMetric.Delete(labels)
+}
+
+// observeReconcile updates the last successful reconcile timestamp of the controller when reconcile succeeded
+// and synchronized the resource with PowerDNS (synced)
+func observeReconcile(controllerName string, synced bool, result ctrl.Result, err error) (ctrl.Result, error) {
+	if synced && err == nil {
+		lastSuccessfulReconcileMetric.WithLabelValues(controllerName).SetToCurrentTime()
+	}
+	return result, err
+}
+
+// observePdnsAPIRequest records the duration of a PowerDNS API call and, when it failed, its error type
+func observePdnsAPIRequest(operation string, duration time.Duration, err error) {
+	outcome := PDNS_API_OUTCOME_SUCCESS
+	if err != nil {
+		outcome = PDNS_API_OUTCOME_ERROR
+		pdnsAPIErrorsMetric.WithLabelValues(operation, pdnsAPIErrorType(err)).Inc()
+		if isPdnsRateLimited(err) {
+			pdnsAPIRateLimitedMetric.WithLabelValues(operation).Inc()
+		}
+	}
+	pdnsAPIRequestDurationMetric.WithLabelValues(operation, outcome).Observe(duration.Seconds())
+}
+
+// pdnsAPIErrorType classifies a PowerDNS API error as 4xx, 5xx, timeout or other
+func pdnsAPIErrorType(err error) string {
+	statusCode := dnsv1alpha2.PDNSErrorStatusCode(err)
+	switch {
+	case statusCode >= 400 && statusCode < 500:
+		return PDNS_API_ERROR_CLIENT
+	case statusCode >= 500 && statusCode < 600:
+		return PDNS_API_ERROR_SERVER
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return PDNS_API_ERROR_TIMEOUT
+	}
+	return PDNS_API_ERROR_OTHER
 }
 
 //nolint:unparam
@@ -147,6 +285,14 @@ func getClusterZoneMetricWithLabels(zoneStatus, zoneName string) float64 {
 	}))
 }
 
+func getLastSuccessfulReconcileMetric(controllerName string) float64 {
+	return testutil.ToFloat64(lastSuccessfulReconcileMetric.WithLabelValues(controllerName))
+}
+
+func getZoneSerialMetric(zoneName, zoneNamespace string) float64 {
+	return testutil.ToFloat64(zonesSerialMetric.WithLabelValues(zoneName, zoneNamespace))
+}
+
 func countRrsetsMetrics() int {
 	return testutil.CollectAndCount(rrsetsStatusesMetric)
 }
@@ -162,3 +308,11 @@ func countZonesMetrics() int {
 func countClusterZonesMetrics() int {
 	return testutil.CollectAndCount(clusterZonesStatusesMetric)
 }
+
+func getPdnsAPIErrorsMetric(operation, errorType string) float64 {
+	return testutil.ToFloat64(pdnsAPIErrorsMetric.WithLabelValues(operation, errorType))
+}
+
+func countPdnsAPIRequestDurationMetrics() int {
+	return testutil.CollectAndCount(pdnsAPIRequestDurationMetric)
+}
@@ -0,0 +1,54 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestObserveReconcile(t *testing.T) {
+	controllerName := "test"
+	lastSuccessfulReconcileMetric.WithLabelValues(controllerName).Set(0)
+
+	// Failed reconcile must not update the timestamp
+	_, _ = observeReconcile(controllerName, true, ctrl.Result{}, fmt.Errorf("reconcile failed"))
+	if got := getLastSuccessfulReconcileMetric(controllerName); got != 0 {
+		t.Errorf("got %v, want %v", got, 0)
+	}
+
+	// Successful reconcile must advance the timestamp
+	before := float64(time.Now().Unix())
+	_, _ = observeReconcile(controllerName, true, ctrl.Result{}, nil)
+	success := getLastSuccessfulReconcileMetric(controllerName)
+	if success < before {
+		t.Errorf("got %v, want at least %v", success, before)
+	}
+
+	// Reconcile which did not synchronize anything (e.g. paused resource) must keep the last successful timestamp
+	_, _ = observeReconcile(controllerName, false, ctrl.Result{}, nil)
+	if got := getLastSuccessfulReconcileMetric(controllerName); got != success {
+		t.Errorf("got %v, want %v", got, success)
+	}
+
+	// Failed reconcile must keep the last successful timestamp
+	_, err := observeReconcile(controllerName, true, ctrl.Result{}, fmt.Errorf("reconcile failed"))
+	if err == nil {
+		t.Errorf("an error was expected")
+	}
+	if got := getLastSuccessfulReconcileMetric(controllerName); got != success {
+		t.Errorf("got %v, want %v", got, success)
+	}
+}
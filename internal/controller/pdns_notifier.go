@@ -0,0 +1,83 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+// ZoneNotifier sends DNS NOTIFY to the secondaries of a zone when its RRsets change.
+// Notifies requested for the same zone within Window are coalesced into a single one.
+type ZoneNotifier struct {
+	Client     client.Client
+	PDNSClient PdnsClienter
+	// Window during which notifies of a zone are coalesced
+	Window time.Duration
+
+	mu      sync.Mutex
+	pending map[string]bool
+}
+
+// Notify schedules a NOTIFY for the zone at the end of the window, unless one is already scheduled
+func (n *ZoneNotifier) Notify(ctx context.Context, gz dnsv1alpha2.GenericZone) {
+	// Zone/ClusterZone with the same name are rejected, the name is unique
+	key := gz.GetObjectMeta().Name
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.pending == nil {
+		n.pending = map[string]bool{}
+	}
+	if n.pending[key] {
+		return
+	}
+	n.pending[key] = true
+
+	zone := gz.Copy()
+	time.AfterFunc(n.Window, func() {
+		n.mu.Lock()
+		delete(n.pending, key)
+		n.mu.Unlock()
+		n.notify(context.WithoutCancel(ctx), zone)
+	})
+}
+
+// notify sends the NOTIFY and records its time in the zone status
+func (n *ZoneNotifier) notify(ctx context.Context, gz dnsv1alpha2.GenericZone) {
+	log := log.FromContext(ctx).WithValues("Zone.Name", gz.GetName())
+
+	if _, err := n.PDNSClient.Zones.Notify(ctx, gz.GetObjectMeta().Name); err != nil {
+		log.Error(err, "Failed to notify zone")
+		return
+	}
+	log.V(1).Info("Zone notified")
+
+	if err := n.Client.Get(ctx, client.ObjectKeyFromObject(gz), gz); err != nil {
+		log.Error(err, "Failed to get zone")
+		return
+	}
+	original := gz.Copy()
+	status := gz.GetStatus()
+	status.LastNotifyTime = &metav1.Time{Time: time.Now().UTC()}
+	gz.SetStatus(status)
+	if err := n.Client.Status().Patch(ctx, gz, client.MergeFrom(original)); err != nil {
+		log.Error(err, "unable to patch zone status")
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// DEFAULT_RATE_LIMIT_DELAY is the delay before reconciling again a resource rate limited by PowerDNS API,
+// when the API does not give a Retry-After header
+const DEFAULT_RATE_LIMIT_DELAY = 10 * time.Second
+
+// pdnsRateLimitedUntil is the time (in Unix nanoseconds) until which PowerDNS API asked not to be called,
+// from the Retry-After header of its last 429 response
+var pdnsRateLimitedUntil atomic.Int64
+
+// RateLimitTransport records the Retry-After header of the 429 responses of PowerDNS API,
+// so that the rate limited resources are reconciled again once the API accepts requests
+type RateLimitTransport struct {
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.Base.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			pdnsRateLimitedUntil.Store(time.Now().Add(retryAfter).UnixNano())
+		}
+	}
+	return resp, err
+}
+
+// parseRetryAfter returns the delay of a Retry-After header, given in seconds or as a HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// isPdnsRateLimited returns true if err is a PowerDNS API error with a 429 status code
+func isPdnsRateLimited(err error) bool {
+	return dnsv1alpha2.PDNSErrorStatusCode(err) == http.StatusTooManyRequests
+}
+
+// pdnsRetryAfter returns the delay before calling PowerDNS API again after a 429 response
+func pdnsRetryAfter(now time.Time) time.Duration {
+	if remaining := time.Unix(0, pdnsRateLimitedUntil.Load()).Sub(now); remaining > 0 {
+		return remaining
+	}
+	return DEFAULT_RATE_LIMIT_DELAY
+}
+
+// rateLimitedResult requeues a resource rate limited by PowerDNS API after the delay asked by the API,
+// instead of returning the error which would retry it with the fast exponential backoff of the controller
+func rateLimitedResult(result ctrl.Result, err error) (ctrl.Result, error) {
+	if !isPdnsRateLimited(err) {
+		return result, err
+	}
+	return ctrl.Result{RequeueAfter: pdnsRetryAfter(time.Now())}, nil
+}
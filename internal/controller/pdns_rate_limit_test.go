@@ -0,0 +1,82 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/joeig/go-powerdns/v3"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	var testCases = []struct {
+		description string
+		value       string
+		expected    time.Duration
+		expectedOk  bool
+	}{
+		{"Seconds", "30", 30 * time.Second, true},
+		{"HTTP date", now.Add(time.Minute).Format(http.TimeFormat), time.Minute, true},
+		{"Past HTTP date", now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{"Missing header", "", 0, false},
+		{"Invalid header", "soon", 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, ok := parseRetryAfter(tc.value, now)
+			if result != tc.expected || ok != tc.expectedOk {
+				t.Errorf("got %v, %v, want %v, %v", result, ok, tc.expected, tc.expectedOk)
+			}
+		})
+	}
+}
+
+func TestRateLimitedResult(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	defer pdnsRateLimitedUntil.Store(0)
+
+	httpClient := &http.Client{Transport: &RateLimitTransport{Base: http.DefaultTransport}}
+	resp, err := httpClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	rateLimitedErr := &powerdns.Error{StatusCode: http.StatusTooManyRequests, Message: "Too Many Requests"}
+	result, err := rateLimitedResult(ctrl.Result{}, rateLimitedErr)
+	if err != nil {
+		t.Errorf("got %v, want no error", err)
+	}
+	if result.RequeueAfter <= 25*time.Second || result.RequeueAfter > 30*time.Second {
+		t.Errorf("got %v, want about %v", result.RequeueAfter, 30*time.Second)
+	}
+
+	otherErr := errors.New("connection refused")
+	if _, err := rateLimitedResult(ctrl.Result{}, otherErr); err != otherErr {
+		t.Errorf("got %v, want %v", err, otherErr)
+	}
+
+	pdnsRateLimitedUntil.Store(0)
+	if result, _ := rateLimitedResult(ctrl.Result{}, rateLimitedErr); result.RequeueAfter != DEFAULT_RATE_LIMIT_DELAY {
+		t.Errorf("got %v, want %v", result.RequeueAfter, DEFAULT_RATE_LIMIT_DELAY)
+	}
+}
@@ -0,0 +1,156 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/joeig/go-powerdns/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// Oldest PowerDNS version supporting all the features of the operator (catalog zones)
+	MIN_PDNS_VERSION = "4.7.0"
+	// Daemon type of the PowerDNS servers supported by the operator
+	AUTHORITATIVE_DAEMON_TYPE = "authoritative"
+)
+
+// pdnsDaemonType is the last daemon type reported by the PowerDNS server, refreshed on startup and on readiness checks
+// so that it is not queried on each reconcile
+var pdnsDaemonType atomic.Pointer[string]
+
+var (
+	pdnsServerInfoMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "pdns_server_info",
+			Help: "Information about the PowerDNS server, always 1",
+		},
+		[]string{"version", "daemon_type"},
+	)
+	pdnsServerVersionSupportedMetric = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "pdns_server_version_supported",
+			Help: "Whether the PowerDNS server version is at least " + MIN_PDNS_VERSION + " (1) or older (0)",
+		},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(pdnsServerInfoMetric)
+	metrics.Registry.MustRegister(pdnsServerVersionSupportedMetric)
+}
+
+type pdnsServersClienter interface {
+	Get(ctx context.Context, vHost string) (*powerdns.Server, error)
+}
+
+// PDNSReadinessCheck reports the operator as not ready when PowerDNS API is unreachable,
+// so that a pod with a broken PowerDNS connection is removed from service
+type PDNSReadinessCheck struct {
+	Servers pdnsServersClienter
+	// VHost is the PowerDNS server queried by the check
+	VHost string
+	// Timeout of the query on PowerDNS API
+	Timeout time.Duration
+}
+
+// Check implements healthz.Checker by querying the PowerDNS server information
+func (c *PDNSReadinessCheck) Check(req *http.Request) error {
+	ctx, cancel := context.WithTimeout(req.Context(), c.Timeout)
+	defer cancel()
+	server, err := c.Servers.Get(ctx, c.VHost)
+	if err != nil {
+		return fmt.Errorf("PowerDNS API is not reachable: %w", err)
+	}
+	// An unsupported version is reported in metrics and logged at startup, it does not make the operator unready
+	_ = recordServerInfo(server)
+	return nil
+}
+
+// recordServerInfo reports the version and the daemon type of the PowerDNS server in metrics,
+// an error is returned when its version is older than MIN_PDNS_VERSION
+func recordServerInfo(server *powerdns.Server) error {
+	version := ptr.Deref(server.Version, "")
+	pdnsServerInfoMetric.Reset()
+	pdnsServerInfoMetric.WithLabelValues(version, ptr.Deref(server.DaemonType, "")).Set(1)
+	SetPDNSDaemonType(ptr.Deref(server.DaemonType, ""))
+	err := CheckPDNSVersion(version)
+	if err != nil {
+		pdnsServerVersionSupportedMetric.Set(0)
+	} else {
+		pdnsServerVersionSupportedMetric.Set(1)
+	}
+	return err
+}
+
+// SetPDNSDaemonType records the daemon type reported by the PowerDNS server, an empty daemon type is ignored
+func SetPDNSDaemonType(daemonType string) {
+	if daemonType != "" {
+		pdnsDaemonType.Store(&daemonType)
+	}
+}
+
+// notAuthoritativeDaemonType returns the daemon type of the PowerDNS server when it is known and not authoritative,
+// an empty string otherwise
+func notAuthoritativeDaemonType() string {
+	daemonType := ptr.Deref(pdnsDaemonType.Load(), AUTHORITATIVE_DAEMON_TYPE)
+	if daemonType == AUTHORITATIVE_DAEMON_TYPE {
+		return ""
+	}
+	return daemonType
+}
+
+// CheckPDNSVersion returns an error when the PowerDNS version ("4.9.1", "4.8.0-rc1") is older than MIN_PDNS_VERSION.
+// Versions which cannot be parsed (e.g. development builds) are considered supported
+func CheckPDNSVersion(version string) error {
+	current, ok := parsePDNSVersion(version)
+	if !ok || current[0] == 0 {
+		return nil
+	}
+	minimum, _ := parsePDNSVersion(MIN_PDNS_VERSION)
+	for i := range current {
+		if current[i] != minimum[i] {
+			if current[i] < minimum[i] {
+				return fmt.Errorf("PowerDNS %s is not supported, some features require at least PowerDNS %s", version, MIN_PDNS_VERSION)
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// parsePDNSVersion returns the major, minor and patch numbers of a PowerDNS version, a pre-release suffix is ignored
+func parsePDNSVersion(version string) ([3]int, bool) {
+	var result [3]int
+	version, _, _ = strings.Cut(strings.TrimSpace(version), "-")
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 {
+		return result, false
+	}
+	for i := 0; i < len(parts) && i < len(result); i++ {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return result, false
+		}
+		result[i] = n
+	}
+	return result, true
+}
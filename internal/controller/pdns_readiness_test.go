@@ -0,0 +1,130 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/joeig/go-powerdns/v3"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/utils/ptr"
+)
+
+type mockServersClient struct {
+	err error
+}
+
+func (m mockServersClient) Get(ctx context.Context, vHost string) (*powerdns.Server, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &powerdns.Server{}, nil
+}
+
+func TestPDNSReadinessCheck(t *testing.T) {
+	var testCases = []struct {
+		description string
+		err         error
+		expectedErr bool
+	}{
+		{"Reachable PowerDNS", nil, false},
+		{"Unreachable PowerDNS", context.DeadlineExceeded, true},
+		{"Unauthorized", &powerdns.Error{StatusCode: 401, Message: "Unauthorized"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			check := &PDNSReadinessCheck{Servers: mockServersClient{err: tc.err}, VHost: "localhost", Timeout: time.Second}
+			err := check.Check(httptest.NewRequest("GET", "/readyz", nil))
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("got %v, want error: %v", err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCheckPDNSVersion(t *testing.T) {
+	var testCases = []struct {
+		description string
+		version     string
+		expectedErr bool
+	}{
+		{"Minimum version", MIN_PDNS_VERSION, false},
+		{"Recent version", "4.9.2", false},
+		{"Next major version", "5.0.0", false},
+		{"Release candidate", "4.8.0-rc1", false},
+		{"Old version", "4.6.4", true},
+		{"Old release candidate", "4.6.0-beta2", true},
+		{"Development build", "0.0.2839g1b2c3d4", false},
+		{"Unknown version", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := CheckPDNSVersion(tc.version)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("got %v, want error: %v", err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRecordServerInfo(t *testing.T) {
+	var testCases = []struct {
+		description       string
+		version           string
+		expectedSupported float64
+	}{
+		{"Supported version", "4.9.2", 1},
+		{"Unsupported version", "4.5.0", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			_ = recordServerInfo(&powerdns.Server{Version: ptr.To(tc.version), DaemonType: ptr.To("authoritative")})
+			if result := testutil.ToFloat64(pdnsServerVersionSupportedMetric); result != tc.expectedSupported {
+				t.Errorf("got %v, want %v", result, tc.expectedSupported)
+			}
+			// Only the current version is reported
+			if result := testutil.CollectAndCount(pdnsServerInfoMetric); result != 1 {
+				t.Errorf("got %v, want %v", result, 1)
+			}
+			if result := testutil.ToFloat64(pdnsServerInfoMetric.WithLabelValues(tc.version, "authoritative")); result != 1 {
+				t.Errorf("got %v, want %v", result, 1)
+			}
+		})
+	}
+}
+
+func TestNotAuthoritativeDaemonType(t *testing.T) {
+	defer pdnsDaemonType.Store(nil)
+
+	if got := notAuthoritativeDaemonType(); got != "" {
+		t.Errorf("got %q, want an unknown daemon type considered authoritative", got)
+	}
+	_ = recordServerInfo(&powerdns.Server{Version: ptr.To(MIN_PDNS_VERSION), DaemonType: ptr.To("recursor")})
+	if got := notAuthoritativeDaemonType(); got != "recursor" {
+		t.Errorf("got %q, want %q", got, "recursor")
+	}
+	// A server without daemon type keeps the last known one
+	SetPDNSDaemonType("")
+	if got := notAuthoritativeDaemonType(); got != "recursor" {
+		t.Errorf("got %q, want %q", got, "recursor")
+	}
+	SetPDNSDaemonType(AUTHORITATIVE_DAEMON_TYPE)
+	if got := notAuthoritativeDaemonType(); got != "" {
+		t.Errorf("got %q, want an authoritative server", got)
+	}
+}
@@ -0,0 +1,117 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// STATISTIC_ITEM_TYPE is the type of the PowerDNS statistics holding a single value,
+// the map and ring statistics (e.g. response-by-qtype, remotes) are not exported
+const STATISTIC_ITEM_TYPE = "StatisticItem"
+
+var pdnsServerStatisticMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "pdns_server_statistic",
+		Help: "Value of the PowerDNS server statistics (e.g. udp-queries, packetcache-hit), per statistic name",
+	},
+	[]string{"name"},
+)
+
+// ServerStatistics periodically fetches the PowerDNS server statistics and exposes them as Prometheus gauges,
+// so that PowerDNS health can be monitored from the operator
+type ServerStatistics struct {
+	PDNSClient PdnsClienter
+	// Names of the exported statistics, all the single value statistics when empty
+	Names []string
+	// Interval between two fetches of the statistics
+	Interval time.Duration
+
+	exported []string
+}
+
+// SetupWithManager registers the statistics metric and adds the statistics fetcher to the Manager
+func (s *ServerStatistics) SetupWithManager(mgr ctrl.Manager) error {
+	if s.Interval <= 0 {
+		return fmt.Errorf("statistics interval must be positive")
+	}
+	metrics.Registry.MustRegister(pdnsServerStatisticMetric)
+	return mgr.Add(s)
+}
+
+// NeedLeaderElection ensures only the leader exposes the statistics, avoiding duplicated series across replicas
+func (s *ServerStatistics) NeedLeaderElection() bool {
+	return true
+}
+
+// Start fetches the statistics at each interval until the context is cancelled
+func (s *ServerStatistics) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		_ = s.fetch(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// fetch updates pdnsServerStatisticMetric from the PowerDNS server statistics.
+// Statistics which are missing or not numeric are skipped, the series of the statistics no longer reported are removed
+func (s *ServerStatistics) fetch(ctx context.Context) error {
+	log := log.FromContext(ctx)
+	statistics, err := s.PDNSClient.Statistics.List(ctx)
+	if err != nil {
+		log.Error(err, "Failed to fetch PowerDNS server statistics")
+		return err
+	}
+
+	exported := make([]string, 0, len(statistics))
+	for _, statistic := range statistics {
+		if statistic.Name == nil || statistic.Type == nil || *statistic.Type != STATISTIC_ITEM_TYPE {
+			continue
+		}
+		if len(s.Names) > 0 && !slices.Contains(s.Names, *statistic.Name) {
+			continue
+		}
+		raw, ok := statistic.Value.(string)
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			log.V(1).Info("Skipping non numeric PowerDNS statistic", "name", *statistic.Name, "value", raw)
+			continue
+		}
+		pdnsServerStatisticMetric.WithLabelValues(*statistic.Name).Set(value)
+		exported = append(exported, *statistic.Name)
+	}
+
+	for _, name := range s.exported {
+		if !slices.Contains(exported, name) {
+			pdnsServerStatisticMetric.DeleteLabelValues(name)
+		}
+	}
+	s.exported = exported
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/joeig/go-powerdns/v3"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/utils/ptr"
+)
+
+type fakeStatisticsClient struct {
+	statistics []powerdns.Statistic
+	err        error
+}
+
+func (c *fakeStatisticsClient) List(ctx context.Context) ([]powerdns.Statistic, error) {
+	return c.statistics, c.err
+}
+
+func statistic(name, statisticType string, value any) powerdns.Statistic {
+	return powerdns.Statistic{Name: ptr.To(name), Type: ptr.To(statisticType), Value: value}
+}
+
+func TestServerStatisticsFetch(t *testing.T) {
+	client := &fakeStatisticsClient{statistics: []powerdns.Statistic{
+		statistic("udp-queries", STATISTIC_ITEM_TYPE, "42"),
+		statistic("packetcache-hit", STATISTIC_ITEM_TYPE, "7"),
+		statistic("uptime", STATISTIC_ITEM_TYPE, "not-a-number"),
+		statistic("response-by-qtype", "MapStatisticItem", []any{map[string]any{"name": "A", "value": "3"}}),
+	}}
+	s := &ServerStatistics{PDNSClient: PdnsClienter{Statistics: client}, Names: []string{"udp-queries", "uptime", "response-by-qtype", "missing"}, Interval: time.Minute}
+
+	// Only the selected single value numeric statistics are exported, missing ones are skipped
+	if err := s.fetch(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := testutil.ToFloat64(pdnsServerStatisticMetric.WithLabelValues("udp-queries")); got != 42 {
+		t.Errorf("got %v, want %v", got, 42)
+	}
+	if got := testutil.CollectAndCount(pdnsServerStatisticMetric); got != 1 {
+		t.Errorf("got %v series, want %v", got, 1)
+	}
+
+	// A statistic no longer reported is removed
+	client.statistics = client.statistics[1:]
+	s.Names = nil
+	if err := s.fetch(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := testutil.CollectAndCount(pdnsServerStatisticMetric); got != 1 {
+		t.Errorf("got %v series, want %v", got, 1)
+	}
+	if got := testutil.ToFloat64(pdnsServerStatisticMetric.WithLabelValues("packetcache-hit")); got != 7 {
+		t.Errorf("got %v, want %v", got, 7)
+	}
+
+	// The last values are kept when the statistics cannot be fetched
+	client.err = errors.New("unreachable")
+	if err := s.fetch(context.Background()); err == nil {
+		t.Errorf("an error was expected")
+	}
+	if got := testutil.CollectAndCount(pdnsServerStatisticMetric); got != 1 {
+		t.Errorf("got %v series, want %v", got, 1)
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"strings"
+	"sync"
+)
+
+// zoneLocks serializes the writes of the operator to the records of a zone, so that concurrent reconciles
+// of RRsets and Zones do not race on the SOA serial of the zone
+var zoneLocks = &keyedMutex{}
+
+// keyedMutex is a set of mutexes by key, a mutex only exists while it is held or awaited
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedMutexEntry
+}
+
+type keyedMutexEntry struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// Lock acquires the mutex of the key and returns the function releasing it
+func (k *keyedMutex) Lock(key string) func() {
+	k.mu.Lock()
+	if k.locks == nil {
+		k.locks = map[string]*keyedMutexEntry{}
+	}
+	entry, ok := k.locks[key]
+	if !ok {
+		entry = &keyedMutexEntry{}
+		k.locks[key] = entry
+	}
+	entry.refs++
+	k.mu.Unlock()
+
+	entry.mu.Lock()
+	return func() {
+		entry.mu.Unlock()
+		k.mu.Lock()
+		defer k.mu.Unlock()
+		entry.refs--
+		if entry.refs == 0 {
+			delete(k.locks, key)
+		}
+	}
+}
+
+// lockZone acquires the write lock of a zone, whatever the form of its name, and returns the function releasing it
+func lockZone(zone string) func() {
+	return zoneLocks.Lock(strings.ToLower(makeCanonical(zone)))
+}
+
+// lockZoneChange acquires the write lock of a zone before a Records.Change and returns the function releasing it.
+// The changes of a RecordsBatcher are applied under the lock at the end of its window, it is not held while waiting for them.
+func lockZoneChange(records pdnsRecordsClienter, zone string) func() {
+	if _, batched := records.(*RecordsBatcher); batched {
+		return func() {}
+	}
+	return lockZone(zone)
+}
@@ -0,0 +1,226 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/joeig/go-powerdns/v3"
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// serialRecordsClient stores the RRsets of a zone and bumps its serial on each write,
+// the writes overlapping another write of the same zone are counted as serial conflicts
+type serialRecordsClient struct {
+	pdnsRecordsClienter
+	mu        sync.Mutex
+	inFlight  int
+	conflicts int
+	serial    int
+	rrsets    map[string][]string
+}
+
+func (c *serialRecordsClient) Get(ctx context.Context, domain, name string, recordType *powerdns.RRType) ([]powerdns.RRset, error) {
+	return nil, nil
+}
+
+func (c *serialRecordsClient) write(sets []powerdns.RRset) {
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > 1 {
+		c.conflicts++
+	}
+	serial := c.serial
+	c.mu.Unlock()
+
+	// PowerDNS reads the serial, applies the change, then writes the serial back
+	time.Sleep(time.Millisecond)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inFlight--
+	c.serial = serial + 1
+	for _, rrset := range sets {
+		content := make([]string, 0, len(rrset.Records))
+		for _, r := range rrset.Records {
+			content = append(content, *r.Content)
+		}
+		c.rrsets[*rrset.Name] = content
+	}
+}
+
+func (c *serialRecordsClient) Change(ctx context.Context, domain string, name string, recordType powerdns.RRType, ttl uint32, content []string, options ...func(*powerdns.RRset)) error {
+	records := make([]powerdns.Record, 0, len(content))
+	for _, r := range content {
+		records = append(records, powerdns.Record{Content: ptr.To(r)})
+	}
+	c.write([]powerdns.RRset{{Name: ptr.To(makeCanonical(name)), Records: records}})
+	return nil
+}
+
+func (c *serialRecordsClient) Patch(ctx context.Context, domain string, rrSets *powerdns.RRsets) error {
+	c.write(rrSets.Sets)
+	return nil
+}
+
+func TestZoneLockConcurrentRrsetWrites(t *testing.T) {
+	var testCases = []struct {
+		description string
+		batched     bool
+		disabled    bool
+	}{
+		{"Changes", false, false},
+		{"Patches", false, true},
+		{"Batched changes", true, false},
+	}
+
+	ctx := context.Background()
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: "example"}}
+	count := 20
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			records := &serialRecordsClient{rrsets: map[string][]string{}}
+			pdnsClient := PdnsClienter{Records: records}
+			if tc.batched {
+				pdnsClient.Records = &RecordsBatcher{Records: records, Window: 10 * time.Millisecond}
+			}
+
+			var wg sync.WaitGroup
+			for i := range count {
+				wg.Go(func() {
+					rrset := &dnsv1alpha2.RRset{Spec: dnsv1alpha2.RRsetSpec{Type: "A", Name: fmt.Sprintf("www%d", i), Records: []string{"1.1.1.1", "2.2.2.2"}, ZoneRef: dnsv1alpha2.ZoneRef{Name: zone.Name, Kind: "Zone"}}}
+					if tc.disabled {
+						rrset.Spec.DisabledRecords = []string{"2.2.2.2"}
+					}
+					if _, err := createOrUpdateRrsetExternalResources(ctx, zone, rrset, nil, pdnsClient); err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+				})
+			}
+			// The nameservers of the zone are updated concurrently
+			wg.Go(func() {
+				if err := updateNsOnZoneExternalResources(ctx, zone, DEFAULT_TTL_FOR_NS_RECORDS, pdnsClient, logr.Discard()); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			})
+			wg.Wait()
+
+			if records.conflicts != 0 {
+				t.Errorf("got %d serial conflicts, want 0", records.conflicts)
+			}
+			if len(records.rrsets) != count+1 {
+				t.Errorf("got %d RRsets, want %d", len(records.rrsets), count+1)
+			}
+			if !tc.batched && records.serial != count+1 {
+				t.Errorf("got serial %d, want %d", records.serial, count+1)
+			}
+		})
+	}
+}
+
+func TestKeyedMutex(t *testing.T) {
+	k := &keyedMutex{}
+	unlock := k.Lock("example.org.")
+	// Another key is not blocked
+	k.Lock("example.com.")()
+
+	locked := make(chan struct{})
+	go func() {
+		defer k.Lock("example.org.")()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		t.Fatalf("the key was locked twice")
+	case <-time.After(10 * time.Millisecond):
+	}
+	unlock()
+	<-locked
+
+	// The mutexes are released once unused
+	time.Sleep(10 * time.Millisecond)
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if len(k.locks) != 0 {
+		t.Errorf("got %d mutexes, want 0", len(k.locks))
+	}
+}
+
+// lockCheckingRecordsClient counts the reads and writes of the SOA record made without the lock of the zone
+type lockCheckingRecordsClient struct {
+	*soaRecordsClient
+	unlocked int
+}
+
+func (c *lockCheckingRecordsClient) checkLocked(domain string) {
+	zoneLocks.mu.Lock()
+	defer zoneLocks.mu.Unlock()
+	if _, locked := zoneLocks.locks[strings.ToLower(makeCanonical(domain))]; !locked {
+		c.unlocked++
+	}
+}
+
+func (c *lockCheckingRecordsClient) Get(ctx context.Context, domain, name string, recordType *powerdns.RRType) ([]powerdns.RRset, error) {
+	c.checkLocked(domain)
+	return c.rrsets, nil
+}
+
+func (c *lockCheckingRecordsClient) Change(ctx context.Context, domain string, name string, recordType powerdns.RRType, ttl uint32, content []string, options ...func(*powerdns.RRset)) error {
+	c.checkLocked(domain)
+	return c.soaRecordsClient.Change(ctx, domain, name, recordType, ttl, content, options...)
+}
+
+func TestSOAReconcileZoneLock(t *testing.T) {
+	zoneName := "lock.org"
+	ctx := context.Background()
+	soaRecords := &soaRecordsClient{}
+	_ = soaRecords.Change(ctx, zoneName, makeCanonical(zoneName), powerdns.RRTypeSOA, 3600, []string{"ns1.lock.org. hostmaster.lock.org. 2025010101 10800 3600 604800 3600"})
+	records := &lockCheckingRecordsClient{soaRecordsClient: soaRecords}
+	pdnsClient := PdnsClienter{Records: records, Zones: soaZonesClient{records: soaRecords}}
+	zone := &dnsv1alpha2.Zone{
+		ObjectMeta: metav1.ObjectMeta{Name: zoneName, Namespace: "example"},
+		Spec: dnsv1alpha2.ZoneSpec{
+			Kind:           NATIVE_KIND_ZONE,
+			SOA:            &dnsv1alpha2.SOASpec{Refresh: ptr.To(uint32(7200))},
+			SerialStrategy: ptr.To(dnsv1alpha2.SERIAL_STRATEGY_INCREMENT),
+		},
+	}
+
+	var testCases = []struct {
+		description string
+		reconcile   func(context.Context, dnsv1alpha2.GenericZone, PdnsClienter, logr.Logger) error
+	}{
+		{"SOA parameters", soaExternalResourcesReconcile},
+		{"SOA serial", serialExternalResourcesReconcile},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			records.unlocked = 0
+			if err := tc.reconcile(ctx, zone, pdnsClient, logr.Discard()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if records.unlocked != 0 {
+				t.Errorf("got %d accesses to the SOA record without the zone lock, want 0", records.unlocked)
+			}
+		})
+	}
+}
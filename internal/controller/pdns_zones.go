@@ -0,0 +1,46 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"path"
+
+	"github.com/joeig/go-powerdns/v3"
+)
+
+// RectifyResult is the result of a zone rectify
+type RectifyResult struct {
+	Result *string `json:"result,omitempty"`
+}
+
+// ZonesClient extends the go-powerdns Zones service with the rectify of a zone
+type ZonesClient struct {
+	*powerdns.ZonesService
+	pdnsAPIRequester
+}
+
+// NewZonesClient initializes a ZonesClient sharing the configuration of the PowerDNS client
+func NewZonesClient(client *powerdns.Client, apiKey string, httpClient *http.Client) *ZonesClient {
+	return &ZonesClient{
+		ZonesService:     client.Zones,
+		pdnsAPIRequester: newPdnsAPIRequester(client, apiKey, httpClient),
+	}
+}
+
+// Rectify rectifies a zone: the ordername and auth fields used by DNSSEC are computed again
+func (c *ZonesClient) Rectify(ctx context.Context, domain string) (*RectifyResult, error) {
+	result := &RectifyResult{}
+	err := c.do(ctx, http.MethodPut, path.Join("zones", makeCanonical(domain), "rectify"), nil, result)
+	return result, err
+}
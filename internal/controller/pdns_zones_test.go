@@ -0,0 +1,65 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/joeig/go-powerdns/v3"
+	"k8s.io/utils/ptr"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+func TestZonesClient(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut && r.URL.Path == "/api/v1/servers/localhost/zones/example.org./rectify" {
+			_, _ = w.Write([]byte(`{"result": "Rectified"}`))
+			return
+		}
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"error": "Zone is presigned"}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	pdnsClient := powerdns.New(server.URL, "localhost", powerdns.WithAPIKey("secret"))
+	client := NewZonesClient(pdnsClient, "secret", server.Client())
+
+	result, err := client.Rectify(ctx, "example.org")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cmp.Equal(ptr.Deref(result.Result, ""), "Rectified") {
+		t.Errorf("got %v, want %v", ptr.Deref(result.Result, ""), "Rectified")
+	}
+
+	_, err = client.Rectify(ctx, "presigned.org")
+	if dnsv1alpha2.PDNSErrorStatusCode(err) != http.StatusUnprocessableEntity {
+		t.Errorf("got %v, want an unprocessable entity error", err)
+	}
+
+	expectedRequests := []string{
+		"PUT /api/v1/servers/localhost/zones/example.org./rectify",
+		"PUT /api/v1/servers/localhost/zones/presigned.org./rectify",
+	}
+	if !cmp.Equal(requests, expectedRequests) {
+		t.Errorf("got %v, want %v", requests, expectedRequests)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"hash/fnv"
+	"math"
+	"time"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// RetryBackoff defines when a resource in SynchronizationFailed status is synchronized again.
+// The delay grows exponentially with the number of consecutive failures, from Base up to Max, with a jitter.
+// A zero Base disables the retries: a failed resource is only reconciled again when modified.
+type RetryBackoff struct {
+	Base time.Duration
+	Max  time.Duration
+}
+
+// Delay returns the delay before retrying after failures consecutive failures.
+// The jitter (up to half of the delay) is derived from key, so that it is stable across reconciles
+// of the same resource while spreading the retries of different resources
+func (b RetryBackoff) Delay(failures int32, key string) time.Duration {
+	maxDelay := max(b.Max, b.Base)
+	delay := b.Base
+	for i := int32(1); i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxDelay)
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	jitter := time.Duration(float64(delay/2) * float64(h.Sum32()) / math.MaxUint32)
+	return delay - delay/2 + jitter
+}
+
+// retryAfter returns the remaining delay before retrying the synchronization of a failed resource,
+// and whether the resource must be retried now.
+// Only resources failing to synchronize with PowerDNS are retried, other failures (duplicated, missing zone, ...)
+// are resolved by the reconcile of the related resources
+func (b RetryBackoff) retryAfter(conditions []metav1.Condition, failureCount *int32, lastFailureTime *metav1.Time, key string, now time.Time) (time.Duration, bool) {
+	condition := meta.FindStatusCondition(conditions, "Available")
+	// Rate limited resources are requeued after the delay asked by PowerDNS API, see rateLimitedResult
+	if condition != nil && condition.Reason == dnsv1alpha2.PDNS_RATE_LIMITED_REASON {
+		return 0, true
+	}
+	if b.Base <= 0 || lastFailureTime == nil {
+		return 0, false
+	}
+	if condition == nil || !dnsv1alpha2.IsSynchronizationFailedReason(condition.Reason) {
+		return 0, false
+	}
+	remaining := lastFailureTime.Add(b.Delay(ptr.Deref(failureCount, 1), key)).Sub(now)
+	if remaining > 0 {
+		return remaining, false
+	}
+	return 0, true
+}
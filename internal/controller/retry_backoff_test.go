@@ -0,0 +1,107 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestRetryBackoffDelay(t *testing.T) {
+	backoff := RetryBackoff{Base: 10 * time.Second, Max: time.Minute}
+	var testCases = []struct {
+		description string
+		failures    int32
+		expectedMin time.Duration
+		expectedMax time.Duration
+	}{
+		{"First failure", 1, 5 * time.Second, 10 * time.Second},
+		{"Second failure", 2, 10 * time.Second, 20 * time.Second},
+		{"Third failure", 3, 20 * time.Second, 40 * time.Second},
+		{"Capped to maximum", 10, 30 * time.Second, time.Minute},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result := backoff.Delay(tc.failures, "uid")
+			if result < tc.expectedMin || result > tc.expectedMax {
+				t.Errorf("got %v, want between %v and %v", result, tc.expectedMin, tc.expectedMax)
+			}
+			if again := backoff.Delay(tc.failures, "uid"); again != result {
+				t.Errorf("got %v, want %v", again, result)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Now()
+	failed := []metav1.Condition{{Type: "Available", Status: metav1.ConditionFalse, Reason: dnsv1alpha2.SYNCHRONIZATION_FAILED_REASON}}
+	serverError := []metav1.Condition{{Type: "Available", Status: metav1.ConditionFalse, Reason: dnsv1alpha2.PDNS_SERVER_ERROR_REASON}}
+	rateLimited := []metav1.Condition{{Type: "Available", Status: metav1.ConditionFalse, Reason: dnsv1alpha2.PDNS_RATE_LIMITED_REASON}}
+	duplicated := []metav1.Condition{{Type: "Available", Status: metav1.ConditionFalse, Reason: dnsv1alpha2.DUPLICATED_REASON}}
+	backoff := RetryBackoff{Base: 10 * time.Second, Max: time.Minute}
+
+	var testCases = []struct {
+		description   string
+		backoff       RetryBackoff
+		conditions    []metav1.Condition
+		lastFailure   time.Time
+		expectedRetry bool
+		expectedWait  bool
+	}{
+		{"Disabled", RetryBackoff{}, failed, now.Add(-time.Hour), false, false},
+		{"Duplicated", backoff, duplicated, now.Add(-time.Hour), false, false},
+		{"Delay not elapsed", backoff, failed, now, false, true},
+		{"Delay elapsed", backoff, failed, now.Add(-time.Minute), true, false},
+		{"PowerDNS server error", backoff, serverError, now.Add(-time.Minute), true, false},
+		{"Rate limited", RetryBackoff{}, rateLimited, now, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			wait, retry := tc.backoff.retryAfter(tc.conditions, ptr.To(int32(1)), &metav1.Time{Time: tc.lastFailure}, "uid", now)
+			if retry != tc.expectedRetry {
+				t.Errorf("got %v, want %v", retry, tc.expectedRetry)
+			}
+			if (wait > 0) != tc.expectedWait {
+				t.Errorf("got %v, want a delay: %v", wait, tc.expectedWait)
+			}
+		})
+	}
+}
+
+func TestRetryAfterRRset(t *testing.T) {
+	backoff := RetryBackoff{Base: 10 * time.Second, Max: time.Minute}
+	// The RRset was last updated long before its synchronization failure
+	lastUpdateTime := &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	rrset := &dnsv1alpha2.RRset{}
+	rrset.SetSynchronizationFailed(lastUpdateTime, errors.New("connection refused"))
+
+	status := rrset.GetStatus()
+	if status.LastFailureTime == nil {
+		t.Fatalf("got %v, want a failure time", status.LastFailureTime)
+	}
+	if _, retry := backoff.retryAfter(status.Conditions, status.FailureCount, status.LastFailureTime, "uid", time.Now()); retry {
+		t.Errorf("got %v, want %v", retry, false)
+	}
+
+	rrset.SetAvailable(lastUpdateTime, "test.example.org.")
+	if status := rrset.GetStatus(); status.LastFailureTime != nil {
+		t.Errorf("got %v, want %v", status.LastFailureTime, nil)
+	}
+}
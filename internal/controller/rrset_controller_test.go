@@ -20,7 +20,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -350,6 +352,132 @@ var _ = Describe("RRset Controller", func() {
 		})
 	})
 
+	Context("When updating RRset", func() {
+		It("should notify the zone once for a burst of changes", Label("rrset-modification", "notify"), func() {
+			ctx := context.Background()
+
+			By("Enabling NOTIFY on changes on the zone")
+			zone := &dnsv1alpha2.Zone{}
+			Expect(k8sClient.Get(ctx, zoneLookupKey, zone)).To(Succeed())
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, zone, func() error {
+				zone.Spec.NotifyOnChange = ptr.To(true)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, zoneLookupKey, zone)
+				return err == nil && zone.Status.ObservedGeneration != nil && *zone.Status.ObservedGeneration == zone.Generation
+			}, timeout, interval).Should(BeTrue())
+			initialNotifies := getNotifiesCount(zoneName)
+
+			By("Updating RRset records twice")
+			resource := &dnsv1alpha2.RRset{}
+			for _, records := range [][]string{{"127.0.0.3"}, {"127.0.0.4"}} {
+				Expect(k8sClient.Get(ctx, rssetLookupKey, resource)).To(Succeed())
+				_, err = controllerutil.CreateOrUpdate(ctx, k8sClient, resource, func() error {
+					resource.Spec.Records = records
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(func() []string {
+					return getMockedRecordsForType(resourceName, resourceType)
+				}, timeout, interval).Should(Equal(records))
+			}
+
+			By("Verifying the zone has been notified once")
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, zoneLookupKey, zone)
+				return err == nil && zone.Status.LastNotifyTime != nil
+			}, timeout, interval).Should(BeTrue())
+			Expect(getNotifiesCount(zoneName)-initialNotifies).To(Equal(1), "Changes should have been coalesced into a single NOTIFY")
+		})
+	})
+
+	Context("When updating RRset", func() {
+		It("should maintain the PTR records in the reverse zone", Label("rrset-modification", "manage-reverse"), func() {
+			ctx := context.Background()
+			// Specific test variables
+			reverseZoneName := "0.0.127.in-addr.arpa"
+			reverseZoneLookupKey := types.NamespacedName{
+				Name:      reverseZoneName,
+				Namespace: zoneNamespace,
+			}
+			ptrName1 := "1.0.0.127.in-addr.arpa"
+			ptrName2 := "2.0.0.127.in-addr.arpa"
+
+			By("Enabling the reverse records without reverse zone")
+			resource := &dnsv1alpha2.RRset{}
+			Expect(k8sClient.Get(ctx, rssetLookupKey, resource)).To(Succeed())
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, resource, func() error {
+				resource.Spec.ManageReverse = ptr.To(true)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, rssetLookupKey, resource)
+				condition := meta.FindStatusCondition(resource.Status.Conditions, dnsv1alpha2.REVERSE_MANAGED_CONDITION)
+				return err == nil && condition != nil && condition.Reason == dnsv1alpha2.REVERSE_ZONE_MISSING_REASON
+			}, timeout, interval).Should(BeTrue())
+			Expect(*resource.Status.SyncStatus).To(Equal(dnsv1alpha2.SUCCEEDED_STATUS), "RRset status should be 'Succeeded'")
+			Expect(resource.Status.LastSyncTime).NotTo(BeNil(), "RRset last sync time should be set")
+
+			By("Creating the reverse zone")
+			reverseZone := &dnsv1alpha2.Zone{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      reverseZoneName,
+					Namespace: zoneNamespace,
+				},
+				Spec: dnsv1alpha2.ZoneSpec{
+					Kind:        zoneKind,
+					Nameservers: []string{zoneNS1, zoneNS2},
+				},
+			}
+			Expect(k8sClient.Create(ctx, reverseZone)).To(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, reverseZoneLookupKey, reverseZone)
+				return err == nil && reverseZone.Status.SyncStatus != nil && *reverseZone.Status.SyncStatus == dnsv1alpha2.SUCCEEDED_STATUS
+			}, timeout, interval).Should(BeTrue())
+
+			By("Updating RRset records")
+			Expect(k8sClient.Get(ctx, rssetLookupKey, resource)).To(Succeed())
+			_, err = controllerutil.CreateOrUpdate(ctx, k8sClient, resource, func() error {
+				resource.Spec.Records = []string{testRecord1}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() []string {
+				return getMockedRecordsForType(ptrName1, "PTR")
+			}, timeout, interval).Should(Equal([]string{resourceName + "."}))
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, rssetLookupKey, resource)
+				return err == nil && meta.IsStatusConditionTrue(resource.Status.Conditions, dnsv1alpha2.REVERSE_MANAGED_CONDITION)
+			}, timeout, interval).Should(BeTrue())
+			Expect(resource.Status.ReverseRecords).To(HaveLen(1))
+			Expect(getMockedRecordsForType(ptrName2, "PTR")).To(BeEmpty())
+
+			By("Disabling the reverse records")
+			_, err = controllerutil.CreateOrUpdate(ctx, k8sClient, resource, func() error {
+				resource.Spec.ManageReverse = nil
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() []string {
+				return getMockedRecordsForType(ptrName1, "PTR")
+			}, timeout, interval).Should(BeEmpty())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, rssetLookupKey, resource)
+				return err == nil && resource.Status.ReverseRecords == nil && meta.FindStatusCondition(resource.Status.Conditions, dnsv1alpha2.REVERSE_MANAGED_CONDITION) == nil
+			}, timeout, interval).Should(BeTrue())
+
+			By("Cleaning up the reverse zone")
+			Expect(k8sClient.Delete(ctx, reverseZone)).To(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, reverseZoneLookupKey, reverseZone)
+				return apierrors.IsNotFound(err)
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+
 	Context("When existing resource", func() {
 		It("should successfully recreate an existing rrset", Label("rrset-recreation"), func() {
 			ic := countRrsetsMetrics()
@@ -556,6 +684,70 @@ var _ = Describe("RRset Controller", func() {
 		})
 	})
 
+	Context("When the Zone is deleted in PowerDNS", func() {
+		It("should successfully delete its RRsets", Label("rrset-deletion", "zone-deletion"), func() {
+			ctx := context.Background()
+			// Specific test variables
+			childResourceDNSNames := []string{"child1", "child2", "child3"}
+
+			By("Creating several RRsets in the Zone")
+			for _, dnsName := range childResourceDNSNames {
+				child := &dnsv1alpha2.RRset{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      dnsName + "." + zoneName,
+						Namespace: resourceNamespace,
+					},
+				}
+				_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, child, func() error {
+					child.Spec = dnsv1alpha2.RRsetSpec{
+						Type:    resourceType,
+						Name:    dnsName,
+						TTL:     resourceTTL,
+						Records: resourceRecords,
+						ZoneRef: dnsv1alpha2.ZoneRef{
+							Name: zoneRef,
+							Kind: resourceZoneKind,
+						},
+					}
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(func() bool {
+					_, found := readFromRecordsMap(makeCanonical(dnsName + "." + zoneName))
+					return found
+				}, timeout, interval).Should(BeTrue())
+			}
+
+			By("Deleting the Zone directly in the mock")
+			// Wait all the reconciliation loop to be done before deleting the mock (backend) Zone
+			time.Sleep(2 * time.Second)
+			deleteFromZonesMap(makeCanonical(zoneName))
+
+			By("Deleting the RRsets")
+			for _, dnsName := range childResourceDNSNames {
+				child := &dnsv1alpha2.RRset{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      dnsName + "." + zoneName,
+						Namespace: resourceNamespace,
+					},
+				}
+				Expect(k8sClient.Delete(ctx, child)).To(Succeed())
+			}
+
+			By("Verifying the RRsets have been deleted without stuck finalizers")
+			for _, dnsName := range childResourceDNSNames {
+				childLookupKey := types.NamespacedName{
+					Name:      dnsName + "." + zoneName,
+					Namespace: resourceNamespace,
+				}
+				Eventually(func() bool {
+					err := k8sClient.Get(ctx, childLookupKey, &dnsv1alpha2.RRset{})
+					return apierrors.IsNotFound(err)
+				}, timeout, interval).Should(BeTrue())
+			}
+		})
+	})
+
 	Context("When creating RRset", func() {
 		It("should successfully reconcile the resource", Label("rrset-creation", "AAAA-Type"), func() {
 			ic := countRrsetsMetrics()
@@ -757,6 +949,137 @@ var _ = Describe("RRset Controller", func() {
 			Expect(createdResource.GetFinalizers()).To(ContainElement(RESOURCES_FINALIZER_NAME), "RRset should contain the finalizer")
 		})
 	})
+	Context("When a RRset is deleted out-of-band", func() {
+		It("should restore the records on next resynchronization", Label("rrset-modification", "resync"), func() {
+			ctx := context.Background()
+			// Specific test variables
+			resyncResourceName := "resync.example2.org"
+			resyncResourceDNSName := "resync"
+			resyncResourceType := "A"
+			resyncResourceRecords := []string{"192.168.1.130"}
+			resyncLookupKey := types.NamespacedName{
+				Name:      resyncResourceName,
+				Namespace: resourceNamespace,
+			}
+
+			By("Creating the RRset resource with a resynchronization interval")
+			resyncResource := &dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resyncResourceName,
+					Namespace: resourceNamespace,
+				},
+			}
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, resyncResource, func() error {
+				resyncResource.Spec = dnsv1alpha2.RRsetSpec{
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneRef,
+						Kind: resourceZoneKind,
+					},
+					Type:         resyncResourceType,
+					Name:         resyncResourceDNSName,
+					TTL:          resourceTTL,
+					Records:      resyncResourceRecords,
+					SyncInterval: &metav1.Duration{Duration: time.Second},
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			DnsFqdn := resyncResourceDNSName + "." + zoneName + "."
+			createdResource := &dnsv1alpha2.RRset{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, resyncLookupKey, createdResource)
+				return err == nil && createdResource.IsInExpectedStatus(FIRST_GENERATION, dnsv1alpha2.SUCCEEDED_STATUS, metav1.ConditionTrue)
+			}, timeout, interval).Should(BeTrue())
+			initialUpdateTime := createdResource.Status.LastUpdateTime
+
+			By("Deleting the RRset directly in the mock")
+			deleteFromRecordsMap(DnsFqdn)
+
+			By("Waiting for the RRset to be restored")
+			Eventually(func() []string {
+				return getMockedRecordsForType(DnsFqdn, resyncResourceType)
+			}, timeout, interval).Should(Equal(resyncResourceRecords))
+			restoredResource := &dnsv1alpha2.RRset{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, resyncLookupKey, restoredResource)
+				return err == nil && restoredResource.Status.LastUpdateTime != nil && restoredResource.Status.LastUpdateTime.After(initialUpdateTime.Time)
+			}, timeout, interval).Should(BeTrue(), "LastUpdateTime should be updated")
+
+			By("Deleting the RRset")
+			Expect(k8sClient.Delete(ctx, restoredResource)).To(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, resyncLookupKey, restoredResource)
+				return apierrors.IsNotFound(err)
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+	Context("When managing a wildcard RRset", func() {
+		It("should successfully create, update and delete the resource", Label("rrset-creation", "rrset-modification", "wildcard"), func() {
+			ctx := context.Background()
+			// Specific test variables
+			wildcardResourceName := "wildcard-a.example2.org"
+			wildcardResourceDNSName := "*.wildcard-a"
+			wildcardResourceType := "A"
+			wildcardResourceRecords := []string{"192.168.1.124"}
+			updatedRecords := []string{"192.168.1.125", "192.168.1.126"}
+			wildcardLookupKey := types.NamespacedName{
+				Name:      wildcardResourceName,
+				Namespace: resourceNamespace,
+			}
+
+			By("Creating the wildcard RRset resource")
+			wildcardResource := &dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      wildcardResourceName,
+					Namespace: resourceNamespace,
+				},
+			}
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, wildcardResource, func() error {
+				wildcardResource.Spec = dnsv1alpha2.RRsetSpec{
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneRef,
+						Kind: resourceZoneKind,
+					},
+					Type:    wildcardResourceType,
+					Name:    wildcardResourceDNSName,
+					TTL:     resourceTTL,
+					Records: wildcardResourceRecords,
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			DnsFqdn := wildcardResourceDNSName + "." + zoneName + "."
+			createdResource := &dnsv1alpha2.RRset{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, wildcardLookupKey, createdResource)
+				return err == nil && createdResource.IsInExpectedStatus(FIRST_GENERATION, dnsv1alpha2.SUCCEEDED_STATUS, metav1.ConditionTrue)
+			}, timeout, interval).Should(BeTrue())
+			Expect(getRRsetName(createdResource)).To(Equal(DnsFqdn), "Wildcard label should be kept")
+			Expect(getMockedRecordsForType(DnsFqdn, wildcardResourceType)).To(Equal(wildcardResourceRecords))
+
+			By("Updating the wildcard RRset records")
+			_, err = controllerutil.CreateOrUpdate(ctx, k8sClient, wildcardResource, func() error {
+				wildcardResource.Spec.Records = updatedRecords
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			updatedResource := &dnsv1alpha2.RRset{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, wildcardLookupKey, updatedResource)
+				return err == nil && updatedResource.IsInExpectedStatus(MODIFIED_GENERATION, dnsv1alpha2.SUCCEEDED_STATUS, metav1.ConditionTrue)
+			}, timeout, interval).Should(BeTrue())
+			Expect(getMockedRecordsForType(DnsFqdn, wildcardResourceType)).To(Equal(updatedRecords))
+
+			By("Deleting the wildcard RRset")
+			Expect(k8sClient.Delete(ctx, wildcardResource)).To(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, wildcardLookupKey, wildcardResource)
+				return apierrors.IsNotFound(err)
+			}, timeout, interval).Should(BeTrue())
+			_, ok := readFromRecordsMap(DnsFqdn)
+			Expect(ok).To(BeFalse(), "Wildcard RRset should be deleted from the backend")
+		})
+	})
 	Context("When creating RRset", func() {
 		It("should successfully reconcile the resource", Label("rrset-creation", "MX-Type"), func() {
 			ic := countRrsetsMetrics()
@@ -1375,6 +1698,120 @@ var _ = Describe("RRset Controller", func() {
 		})
 	})
 
+	Context("When creating a CNAME RRset with an existing RRset with same FQDN", func() {
+		It("should reconcile the resource with Failed status", Label("wrong-rrset", "cname-conflict"), func() {
+			ctx := context.Background()
+			// Specific test variables
+			conflictResourceName := "cname-conflict.example2.org"
+			conflictResourceNamespace := zoneNamespace
+			conflictResourceDNSName := resourceDNSName
+			conflictResourceType := "CNAME"
+			conflictResourceRecords := []string{"target.example2.org."}
+
+			By("Creating the RRset resource")
+			conflictResource := &dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      conflictResourceName,
+					Namespace: conflictResourceNamespace,
+				},
+			}
+			conflictResource.SetResourceVersion("")
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, conflictResource, func() error {
+				conflictResource.Spec = dnsv1alpha2.RRsetSpec{
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneName,
+						Kind: resourceZoneKind,
+					},
+					Type:    conflictResourceType,
+					Name:    conflictResourceDNSName,
+					TTL:     resourceTTL,
+					Records: conflictResourceRecords,
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			conflictRRsetLookupKey := types.NamespacedName{
+				Name:      conflictResourceName,
+				Namespace: conflictResourceNamespace,
+			}
+
+			By("Getting the created resource")
+			createdResource := &dnsv1alpha2.RRset{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, conflictRRsetLookupKey, createdResource)
+				return err == nil && createdResource.IsInExpectedStatus(FIRST_GENERATION, dnsv1alpha2.FAILED_STATUS, metav1.ConditionFalse)
+			}, timeout, interval).Should(BeTrue())
+
+			Expect(meta.FindStatusCondition(createdResource.Status.Conditions, "Available").Reason).To(Equal(dnsv1alpha2.CNAME_CONFLICT_REASON), "RRset should be in CNAME conflict")
+			backendRRset, found := readFromRecordsMap(makeCanonical(resourceDNSName + "." + zoneName))
+			Expect(found).To(BeTrue())
+			Expect(string(*backendRRset.Type)).To(Equal(resourceType), "CNAME should not be created in the backend")
+
+			By("Ensuring the existing RRset is still available")
+			existingResource := &dnsv1alpha2.RRset{}
+			Expect(k8sClient.Get(ctx, rssetLookupKey, existingResource)).To(Succeed())
+			Expect(*existingResource.Status.SyncStatus).To(Equal(dnsv1alpha2.SUCCEEDED_STATUS), "existing RRset status should be 'Succeeded'")
+
+			By("Cleaning up the CNAME RRset")
+			Expect(k8sClient.Delete(ctx, createdResource)).To(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, conflictRRsetLookupKey, createdResource)
+				return apierrors.IsNotFound(err)
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+
+	Context("When creating a CNAME RRset at the zone apex", func() {
+		It("should reconcile the resource with Failed status", Label("wrong-rrset", "apex-cname"), func() {
+			ctx := context.Background()
+			// Specific test variables
+			apexResourceName := "apex.example2.org"
+			apexResourceNamespace := zoneNamespace
+
+			By("Creating the RRset resource")
+			apexResource := &dnsv1alpha2.RRset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      apexResourceName,
+					Namespace: apexResourceNamespace,
+				},
+			}
+			apexResource.SetResourceVersion("")
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, apexResource, func() error {
+				apexResource.Spec = dnsv1alpha2.RRsetSpec{
+					ZoneRef: dnsv1alpha2.ZoneRef{
+						Name: zoneName,
+						Kind: resourceZoneKind,
+					},
+					Type:    "CNAME",
+					Name:    zoneName + ".",
+					TTL:     resourceTTL,
+					Records: []string{"target.example2.org."},
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			apexRRsetLookupKey := types.NamespacedName{
+				Name:      apexResourceName,
+				Namespace: apexResourceNamespace,
+			}
+
+			By("Getting the created resource")
+			createdResource := &dnsv1alpha2.RRset{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, apexRRsetLookupKey, createdResource)
+				return err == nil && createdResource.IsInExpectedStatus(FIRST_GENERATION, dnsv1alpha2.FAILED_STATUS, metav1.ConditionFalse)
+			}, timeout, interval).Should(BeTrue())
+			Expect(meta.FindStatusCondition(createdResource.Status.Conditions, "Available").Reason).To(Equal(dnsv1alpha2.CNAME_CONFLICT_REASON), "RRset should be in CNAME conflict")
+
+			By("Cleaning up the CNAME RRset")
+			Expect(k8sClient.Delete(ctx, createdResource)).To(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, apexRRsetLookupKey, createdResource)
+				return apierrors.IsNotFound(err)
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+
 	Context("When creating a RRset with a non-existing Zone", func() {
 		It("should reconcile the resource with Pending status", Label("pending-rrset", "non-existing-zone"), func() {
 			ic := countRrsetsMetrics()
@@ -0,0 +1,56 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"hash/fnv"
+	"math"
+	"sync"
+	"time"
+)
+
+// StartupJitter spreads the first reconcile of the resources already synchronized with PowerDNS over Window
+// after the start of the operator, so that a restart does not reconcile every resource at once.
+// A nil StartupJitter or a zero Window disables it.
+type StartupJitter struct {
+	Window time.Duration
+
+	once  sync.Once
+	start time.Time
+	seen  sync.Map
+}
+
+// NewStartupJitter returns a StartupJitter spreading the first reconciles over window
+func NewStartupJitter(window time.Duration) *StartupJitter {
+	return &StartupJitter{Window: window}
+}
+
+// Delay returns the delay of the first reconcile of the resource identified by key, 0 when it must be reconciled now.
+// The window starts with the first reconcile of the controller, each resource is delayed at most once, by an offset
+// derived from key so that the resources are evenly spread over the window.
+func (j *StartupJitter) Delay(key string, now time.Time) time.Duration {
+	if j == nil || j.Window <= 0 {
+		return 0
+	}
+	j.once.Do(func() { j.start = now })
+	if now.Sub(j.start) >= j.Window {
+		return 0
+	}
+	if _, delayed := j.seen.LoadOrStore(key, struct{}{}); delayed {
+		return 0
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	offset := time.Duration(float64(j.Window) * float64(h.Sum32()) / math.MaxUint32)
+	return max(j.start.Add(offset).Sub(now), 0)
+}
@@ -0,0 +1,67 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestStartupJitterDelay(t *testing.T) {
+	now := time.Now()
+	window := time.Minute
+	jitter := NewStartupJitter(window)
+
+	first := jitter.Delay("uid", now)
+	if first < 0 || first >= window {
+		t.Errorf("got %v, want between 0 and %v", first, window)
+	}
+	// A resource is only delayed once
+	if again := jitter.Delay("uid", now.Add(time.Second)); again != 0 {
+		t.Errorf("got %v, want %v", again, time.Duration(0))
+	}
+	// Resources are not delayed after the window
+	if after := jitter.Delay("other-uid", now.Add(window)); after != 0 {
+		t.Errorf("got %v, want %v", after, time.Duration(0))
+	}
+
+	var disabled *StartupJitter
+	if delay := disabled.Delay("uid", now); delay != 0 {
+		t.Errorf("got %v, want %v", delay, time.Duration(0))
+	}
+	if delay := NewStartupJitter(0).Delay("uid", now); delay != 0 {
+		t.Errorf("got %v, want %v", delay, time.Duration(0))
+	}
+}
+
+func TestStartupJitterSpread(t *testing.T) {
+	const resources = 5000
+	now := time.Now()
+	window := 50 * time.Second
+	jitter := NewStartupJitter(window)
+
+	// Count the reconciles per second: without jitter, all the resources are reconciled in the first second
+	perSecond := map[int64]int{}
+	for i := range resources {
+		delay := jitter.Delay(fmt.Sprintf("uid-%d", i), now)
+		perSecond[int64(delay/time.Second)]++
+	}
+	peak := 0
+	for _, count := range perSecond {
+		peak = max(peak, count)
+	}
+	// Evenly spread, each second gets 100 reconciles
+	if peak > 2*resources/int(window/time.Second) {
+		t.Errorf("got a peak of %d reconciles per second, want at most %d", peak, 2*resources/int(window/time.Second))
+	}
+}
@@ -13,6 +13,7 @@ package controller
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -58,8 +59,17 @@ var (
 )
 
 var (
-	zones   sync.Map
-	records sync.Map
+	zones      sync.Map
+	records    sync.Map
+	cryptokeys sync.Map
+	metadata   sync.Map
+	tsigkeys   sync.Map
+	// Number of AXFR retrieves per zone
+	axfrRetrieves sync.Map
+	// Number of NOTIFY per zone
+	notifies sync.Map
+	// Number of rectifies per zone
+	rectifies sync.Map
 )
 
 const (
@@ -144,6 +154,75 @@ func resetRecordsMap() {
 	records.Clear()
 }
 
+// writeToCryptokeysMap stores the Cryptokeys of a zone in the Cryptokeys sync.Map
+func writeToCryptokeysMap(key string, value []powerdns.Cryptokey) {
+	result, err := json.Marshal(value)
+	if err != nil {
+		GinkgoLogr.Error(err, "error while marshalling cryptokeys")
+	}
+	cryptokeys.Store(key, result)
+}
+
+// readFromCryptokeysMap retrieves the Cryptokeys of a zone from the Cryptokeys sync.Map
+func readFromCryptokeysMap(key string) []powerdns.Cryptokey {
+	result := []powerdns.Cryptokey{}
+	value, ok := cryptokeys.Load(key)
+	if !ok {
+		return result
+	}
+	valueByte, _ := value.([]byte)
+	err := json.Unmarshal(valueByte, &result)
+	if err != nil {
+		GinkgoLogr.Error(err, "error while unmarshalling cryptokeys")
+	}
+	return result
+}
+
+// resetCryptokeysMap removes all entries from the Cryptokeys sync.Map
+func resetCryptokeysMap() {
+	cryptokeys.Clear()
+}
+
+// writeToMetadataMap stores the values of a zone metadata kind in the Metadata sync.Map
+func writeToMetadataMap(domain string, kind powerdns.MetadataKind, values []string) {
+	metadata.Store(makeCanonical(domain)+"/"+string(kind), values)
+}
+
+// readFromMetadataMap retrieves the values of a zone metadata kind from the Metadata sync.Map
+func readFromMetadataMap(domain string, kind powerdns.MetadataKind) ([]string, bool) {
+	value, ok := metadata.Load(makeCanonical(domain) + "/" + string(kind))
+	if !ok {
+		return nil, false
+	}
+	values, _ := value.([]string)
+	return values, true
+}
+
+// resetMetadataMap removes all entries from the Metadata sync.Map
+func resetMetadataMap() {
+	metadata.Clear()
+}
+
+// writeToTSIGKeysMap stores a TSIG key in the TSIGKeys sync.Map
+func writeToTSIGKeysMap(key string, value *powerdns.TSIGKey) {
+	tsigkeys.Store(key, *value)
+}
+
+// readFromTSIGKeysMap retrieves a TSIG key from the TSIGKeys sync.Map
+func readFromTSIGKeysMap(key string) (*powerdns.TSIGKey, bool) {
+	value, ok := tsigkeys.Load(key)
+	if !ok {
+		return nil, false
+	}
+	tsigKey, _ := value.(powerdns.TSIGKey)
+	return &tsigKey, true
+}
+
+// resetTSIGKeysMap removes all entries from the TSIGKeys sync.Map
+func resetTSIGKeysMap() {
+	tsigkeys.Clear()
+}
+
 func TestControllers(t *testing.T) {
 	RegisterFailHandler(Fail)
 
@@ -191,42 +270,88 @@ var _ = BeforeSuite(func() {
 
 	// Initialize mockClient
 	m := NewMockClient()
+	zoneNotifier := &ZoneNotifier{
+		Client:     k8sManager.GetClient(),
+		PDNSClient: PdnsClienter{Zones: m.Zones},
+		Window:     time.Second,
+	}
 	err = (&RRsetReconciler{
-		Client: k8sManager.GetClient(),
-		Scheme: k8sManager.GetScheme(),
+		Client:   k8sManager.GetClient(),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorder("rrset-controller"),
 		PDNSClient: PdnsClienter{
-			Records: m.Records,
-			Zones:   m.Zones,
+			Records:  m.Records,
+			Zones:    m.Zones,
+			Metadata: m.Metadata,
 		},
+		Notifier: zoneNotifier,
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&ClusterRRsetReconciler{
+		Client:   k8sManager.GetClient(),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorder("clusterrrset-controller"),
+		PDNSClient: PdnsClienter{
+			Records:  m.Records,
+			Zones:    m.Zones,
+			Metadata: m.Metadata,
+		},
+		Notifier: zoneNotifier,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&ZoneReconciler{
+		Client:   k8sManager.GetClient(),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorder("zone-controller"),
+		PDNSClient: PdnsClienter{
+			Records:  m.Records,
+			Zones:    m.Zones,
+			Metadata: m.Metadata,
+		},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&ClusterZoneReconciler{
+		Client:   k8sManager.GetClient(),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorder("clusterzone-controller"),
+		PDNSClient: PdnsClienter{
+			Records:  m.Records,
+			Zones:    m.Zones,
+			Metadata: m.Metadata,
+		},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&CryptokeyReconciler{
 		Client: k8sManager.GetClient(),
 		Scheme: k8sManager.GetScheme(),
 		PDNSClient: PdnsClienter{
-			Records: m.Records,
-			Zones:   m.Zones,
+			Records:    m.Records,
+			Zones:      m.Zones,
+			Cryptokeys: m.Cryptokeys,
+			Metadata:   m.Metadata,
 		},
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
-	err = (&ZoneReconciler{
+	err = (&TSIGKeyReconciler{
 		Client: k8sManager.GetClient(),
 		Scheme: k8sManager.GetScheme(),
 		PDNSClient: PdnsClienter{
-			Records: m.Records,
-			Zones:   m.Zones,
+			TSIGKeys: m.TSIGKeys,
 		},
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
-	err = (&ClusterZoneReconciler{
+	err = (&DNSSECMaintenanceReconciler{
 		Client: k8sManager.GetClient(),
 		Scheme: k8sManager.GetScheme(),
 		PDNSClient: PdnsClienter{
-			Records: m.Records,
-			Zones:   m.Zones,
+			Zones:      m.Zones,
+			Cryptokeys: m.Cryptokeys,
 		},
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
@@ -251,6 +376,8 @@ var _ = BeforeSuite(func() {
 		"example5",
 		"example6",
 		"example7",
+		"example8",
+		"example9",
 	}
 
 	for _, n := range namespaces {
@@ -298,17 +425,26 @@ func getFirstFoundEnvTestBinaryDir() string {
 }
 
 type mockClient struct {
-	Zones   mockZonesClient
-	Records mockRecordsClient
+	Zones      mockZonesClient
+	Records    mockRecordsClient
+	Cryptokeys mockCryptokeysClient
+	Metadata   mockMetadataClient
+	TSIGKeys   mockTSIGKeysClient
 }
 
 type mockZonesClient struct{}
 type mockRecordsClient struct{}
+type mockCryptokeysClient struct{}
+type mockMetadataClient struct{}
+type mockTSIGKeysClient struct{}
 
 func NewMockClient() mockClient {
 	return mockClient{
-		Zones:   mockZonesClient{},
-		Records: mockRecordsClient{},
+		Zones:      mockZonesClient{},
+		Records:    mockRecordsClient{},
+		Cryptokeys: mockCryptokeysClient{},
+		Metadata:   mockMetadataClient{},
+		TSIGKeys:   mockTSIGKeysClient{},
 	}
 }
 
@@ -339,6 +475,10 @@ func (m mockZonesClient) Add(ctx context.Context, zone *powerdns.Zone) (*powerdn
 		serial = uint32(now.Year())*1000000 + uint32((now.Month()))*10000 + uint32(now.Day())*100 + 1
 	}
 	zone.Serial = &serial
+	// PowerDNS reports a presigned zone as signed
+	if ptr.Deref(zone.Presigned, false) {
+		zone.DNSsec = ptr.To(true)
+	}
 
 	// RRset type NS creation
 	zoneCanonicalName := makeCanonical(*zone.Name)
@@ -426,11 +566,80 @@ func (m mockZonesClient) Change(ctx context.Context, domain string, zone *powerd
 		}
 	}
 	zone.Serial = serial
+	if ptr.Deref(zone.Presigned, false) {
+		zone.DNSsec = ptr.To(true)
+	}
 
 	writeToZonesMap(makeCanonical(domain), zone)
 	return nil
 }
 
+func (m mockZonesClient) AxfrRetrieve(ctx context.Context, domain string) (*powerdns.AxfrRetrieveResult, error) {
+	if _, ok := readFromZonesMap(makeCanonical(domain)); !ok {
+		return nil, powerdns.Error{StatusCode: ZONE_NOT_FOUND_CODE, Status: fmt.Sprintf("%d %s", ZONE_NOT_FOUND_CODE, ZONE_NOT_FOUND_MSG), Message: ZONE_NOT_FOUND_MSG}
+	}
+	count, _ := axfrRetrieves.LoadOrStore(makeCanonical(domain), 0)
+	axfrRetrieves.Store(makeCanonical(domain), count.(int)+1)
+	return &powerdns.AxfrRetrieveResult{Result: ptr.To("Added retrieval request for '" + makeCanonical(domain) + "' from primary")}, nil
+}
+
+func (m mockZonesClient) Rectify(ctx context.Context, domain string) (*RectifyResult, error) {
+	if _, ok := readFromZonesMap(makeCanonical(domain)); !ok {
+		return nil, powerdns.Error{StatusCode: ZONE_NOT_FOUND_CODE, Status: fmt.Sprintf("%d %s", ZONE_NOT_FOUND_CODE, ZONE_NOT_FOUND_MSG), Message: ZONE_NOT_FOUND_MSG}
+	}
+	count, _ := rectifies.LoadOrStore(makeCanonical(domain), 0)
+	rectifies.Store(makeCanonical(domain), count.(int)+1)
+	return &RectifyResult{Result: ptr.To("Rectified")}, nil
+}
+
+func (m mockZonesClient) Notify(ctx context.Context, domain string) (*powerdns.NotifyResult, error) {
+	if _, ok := readFromZonesMap(makeCanonical(domain)); !ok {
+		return nil, powerdns.Error{StatusCode: ZONE_NOT_FOUND_CODE, Status: fmt.Sprintf("%d %s", ZONE_NOT_FOUND_CODE, ZONE_NOT_FOUND_MSG), Message: ZONE_NOT_FOUND_MSG}
+	}
+	count, _ := notifies.LoadOrStore(makeCanonical(domain), 0)
+	notifies.Store(makeCanonical(domain), count.(int)+1)
+	return &powerdns.NotifyResult{Result: ptr.To("Notification queued")}, nil
+}
+
+func (m mockZonesClient) Export(ctx context.Context, domain string) (powerdns.Export, error) {
+	zone, ok := readFromZonesMap(makeCanonical(domain))
+	if !ok {
+		return "", powerdns.Error{StatusCode: ZONE_NOT_FOUND_CODE, Status: fmt.Sprintf("%d %s", ZONE_NOT_FOUND_CODE, ZONE_NOT_FOUND_MSG), Message: ZONE_NOT_FOUND_MSG}
+	}
+	export := fmt.Sprintf("%s\t3600\tIN\tSOA\ta.misconfigured.dns.server.invalid. hostmaster.%s %d 10800 3600 604800 3600\n", makeCanonical(domain), makeCanonical(domain), ptr.Deref(zone.Serial, 0))
+	for _, ns := range zone.Nameservers {
+		export += fmt.Sprintf("%s\t%d\tIN\tNS\t%s\n", makeCanonical(domain), DEFAULT_TTL_FOR_NS_RECORDS, makeCanonical(ns))
+	}
+	return powerdns.Export(export), nil
+}
+
+// getNotifiesCount returns the number of NOTIFY sent for a zone
+func getNotifiesCount(domain string) int {
+	count, ok := notifies.Load(makeCanonical(domain))
+	if !ok {
+		return 0
+	}
+	return count.(int)
+}
+
+// getRectifiesCount returns the number of rectifies requested for a zone
+func getRectifiesCount(domain string) int {
+	count, ok := rectifies.Load(makeCanonical(domain))
+	if !ok {
+		return 0
+	}
+	return count.(int)
+}
+
+// getAxfrRetrievesCount returns the number of AXFR retrieves requested for a zone
+func getAxfrRetrievesCount(domain string) int {
+	count, ok := axfrRetrieves.Load(makeCanonical(domain))
+	if !ok {
+		return 0
+	}
+	return count.(int)
+}
+
 func (m mockRecordsClient) Get(ctx context.Context, domain string, name string, recordType *powerdns.RRType) ([]powerdns.RRset, error) {
 	results := []powerdns.RRset{}
 	if record, ok := readFromRecordsMap(makeCanonical(name)); ok {
@@ -480,19 +689,15 @@ func (m mockRecordsClient) Change(ctx context.Context, domain string, name strin
 
 	var isRRsetIdentical, isNewRRset, ok bool
 	var rrset *powerdns.RRset
-	var comment, specifiedComment string
 
-	// The specified comment is included inside the opt function (through .WithComments)
-	// So to extract it, we need to apply opt() function on an empty RRSet
+	// The specified comments are included inside the opt function (through .WithComments)
+	// So to extract them, we need to apply opt() function on an empty RRSet
 	fakeRrset := &powerdns.RRset{
 		Comments: []powerdns.Comment{},
 	}
 	for _, opt := range options {
 		opt(fakeRrset)
 	}
-	if len(fakeRrset.Comments) > 0 {
-		specifiedComment = *fakeRrset.Comments[0].Content
-	}
 
 	if rrset, ok = readFromRecordsMap(makeCanonical(name)); !ok {
 		rrset = &powerdns.RRset{}
@@ -506,10 +711,7 @@ func (m mockRecordsClient) Change(ctx context.Context, domain string, name strin
 			localRecords = append(localRecords, *r.Content)
 		}
 
-		for _, c := range rrset.Comments {
-			comment = *c.Content
-		}
-		isRRsetIdentical = reflect.DeepEqual(localRecords, content) && reflect.DeepEqual(*rrset.TTL, ttl) && reflect.DeepEqual(comment, specifiedComment)
+		isRRsetIdentical = reflect.DeepEqual(localRecords, content) && reflect.DeepEqual(*rrset.TTL, ttl) && (len(rrset.Comments)+len(fakeRrset.Comments) == 0 || reflect.DeepEqual(rrset.Comments, fakeRrset.Comments))
 	}
 
 	rrset.Name = &name
@@ -517,10 +719,7 @@ func (m mockRecordsClient) Change(ctx context.Context, domain string, name strin
 	rrset.TTL = &ttl
 	rrset.ChangeType = powerdns.ChangeTypePtr(powerdns.ChangeTypeReplace)
 	rrset.Records = make([]powerdns.Record, 0)
-	rrset.Comments = []powerdns.Comment{}
-	if specifiedComment != "" {
-		rrset.Comments = append(rrset.Comments, powerdns.Comment{Content: &specifiedComment})
-	}
+	rrset.Comments = fakeRrset.Comments
 
 	for _, c := range content {
 		localContent := c
@@ -540,10 +739,107 @@ func (m mockRecordsClient) Change(ctx context.Context, domain string, name strin
 }
 
 func (m mockRecordsClient) Delete(ctx context.Context, domain string, name string, recordType powerdns.RRType) error {
+	if _, ok := readFromZonesMap(makeCanonical(domain)); !ok {
+		return powerdns.Error{StatusCode: ZONE_NOT_FOUND_CODE, Status: fmt.Sprintf("%d %s", ZONE_NOT_FOUND_CODE, ZONE_NOT_FOUND_MSG), Message: ZONE_NOT_FOUND_MSG}
+	}
 	deleteFromRecordsMap(makeCanonical(name))
 	return nil
 }
 
+func (m mockRecordsClient) Patch(ctx context.Context, domain string, rrSets *powerdns.RRsets) error {
+	for _, rrset := range rrSets.Sets {
+		var err error
+		if rrset.ChangeType != nil && *rrset.ChangeType == powerdns.ChangeTypeDelete {
+			err = m.Delete(ctx, domain, *rrset.Name, *rrset.Type)
+		} else {
+			content := make([]string, 0, len(rrset.Records))
+			for _, r := range rrset.Records {
+				content = append(content, *r.Content)
+			}
+			err = m.Change(ctx, domain, *rrset.Name, *rrset.Type, *rrset.TTL, content, powerdns.WithComments(rrset.Comments...))
+			// Keep the disabled flags of the records, which are reset by Change
+			if stored, ok := readFromRecordsMap(makeCanonical(*rrset.Name)); ok && err == nil {
+				for i := range stored.Records {
+					stored.Records[i].Disabled = ptr.To(ptr.Deref(rrset.Records[i].Disabled, false))
+				}
+				writeToRecordsMap(makeCanonical(*rrset.Name), stored)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m mockCryptokeysClient) List(ctx context.Context, domain string) ([]powerdns.Cryptokey, error) {
+	return readFromCryptokeysMap(makeCanonical(domain)), nil
+}
+
+func (m mockCryptokeysClient) Get(ctx context.Context, domain string, id uint64) (*powerdns.Cryptokey, error) {
+	for _, c := range readFromCryptokeysMap(makeCanonical(domain)) {
+		if *c.ID == id {
+			return &c, nil
+		}
+	}
+	return &powerdns.Cryptokey{}, &powerdns.Error{StatusCode: ZONE_NOT_FOUND_CODE, Status: fmt.Sprintf("%d %s", ZONE_NOT_FOUND_CODE, ZONE_NOT_FOUND_MSG), Message: ZONE_NOT_FOUND_MSG}
+}
+
+func (m mockCryptokeysClient) Add(ctx context.Context, domain string, cryptokey *powerdns.Cryptokey) (*powerdns.Cryptokey, error) {
+	// Specific behaviour to
+	// for "fake" domain, return an error
+	if makeCanonical(domain) == makeCanonical(FAKE_SITE) {
+		return &powerdns.Cryptokey{}, &powerdns.Error{
+			StatusCode: 500,
+			Status:     "500 Internal Server Error",
+			Message:    "Internal Server Error",
+		}
+	}
+
+	keys := readFromCryptokeysMap(makeCanonical(domain))
+	id := uint64(1)
+	for _, c := range keys {
+		id = max(id, *c.ID+1)
+	}
+	flags := "256"
+	var ds []string
+	if *cryptokey.KeyType != "zsk" {
+		flags = "257"
+		ds = []string{fmt.Sprintf("%d 13 2 0123456789ABCDEF", 10000+id)}
+	}
+	key := powerdns.Cryptokey{
+		Type:      ptr.To("Cryptokey"),
+		ID:        &id,
+		KeyType:   cryptokey.KeyType,
+		Active:    ptr.To(ptr.Deref(cryptokey.Active, false)),
+		DNSkey:    ptr.To(flags + " 3 13 bW9ja2Vk"),
+		DS:        ds,
+		Algorithm: ptr.To(ptr.Deref(cryptokey.Algorithm, "ECDSAP256SHA256")),
+		Bits:      ptr.To(ptr.Deref(cryptokey.Bits, 256)),
+	}
+	writeToCryptokeysMap(makeCanonical(domain), append(keys, key))
+	return &key, nil
+}
+
+func (m mockCryptokeysClient) Change(ctx context.Context, domain string, id uint64, active bool) error {
+	keys := readFromCryptokeysMap(makeCanonical(domain))
+	for i := range keys {
+		if *keys[i].ID == id {
+			keys[i].Active = &active
+			writeToCryptokeysMap(makeCanonical(domain), keys)
+			return nil
+		}
+	}
+	return &powerdns.Error{StatusCode: ZONE_NOT_FOUND_CODE, Status: fmt.Sprintf("%d %s", ZONE_NOT_FOUND_CODE, ZONE_NOT_FOUND_MSG), Message: ZONE_NOT_FOUND_MSG}
+}
+
+func (m mockCryptokeysClient) Delete(ctx context.Context, domain string, id uint64) error {
+	keys := readFromCryptokeysMap(makeCanonical(domain))
+	keys = slices.DeleteFunc(keys, func(c powerdns.Cryptokey) bool { return *c.ID == id })
+	writeToCryptokeysMap(makeCanonical(domain), keys)
+	return nil
+}
+
 func getMockedNameservers(zoneName string) (result []string) {
 	rrset, _ := readFromRecordsMap(makeCanonical(zoneName))
 	for _, r := range rrset.Records {
@@ -601,3 +897,90 @@ func getMockedSOAEditAPI(zoneName string) (result string) {
 	result = ptr.Deref(zone.SOAEditAPI, "")
 	return
 }
+
+func (m mockMetadataClient) List(ctx context.Context, domain string) ([]powerdns.Metadata, error) {
+	var result []powerdns.Metadata
+	prefix := makeCanonical(domain) + "/"
+	metadata.Range(func(key, value any) bool {
+		if kind, ok := strings.CutPrefix(key.(string), prefix); ok {
+			result = append(result, powerdns.Metadata{Kind: powerdns.MetadataKindPtr(powerdns.MetadataKind(kind)), Metadata: value.([]string)})
+		}
+		return true
+	})
+	return result, nil
+}
+
+func (m mockMetadataClient) Get(ctx context.Context, domain string, kind powerdns.MetadataKind) (*powerdns.Metadata, error) {
+	values, _ := readFromMetadataMap(domain, kind)
+	return &powerdns.Metadata{Kind: &kind, Metadata: values}, nil
+}
+
+func (m mockMetadataClient) Set(ctx context.Context, domain string, kind powerdns.MetadataKind, values []string) (*powerdns.Metadata, error) {
+	if _, ok := readFromZonesMap(makeCanonical(domain)); !ok {
+		return nil, powerdns.Error{StatusCode: ZONE_NOT_FOUND_CODE, Status: fmt.Sprintf("%d %s", ZONE_NOT_FOUND_CODE, ZONE_NOT_FOUND_MSG), Message: ZONE_NOT_FOUND_MSG}
+	}
+	writeToMetadataMap(domain, kind, values)
+	return &powerdns.Metadata{Kind: &kind, Metadata: values}, nil
+}
+
+func (m mockMetadataClient) Delete(ctx context.Context, domain string, kind powerdns.MetadataKind) error {
+	metadata.Delete(makeCanonical(domain) + "/" + string(kind))
+	return nil
+}
+
+func (m mockTSIGKeysClient) Get(ctx context.Context, id string) (*powerdns.TSIGKey, error) {
+	if tsigKey, ok := readFromTSIGKeysMap(id); ok {
+		return tsigKey, nil
+	}
+	return nil, powerdns.Error{StatusCode: 404, Status: "404 Not Found", Message: "Not Found"}
+}
+
+func (m mockTSIGKeysClient) Create(ctx context.Context, name, algorithm, key string) (*powerdns.TSIGKey, error) {
+	id := makeCanonical(name)
+	if _, ok := readFromTSIGKeysMap(id); ok {
+		return nil, powerdns.Error{StatusCode: 409, Status: "409 Conflict", Message: "A TSIG key with the name '" + name + "' already exists"}
+	}
+	// Empty key is generated by PowerDNS
+	if key == "" {
+		key = base64.StdEncoding.EncodeToString([]byte(name + algorithm))
+	}
+	tsigKey := &powerdns.TSIGKey{
+		Name:      &name,
+		ID:        &id,
+		Algorithm: &algorithm,
+		Key:       &key,
+		Type:      ptr.To("TSIGKey"),
+	}
+	writeToTSIGKeysMap(id, tsigKey)
+	return tsigKey, nil
+}
+
+func (m mockTSIGKeysClient) Change(ctx context.Context, id string, newKey powerdns.TSIGKey) (*powerdns.TSIGKey, error) {
+	tsigKey, ok := readFromTSIGKeysMap(id)
+	if !ok {
+		return nil, powerdns.Error{StatusCode: 404, Status: "404 Not Found", Message: "Not Found"}
+	}
+	// PowerDNS changes the ID of a renamed key
+	if newKey.Name != nil && makeCanonical(*newKey.Name) != id {
+		tsigkeys.Delete(id)
+		tsigKey.Name = newKey.Name
+		tsigKey.ID = ptr.To(makeCanonical(*newKey.Name))
+		id = *tsigKey.ID
+	}
+	if newKey.Algorithm != nil {
+		tsigKey.Algorithm = newKey.Algorithm
+	}
+	if newKey.Key != nil {
+		tsigKey.Key = newKey.Key
+	}
+	writeToTSIGKeysMap(id, tsigKey)
+	return tsigKey, nil
+}
+
+func (m mockTSIGKeysClient) Delete(ctx context.Context, id string) error {
+	if _, ok := readFromTSIGKeysMap(id); !ok {
+		return powerdns.Error{StatusCode: 404, Status: "404 Not Found", Message: "Not Found"}
+	}
+	tsigkeys.Delete(id)
+	return nil
+}
@@ -0,0 +1,219 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+// TSIGKeyReconciler reconciles a TSIGKey object
+type TSIGKeyReconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	PDNSClient PdnsClienter
+	// APIReader reads the Secrets not found in the cache directly from the API server, the cache may not have them yet
+	// (e.g. on operator startup), and a missing key would be generated again by PowerDNS
+	APIReader client.Reader
+	// RateLimiter defines the requeue delays of the failed reconciles, the controller-runtime default when zero
+	RateLimiter RateLimiter
+}
+
+// +kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=tsigkeys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=tsigkeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=tsigkeys/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+
+func (r *TSIGKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.Info("Reconcile TSIGKey", "TSIGKey.Name", req.Name)
+
+	// Get TSIGKey
+	tsigKey := &dnsv1alpha2.TSIGKey{}
+	err := r.Get(ctx, req.NamespacedName, tsigKey)
+	if err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Initialize variable to represent TSIGKey situation
+	isModified := tsigKey.Status.ObservedGeneration != nil && *tsigKey.Status.ObservedGeneration != tsigKey.GetGeneration()
+	isDeleted := !tsigKey.DeletionTimestamp.IsZero()
+	log.V(1).Info("TSIGKey situation", "isModified", isModified, "isDeleted", isDeleted)
+
+	original := tsigKey.DeepCopy()
+	// Ensure we update the status in case of early return
+	defer func() {
+		if err := r.Status().Patch(ctx, tsigKey, client.MergeFrom(original)); err != nil {
+			log.Error(err, "unable to patch TSIGKey status")
+		}
+	}()
+
+	// When updating a TSIGKey, if 'Status' is not changed, 'LastTransitionTime' will not be updated
+	// So we delete condition to force new 'LastTransitionTime'
+	if !isDeleted && isModified {
+		meta.RemoveStatusCondition(&tsigKey.Status.Conditions, "Available")
+	}
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if isDeleted {
+		if controllerutil.ContainsFinalizer(tsigKey, RESOURCES_FINALIZER_NAME) {
+			// our finalizer is present, so lets handle any external dependency
+			if err := deleteTSIGKeyExternalResources(ctx, tsigKey, r.PDNSClient, log); err != nil {
+				// if fail to delete the external resource, return with error
+				// so that it can be retried
+				return ctrl.Result{}, err
+			}
+			controllerutil.RemoveFinalizer(tsigKey, RESOURCES_FINALIZER_NAME)
+			if err := r.Update(ctx, tsigKey); err != nil {
+				log.Error(err, "Failed to remove finalizer")
+				return ctrl.Result{}, err
+			}
+		}
+		// Stop reconciliation as the item is being deleted
+		return ctrl.Result{}, nil
+	}
+
+	// The object is not being deleted, so if it does not have our finalizer,
+	// then lets add the finalizer and update the object.
+	if !controllerutil.ContainsFinalizer(tsigKey, RESOURCES_FINALIZER_NAME) {
+		controllerutil.AddFinalizer(tsigKey, RESOURCES_FINALIZER_NAME)
+		if err := r.Update(ctx, tsigKey); err != nil {
+			log.Error(err, "Failed to add finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Secret holding the key, an empty value lets PowerDNS generate it
+	secretValue, err := getSecretValue(ctx, r.Client, r.APIReader, client.ObjectKey{Namespace: tsigKey.Namespace, Name: tsigKey.GetSecretName()}, tsigKey.GetSecretKey())
+	if err != nil {
+		log.Error(err, "Failed to get secret", "Secret.Name", tsigKey.GetSecretName())
+		tsigKey.SetSynchronizationFailed(err)
+		return ctrl.Result{}, err
+	}
+
+	tsigKeyRes, err := tsigKeyExternalResourcesReconcile(ctx, tsigKey, secretValue, r.PDNSClient, log)
+	if err != nil {
+		tsigKey.SetSynchronizationFailed(err)
+		return ctrl.Result{}, err
+	}
+
+	// Write back the key generated by PowerDNS
+	if secretValue == "" {
+		if err := r.writeSecret(ctx, tsigKey, ptr.Deref(tsigKeyRes.Key, "")); err != nil {
+			log.Error(err, "Failed to write secret", "Secret.Name", tsigKey.GetSecretName())
+			tsigKey.SetSynchronizationFailed(err)
+			return ctrl.Result{}, err
+		}
+	}
+	tsigKey.SetAvailable(tsigKeyRes)
+
+	return observeReconcile(TSIGKEY_CONTROLLER_NAME, true, ctrl.Result{}, nil)
+}
+
+// getSecretValue returns the value of dataKey in the Secret, empty when the Secret does not exist.
+// A Secret not found in the cache of cl is read again with apiReader, when defined, before being considered missing
+func getSecretValue(ctx context.Context, cl client.Reader, apiReader client.Reader, key client.ObjectKey, dataKey string) (string, error) {
+	secret := &corev1.Secret{}
+	err := cl.Get(ctx, key, secret)
+	if apierrors.IsNotFound(err) && apiReader != nil {
+		err = apiReader.Get(ctx, key, secret)
+	}
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[dataKey]), nil
+}
+
+// writeSecret stores the key in the Secret of the TSIGKey, a Secret created by the operator is owned by the TSIGKey
+func (r *TSIGKeyReconciler) writeSecret(ctx context.Context, tsigKey *dnsv1alpha2.TSIGKey, key string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tsigKey.GetSecretName(),
+			Namespace: tsigKey.Namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.CreationTimestamp.IsZero() {
+			if err := ctrl.SetControllerReference(tsigKey, secret, r.Scheme); err != nil {
+				return err
+			}
+		}
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[tsigKey.GetSecretKey()] = []byte(key)
+		return nil
+	})
+	return err
+}
+
+// findTSIGKeysForSecret returns the TSIGKeys referencing the Secret, so that a rotation of the key
+// in a Secret which is not owned by the TSIGKey is also propagated to PowerDNS
+func (r *TSIGKeyReconciler) findTSIGKeysForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	var tsigKeys dnsv1alpha2.TSIGKeyList
+	if err := r.List(ctx, &tsigKeys, client.InNamespace(secret.GetNamespace()), client.MatchingFields{"TSIGKey.SecretName": secret.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "unable to find TSIGKeys related to the Secret", "Secret.Name", secret.GetName())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(tsigKeys.Items))
+	for _, tsigKey := range tsigKeys.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&tsigKey)})
+	}
+	return requests
+}
+
+// isTSIGKeySecret returns true if the Secret is referenced by a TSIGKey
+func (r *TSIGKeyReconciler) isTSIGKeySecret(secret client.Object) bool {
+	var tsigKeys dnsv1alpha2.TSIGKeyList
+	if err := r.List(context.Background(), &tsigKeys, client.InNamespace(secret.GetNamespace()), client.MatchingFields{"TSIGKey.SecretName": secret.GetName()}, client.Limit(1)); err != nil {
+		// The event is processed, findTSIGKeysForSecret logs the error
+		return true
+	}
+	return len(tsigKeys.Items) > 0
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// Only the metadata of the Secrets is watched, the Secrets themselves are not cached (see cmd/main.go),
+// and only the events of the Secrets referenced by a TSIGKey are processed
+func (r *TSIGKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// We use indexer to find TSIGKeys related to a Secret
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &dnsv1alpha2.TSIGKey{}, "TSIGKey.SecretName", func(rawObj client.Object) []string {
+		return []string{rawObj.(*dnsv1alpha2.TSIGKey).GetSecretName()}
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&dnsv1alpha2.TSIGKey{}).
+		WatchesMetadata(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findTSIGKeysForSecret), builder.WithPredicates(predicate.NewPredicateFuncs(r.isTSIGKeySecret))).
+		WithOptions(r.RateLimiter.controllerOptions()).
+		Complete(r)
+}
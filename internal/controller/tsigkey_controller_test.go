@@ -0,0 +1,192 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+//nolint:goconst
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+var _ = Describe("TSIGKey Controller", func() {
+
+	const (
+		resourceName      = "transfer-key"
+		resourceNamespace = "example9"
+		resourceAlgorithm = "hmac-sha256"
+
+		timeout  = time.Second * 5
+		interval = time.Millisecond * 250
+	)
+
+	tsigKeyLookupKey := types.NamespacedName{
+		Name:      resourceName,
+		Namespace: resourceNamespace,
+	}
+
+	BeforeEach(func() {
+		ctx := context.Background()
+		By("Creating the TSIGKey resource")
+		resource := &dnsv1alpha2.TSIGKey{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceName,
+				Namespace: resourceNamespace,
+			},
+		}
+		_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, resource, func() error {
+			resource.Spec = dnsv1alpha2.TSIGKeySpec{
+				Algorithm: resourceAlgorithm,
+			}
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		// Confirm that resource is created in the backend
+		Eventually(func() bool {
+			_, found := readFromTSIGKeysMap(makeCanonical(dnsv1alpha2.TSIGKeyPDNSName(resourceNamespace, resourceName)))
+			return found
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	AfterEach(func() {
+		ctx := context.Background()
+		resource := &dnsv1alpha2.TSIGKey{}
+		err := k8sClient.Get(ctx, tsigKeyLookupKey, resource)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Cleaning up the specific resource instance TSIGKey")
+		Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, tsigKeyLookupKey, resource)
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+		// Confirm that resource is deleted in the backend
+		Eventually(func() bool {
+			_, found := readFromTSIGKeysMap(makeCanonical(dnsv1alpha2.TSIGKeyPDNSName(resourceNamespace, resourceName)))
+			return found
+		}, timeout, interval).Should(BeFalse())
+	})
+
+	Context("When existing resource", func() {
+		It("should write the generated key to the Secret", Label("tsigkey-initialization"), func() {
+			ctx := context.Background()
+			resource := &dnsv1alpha2.TSIGKey{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, tsigKeyLookupKey, resource)
+				return err == nil && resource.IsInExpectedStatus(FIRST_GENERATION, dnsv1alpha2.SUCCEEDED_STATUS, metav1.ConditionTrue)
+			}, timeout, interval).Should(BeTrue())
+			Expect(resource.GetFinalizers()).To(ContainElement(RESOURCES_FINALIZER_NAME), "TSIGKey should contain the finalizer")
+
+			external, found := readFromTSIGKeysMap(makeCanonical(dnsv1alpha2.TSIGKeyPDNSName(resourceNamespace, resourceName)))
+			Expect(found).To(BeTrue())
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, tsigKeyLookupKey, secret)).To(Succeed())
+			Expect(string(secret.Data[dnsv1alpha2.DEFAULT_TSIGKEY_SECRET_KEY])).To(Equal(ptr.Deref(external.Key, "")), "Secret should contain the generated key")
+		})
+	})
+
+	Context("When updating the key in the Secret", func() {
+		It("should update the key in PowerDNS", Label("tsigkey-modification"), func() {
+			ctx := context.Background()
+			secret := &corev1.Secret{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, tsigKeyLookupKey, secret)
+			}, timeout, interval).Should(Succeed())
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, secret, func() error {
+				secret.Data[dnsv1alpha2.DEFAULT_TSIGKEY_SECRET_KEY] = []byte("bmV3LXNlY3JldA==")
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() string {
+				external, found := readFromTSIGKeysMap(makeCanonical(dnsv1alpha2.TSIGKeyPDNSName(resourceNamespace, resourceName)))
+				if !found {
+					return ""
+				}
+				return ptr.Deref(external.Key, "")
+			}, timeout, interval).Should(Equal("bmV3LXNlY3JldA=="))
+		})
+	})
+
+	Context("When updating the key in a referenced Secret", func() {
+		It("should update the key in PowerDNS", Label("tsigkey-modification", "secret-rotation"), func() {
+			ctx := context.Background()
+			// Specific test variables
+			externalResourceName := "external-transfer-key"
+			externalSecretName := "external-transfer-key-secret"
+			externalSecretKey := "tsig"
+			externalLookupKey := types.NamespacedName{
+				Name:      externalResourceName,
+				Namespace: resourceNamespace,
+			}
+			readExternalKey := func() string {
+				external, found := readFromTSIGKeysMap(makeCanonical(dnsv1alpha2.TSIGKeyPDNSName(resourceNamespace, externalResourceName)))
+				if !found {
+					return ""
+				}
+				return ptr.Deref(external.Key, "")
+			}
+
+			By("Creating the Secret and the TSIGKey referencing it")
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      externalSecretName,
+					Namespace: resourceNamespace,
+				},
+				Data: map[string][]byte{externalSecretKey: []byte("aW5pdGlhbC1zZWNyZXQ=")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			resource := &dnsv1alpha2.TSIGKey{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      externalResourceName,
+					Namespace: resourceNamespace,
+				},
+				Spec: dnsv1alpha2.TSIGKeySpec{
+					Algorithm: resourceAlgorithm,
+					SecretRef: &dnsv1alpha2.SecretKeyRef{
+						Name: externalSecretName,
+						Key:  ptr.To(externalSecretKey),
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			Eventually(readExternalKey, timeout, interval).Should(Equal("aW5pdGlhbC1zZWNyZXQ="))
+
+			By("Rotating the key in the Secret")
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, secret, func() error {
+				secret.Data[externalSecretKey] = []byte("cm90YXRlZC1zZWNyZXQ=")
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(readExternalKey, timeout, interval).Should(Equal("cm90YXRlZC1zZWNyZXQ="))
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: externalSecretName, Namespace: resourceNamespace}, secret)).To(Succeed())
+			Expect(secret.GetOwnerReferences()).To(BeEmpty(), "Secret should not be owned by the TSIGKey")
+
+			By("Cleaning up the TSIGKey and the Secret")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, externalLookupKey, resource)
+				return apierrors.IsNotFound(err)
+			}, timeout, interval).Should(BeTrue())
+			Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,115 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// Defaults of the controller-runtime rate limiter
+	DEFAULT_RATE_LIMITER_BASE_DELAY = 5 * time.Millisecond
+	DEFAULT_RATE_LIMITER_MAX_DELAY  = 1000 * time.Second
+)
+
+// controllerNames are the names of the controllers accepted by ParseRateLimiterByController
+var controllerNames = []string{
+	ZONE_CONTROLLER_NAME,
+	CLUSTERZONE_CONTROLLER_NAME,
+	RRSET_CONTROLLER_NAME,
+	CLUSTERRRSET_CONTROLLER_NAME,
+	CRYPTOKEY_CONTROLLER_NAME,
+	TSIGKEY_CONTROLLER_NAME,
+	DNSSEC_MAINTENANCE_CONTROLLER_NAME,
+}
+
+// RateLimiter defines the exponential failure rate limiter of the workqueue of a controller:
+// a request failing again is requeued after BaseDelay, doubled on each consecutive failure up to MaxDelay.
+// The zero value keeps the controller-runtime default.
+type RateLimiter struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// Validate returns an error if the delays are inconsistent
+func (l RateLimiter) Validate() error {
+	if l.BaseDelay <= 0 || l.MaxDelay <= 0 {
+		return fmt.Errorf("rate limiter delays must be positive, got %v:%v", l.BaseDelay, l.MaxDelay)
+	}
+	if l.BaseDelay > l.MaxDelay {
+		return fmt.Errorf("rate limiter base delay %v is greater than its max delay %v", l.BaseDelay, l.MaxDelay)
+	}
+	return nil
+}
+
+// controllerOptions returns the options of a controller using this rate limiter.
+// As the controller-runtime default, the exponential failure rate limiter is combined with an overall
+// bucket rate limiter (10 qps, 100 burst)
+func (l RateLimiter) controllerOptions() ctrlcontroller.Options {
+	if l == (RateLimiter{}) {
+		return ctrlcontroller.Options{}
+	}
+	return ctrlcontroller.Options{
+		RateLimiter: workqueue.NewTypedMaxOfRateLimiter(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](l.BaseDelay, l.MaxDelay),
+			&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+		),
+	}
+}
+
+// ParseRateLimiterByController parses a comma-separated list of CONTROLLER=BASE:MAX delays (e.g. "zone=10ms:5m,rrset=50ms:10m"),
+// the controllers not listed use defaults
+func ParseRateLimiterByController(value string, defaults RateLimiter) (map[string]RateLimiter, error) {
+	if err := defaults.Validate(); err != nil {
+		return nil, err
+	}
+	result := map[string]RateLimiter{}
+	for _, name := range controllerNames {
+		result[name] = defaults
+	}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, delays, found := strings.Cut(pair, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !found || !slices.Contains(controllerNames, name) {
+			return nil, fmt.Errorf("invalid rate limiter definition %q, expected CONTROLLER=BASE:MAX with CONTROLLER one of %s", pair, strings.Join(controllerNames, ", "))
+		}
+		baseStr, maxStr, found := strings.Cut(delays, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid rate limiter delays for controller %s: %q, expected BASE:MAX", name, delays)
+		}
+		var limiter RateLimiter
+		var err error
+		if limiter.BaseDelay, err = time.ParseDuration(strings.TrimSpace(baseStr)); err != nil {
+			return nil, fmt.Errorf("invalid rate limiter base delay for controller %s: %w", name, err)
+		}
+		if limiter.MaxDelay, err = time.ParseDuration(strings.TrimSpace(maxStr)); err != nil {
+			return nil, fmt.Errorf("invalid rate limiter max delay for controller %s: %w", name, err)
+		}
+		if err := limiter.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid rate limiter for controller %s: %w", name, err)
+		}
+		result[name] = limiter
+	}
+	return result, nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestParseRateLimiterByController(t *testing.T) {
+	defaults := RateLimiter{BaseDelay: DEFAULT_RATE_LIMITER_BASE_DELAY, MaxDelay: DEFAULT_RATE_LIMITER_MAX_DELAY}
+	var testCases = []struct {
+		description string
+		value       string
+		defaults    RateLimiter
+		expected    map[string]RateLimiter
+		expectedErr bool
+	}{
+		{"Empty value", "", defaults, map[string]RateLimiter{ZONE_CONTROLLER_NAME: defaults, RRSET_CONTROLLER_NAME: defaults}, false},
+		{"Overridden controllers", "zone=10ms:5m, RRset=50ms:10m", defaults, map[string]RateLimiter{
+			ZONE_CONTROLLER_NAME:  {BaseDelay: 10 * time.Millisecond, MaxDelay: 5 * time.Minute},
+			RRSET_CONTROLLER_NAME: {BaseDelay: 50 * time.Millisecond, MaxDelay: 10 * time.Minute},
+		}, false},
+		{"Unknown controller", "records=10ms:5m", defaults, nil, true},
+		{"Missing max delay", "zone=10ms", defaults, nil, true},
+		{"Invalid delay", "zone=abc:5m", defaults, nil, true},
+		{"Base delay greater than max delay", "zone=10m:5m", defaults, nil, true},
+		{"Invalid defaults", "", RateLimiter{BaseDelay: 0, MaxDelay: time.Minute}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, err := ParseRateLimiterByController(tc.value, tc.defaults)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.expectedErr {
+				return
+			}
+			if len(result) != len(controllerNames) {
+				t.Errorf("got %d controllers, want %d", len(result), len(controllerNames))
+			}
+			for name, expected := range tc.expected {
+				if result[name] != expected {
+					t.Errorf("got %v for controller %s, want %v", result[name], name, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiterControllerOptions(t *testing.T) {
+	if options := (RateLimiter{}).controllerOptions(); options.RateLimiter != nil {
+		t.Errorf("got %v, want the controller-runtime default rate limiter", options.RateLimiter)
+	}
+
+	options := RateLimiter{BaseDelay: 10 * time.Millisecond, MaxDelay: 40 * time.Millisecond}.controllerOptions()
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "example.org"}}
+	for _, expected := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond, 40 * time.Millisecond} {
+		if delay := options.RateLimiter.When(request); delay != expected {
+			t.Errorf("got %v, want %v", delay, expected)
+		}
+	}
+	options.RateLimiter.Forget(request)
+	if delay := options.RateLimiter.When(request); delay != 10*time.Millisecond {
+		t.Errorf("got %v, want %v", delay, 10*time.Millisecond)
+	}
+}
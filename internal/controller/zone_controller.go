@@ -13,9 +13,11 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -26,9 +28,38 @@ import (
 )
 
 const (
-	RESOURCES_FINALIZER_NAME   = "dns.cav.enablers.ob/external-resources"
-	METRICS_FINALIZER_NAME     = "dns.cav.enablers.ob/metrics"
+	RESOURCES_FINALIZER_NAME = "dns.cav.enablers.ob/external-resources"
+	METRICS_FINALIZER_NAME   = "dns.cav.enablers.ob/metrics"
+	RETRIEVE_AXFR_ANNOTATION = "dns.cav.enablers.ob/retrieve-axfr"
+	RECTIFY_ANNOTATION       = "dns.cav.enablers.ob/rectify"
+	EXPORT_ANNOTATION        = "dns.cav.enablers.ob/export"
+	// Zones and RRsets deleted with this annotation set to "true" are kept in PowerDNS
+	ORPHAN_ANNOTATION = "dns.cav.enablers.ob/orphan-on-delete"
+	// Zones orphaned on delete with this annotation set to "true" are kept in PowerDNS without the RRsets managed by the operator
+	DELETE_MANAGED_RECORDS_ANNOTATION = "dns.cav.enablers.ob/delete-managed-records"
+	// Templates of spec.defaultRecords already applied to a Zone, as comma-separated "<FQDN> <type>"
+	DEFAULT_RECORDS_ANNOTATION = "dns.cav.enablers.ob/default-records"
+	// RRsets created from spec.defaultRecords have this label set to "true"
+	DEFAULT_RECORD_LABEL = "dns.cav.enablers.ob/default-record"
+	// Zones and RRsets with this annotation set to "true" are not reconciled, except their deletion
+	PAUSED_ANNOTATION    = "dns.cav.enablers.ob/paused"
+	EXPORT_CONFIGMAP_KEY = "zone"
+	// ConfigMaps are limited to 1MiB, keep some room for metadata
+	EXPORT_MAX_SIZE            = 1000 * 1024
 	DEFAULT_TTL_FOR_NS_RECORDS = uint32(1500)
+	DEFAULT_TTL_FOR_RRSETS     = uint32(3600)
+	// Version of the catalog zones schema (RFC 9432)
+	CATALOG_ZONE_VERSION = "\"2\""
+	// Account of the RRSet comments without explicit account
+	OPERATOR_COMMENT_ACCOUNT = "powerdns-operator"
+	// Metadata marking the zones adopted by the operator, its value is the adopting resource
+	OWNER_METADATA_KIND = "X-POWERDNS-OPERATOR"
+	// The last sync time of a RRset is not refreshed more often, its status patch triggers a new reconcile
+	LAST_SYNC_TIME_RESOLUTION = 10 * time.Second
+	// Above this number of RRsets, only their count is reported in the Zone status
+	MAX_MANAGED_RECORDS_IN_STATUS = 100
+	// Message of the Warning event emitted on the operator Pod in dry-run (read-only) mode
+	DRY_RUN_NOTICE_MESSAGE = "Dry-run (read-only) mode enabled, no change is made on PowerDNS"
 
 	ZONE_NOT_FOUND_MSG  = "Not Found"
 	ZONE_NOT_FOUND_CODE = 404
@@ -41,16 +72,37 @@ type ZoneReconciler struct {
 	client.Client
 	Scheme     *runtime.Scheme
 	PDNSClient PdnsClienter
+	Recorder   events.EventRecorder
+	// RetryBackoff defines when a resource in SynchronizationFailed status is synchronized again
+	RetryBackoff RetryBackoff
+	// FinalizerTimeout is the delay after which the finalizer of a deleted zone is removed even if it
+	// cannot be deleted from PowerDNS, 0 disables it
+	FinalizerTimeout time.Duration
+	// SyncInterval is the default interval of the periodic resynchronization with PowerDNS, 0 disables it
+	SyncInterval time.Duration
+	// StartupJitter spreads the first reconcile of the synchronized resources after the start of the operator
+	StartupJitter *StartupJitter
+	// AllowedZoneSuffixes restricts the zones created on the PowerDNS API to these suffixes and their subdomains, any zone when empty
+	AllowedZoneSuffixes []string
+	// DefaultTTLByType is the default TTL per record type, used to resolve the TTL of the RRsets which do not define one
+	DefaultTTLByType map[string]uint32
+	// RateLimiter defines the requeue delays of the failed reconciles, the controller-runtime default when zero
+	RateLimiter RateLimiter
 }
 
 func init() {
 	// Register custom metrics with the global prometheus registry
 	metrics.Registry.MustRegister(zonesStatusesMetric)
+	metrics.Registry.MustRegister(zonesSerialMetric)
+	metrics.Registry.MustRegister(zonesNotifiedSerialMetric)
+	metrics.Registry.MustRegister(lastSuccessfulReconcileMetric)
 }
 
 //+kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=zones,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=zones/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=dns.cav.enablers.ob,resources=zones/finalizers,verbs=update
+//+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
 
 func (r *ZoneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
@@ -70,6 +122,14 @@ func (r *ZoneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	isDeleted := !zone.DeletionTimestamp.IsZero()
 	log.V(1).Info("Zone situation", "isModified", isModified, "isDeleted", isDeleted)
 
+	// Resources already synchronized are reconciled progressively after the start of the operator
+	if !isModified && !isDeleted && zone.Status.ObservedGeneration != nil {
+		if delay := r.StartupJitter.Delay(string(zone.GetUID()), time.Now()); delay > 0 {
+			log.V(1).Info("Delaying first reconcile of Zone", "delay", delay)
+			return ctrl.Result{RequeueAfter: delay}, nil
+		}
+	}
+
 	// Position metrics finalizer as soon as possible
 	if !isDeleted {
 		log.V(1).Info("Zone not deleted", "Zone.Name", zone.Name)
@@ -89,20 +149,27 @@ func (r *ZoneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	original := zone.DeepCopy()
 	// Ensure we update the status in case of early return
 	defer func() {
+		recordAvailableEvent(r.Recorder, zone, original.Status.Conditions, zone.Status.Conditions)
+		recordPausedEvent(r.Recorder, zone, original.Status.Conditions, zone.Status.Conditions)
+		recordCleanupAbandonedEvent(r.Recorder, zone, original.Status.Conditions, zone.Status.Conditions)
 		if err := r.Status().Patch(ctx, zone, client.MergeFrom(original)); err != nil {
 			log.Error(err, "unable to patch Zone status")
 		}
 	}()
 
 	// When updating a Zone, if 'Status' is not changed, 'LastTransitionTime' will not be updated
-	// So we delete condition to force new 'LastTransitionTime'
-	if !isDeleted && isModified {
+	// So we delete condition to force new 'LastTransitionTime', except for a paused resource which keeps its status
+	if !isDeleted && isModified && !isPaused(zone) {
 		log.V(1).Info("Removing Available condition from Zone")
 		isModified = true
 		meta.RemoveStatusCondition(&zone.Status.Conditions, "Available")
 	}
 
-	return zoneReconcile(ctx, zone, isModified, isDeleted, r.Client, r.PDNSClient, log)
+	result, synced, err := zoneReconcile(ctx, zone, isModified, isDeleted, r.RetryBackoff, r.FinalizerTimeout, r.AllowedZoneSuffixes, r.DefaultTTLByType, r.Scheme, r.Client, r.PDNSClient, log)
+	err = dryRunReconcile(zone, err)
+	result, err = rateLimitedResult(result, err)
+	result = resyncResult(result, err, isDeleted, getSyncInterval(zone.Spec.SyncInterval, r.SyncInterval))
+	return observeReconcile(ZONE_CONTROLLER_NAME, synced, result, err)
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -122,5 +189,6 @@ func (r *ZoneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&dnsv1alpha2.Zone{}).
 		Owns(&dnsv1alpha2.ClusterRRset{}).
 		Owns(&dnsv1alpha2.RRset{}).
+		WithOptions(r.RateLimiter.controllerOptions()).
 		Complete(r)
 }
@@ -20,7 +20,9 @@ import (
 	"github.com/joeig/go-powerdns/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
@@ -114,11 +116,13 @@ var _ = Describe("Zone Controller", func() {
 			}, timeout, interval).Should(BeTrue())
 			Expect(countZonesMetrics()-ic).To(Equal(0), "No more metric should have been created")
 			Expect(getZoneMetricWithLabels(dnsv1alpha2.SUCCEEDED_STATUS, resourceName, resourceNamespace)).To(Equal(1.0), "metric should be 1.0")
+			Expect(getLastSuccessfulReconcileMetric(ZONE_CONTROLLER_NAME)).To(BeNumerically(">", 0), "last successful reconcile timestamp should be set")
 			Expect(getMockedKind(resourceName)).To(Equal(resourceKind), "Kind should be equal")
 			Expect(getMockedNameservers(resourceName)).To(Equal(resourceNameservers), "Nameservers should be equal")
 			Expect(getMockedCatalog(resourceName)).To(Equal(resourceCatalog), "Catalog should be equal")
 			Expect(zone.GetFinalizers()).To(ContainElement(RESOURCES_FINALIZER_NAME), "Zone should contain the finalizer")
 			Expect(fmt.Sprintf("%d", *(zone.Status.Serial))).To(Equal(fmt.Sprintf("%s01", time.Now().UTC().Format("20060102"))), "Serial should be YYYYMMDD01")
+			Expect(getZoneSerialMetric(resourceName, resourceNamespace)).To(Equal(float64(*zone.Status.Serial)), "Serial metric should be equal to the zone serial")
 		})
 	})
 
@@ -456,6 +460,106 @@ var _ = Describe("Zone Controller", func() {
 			}, timeout, interval).Should(BeTrue())
 		})
 	})
+	Context("When existing resource", func() {
+		It("should ignore the AXFR retrieve request on a Native zone", Label("zone-modification", "retrieve-axfr"), func() {
+			ctx := context.Background()
+			initialRetrieves := getAxfrRetrievesCount(resourceName)
+
+			By("Annotating the resource")
+			zone := &dnsv1alpha2.Zone{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, zone)).To(Succeed())
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, zone, func() error {
+				zone.SetAnnotations(map[string]string{RETRIEVE_AXFR_ANNOTATION: ""})
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Verifying the annotation has been removed")
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, typeNamespacedName, zone)
+				_, found := zone.GetAnnotations()[RETRIEVE_AXFR_ANNOTATION]
+				return err == nil && !found
+			}, timeout, interval).Should(BeTrue())
+			Expect(getAxfrRetrievesCount(resourceName)).To(Equal(initialRetrieves), "No AXFR retrieve should have been requested")
+			Expect(meta.FindStatusCondition(zone.Status.Conditions, dnsv1alpha2.AXFR_RETRIEVED_CONDITION)).To(BeNil())
+		})
+	})
+
+	Context("When existing resource", func() {
+		It("should retrieve a Slave zone from its masters once", Label("zone-modification", "retrieve-axfr"), func() {
+			ctx := context.Background()
+
+			By("Modifying the resource into a Slave zone")
+			zone := &dnsv1alpha2.Zone{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, zone)).To(Succeed())
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, zone, func() error {
+				zone.Spec.Kind = SLAVE_KIND_ZONE
+				zone.Spec.Masters = []string{"192.0.2.1"}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, typeNamespacedName, zone)
+				return err == nil && zone.IsInExpectedStatus(MODIFIED_GENERATION, dnsv1alpha2.SUCCEEDED_STATUS, metav1.ConditionTrue)
+			}, timeout, interval).Should(BeTrue())
+			initialRetrieves := getAxfrRetrievesCount(resourceName)
+
+			By("Annotating the resource")
+			_, err = controllerutil.CreateOrUpdate(ctx, k8sClient, zone, func() error {
+				zone.SetAnnotations(map[string]string{RETRIEVE_AXFR_ANNOTATION: ""})
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Verifying the zone has been retrieved")
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, typeNamespacedName, zone)
+				_, found := zone.GetAnnotations()[RETRIEVE_AXFR_ANNOTATION]
+				condition := meta.FindStatusCondition(zone.Status.Conditions, dnsv1alpha2.AXFR_RETRIEVED_CONDITION)
+				return err == nil && !found && condition != nil && condition.Status == metav1.ConditionTrue
+			}, timeout, interval).Should(BeTrue())
+			Consistently(func() int {
+				return getAxfrRetrievesCount(resourceName)
+			}, time.Second, interval).Should(Equal(initialRetrieves+1), "AXFR retrieve should have been requested once")
+		})
+	})
+
+	Context("When existing resource", func() {
+		It("should export the zone to a ConfigMap", Label("zone-modification", "export"), func() {
+			ctx := context.Background()
+
+			By("Annotating the resource")
+			zone := &dnsv1alpha2.Zone{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, zone)).To(Succeed())
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, zone, func() error {
+				zone.SetAnnotations(map[string]string{EXPORT_ANNOTATION: ""})
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Verifying the zone has been exported")
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, typeNamespacedName, zone)
+				return err == nil && zone.Status.Export != nil && zone.Status.Serial != nil && zone.Status.Export.Serial == *zone.Status.Serial
+			}, timeout, interval).Should(BeTrue())
+			Expect(zone.Status.Export.ConfigMap).To(Equal(resourceName))
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: zone.Status.Export.ConfigMap, Namespace: resourceNamespace}, configMap)).To(Succeed())
+			Expect(configMap.Data[EXPORT_CONFIGMAP_KEY]).To(ContainSubstring(fmt.Sprintf("SOA\ta.misconfigured.dns.server.invalid. hostmaster.%s %d", makeCanonical(resourceName), *zone.Status.Serial)))
+
+			By("Removing the annotation")
+			_, err = controllerutil.CreateOrUpdate(ctx, k8sClient, zone, func() error {
+				zone.SetAnnotations(nil)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, typeNamespacedName, zone)
+				return err == nil && zone.Status.Export == nil
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+
 	Context("When creating a Zone with an existing Zone with same FQDN", func() {
 		It("should reconcile the resource with Failed status", Label("zone-creation", "existing-zone"), func() {
 			ctx := context.Background()
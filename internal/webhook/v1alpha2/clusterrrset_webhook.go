@@ -0,0 +1,82 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package v1alpha2
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+// SetupClusterRRsetWebhookWithManager registers the webhooks for ClusterRRset in the manager.
+func SetupClusterRRsetWebhookWithManager(mgr ctrl.Manager, ttlPolicy TTLPolicy, allowLuaRecords bool) error {
+	return ctrl.NewWebhookManagedBy(mgr, &dnsv1alpha2.ClusterRRset{}).
+		WithDefaulter(&ClusterRRsetCustomDefaulter{TTLPolicy: ttlPolicy}).
+		WithValidator(&ClusterRRsetCustomValidator{TTLPolicy: ttlPolicy, AllowLuaRecords: allowLuaRecords}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-dns-cav-enablers-ob-v1alpha2-clusterrrset,mutating=true,failurePolicy=fail,sideEffects=None,groups=dns.cav.enablers.ob,resources=clusterrrsets,verbs=create;update,versions=v1alpha2,name=mclusterrrset-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// ClusterRRsetCustomDefaulter sets the default TTL of the TTL policy on a ClusterRRset without TTL
+type ClusterRRsetCustomDefaulter struct {
+	TTLPolicy TTLPolicy
+}
+
+var _ admission.Defaulter[*dnsv1alpha2.ClusterRRset] = &ClusterRRsetCustomDefaulter{}
+
+// Default implements admission.Defaulter so a webhook will be registered for the type ClusterRRset.
+func (d *ClusterRRsetCustomDefaulter) Default(_ context.Context, clusterrrset *dnsv1alpha2.ClusterRRset) error {
+	d.TTLPolicy.defaultTTL(&clusterrrset.Spec)
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-dns-cav-enablers-ob-v1alpha2-clusterrrset,mutating=false,failurePolicy=fail,sideEffects=None,groups=dns.cav.enablers.ob,resources=clusterrrsets,verbs=create;update,versions=v1alpha2,name=vclusterrrset-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// ClusterRRsetCustomValidator validates the content of the records of a ClusterRRset according to its type, and its TTL against the TTL policy,
+// LUA ClusterRRsets are rejected unless AllowLuaRecords is set
+type ClusterRRsetCustomValidator struct {
+	TTLPolicy       TTLPolicy
+	AllowLuaRecords bool
+}
+
+var _ admission.Validator[*dnsv1alpha2.ClusterRRset] = &ClusterRRsetCustomValidator{}
+
+// ValidateCreate implements admission.Validator so a webhook will be registered for the type ClusterRRset.
+func (v *ClusterRRsetCustomValidator) ValidateCreate(_ context.Context, clusterrrset *dnsv1alpha2.ClusterRRset) (admission.Warnings, error) {
+	return rrsetWarnings(clusterrrset.Spec), validateClusterRRset(clusterrrset, v.TTLPolicy, v.AllowLuaRecords)
+}
+
+// ValidateUpdate implements admission.Validator so a webhook will be registered for the type ClusterRRset.
+func (v *ClusterRRsetCustomValidator) ValidateUpdate(_ context.Context, _, clusterrrset *dnsv1alpha2.ClusterRRset) (admission.Warnings, error) {
+	return rrsetWarnings(clusterrrset.Spec), validateClusterRRset(clusterrrset, v.TTLPolicy, v.AllowLuaRecords)
+}
+
+// ValidateDelete implements admission.Validator so a webhook will be registered for the type ClusterRRset.
+func (v *ClusterRRsetCustomValidator) ValidateDelete(_ context.Context, _ *dnsv1alpha2.ClusterRRset) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateClusterRRset(clusterrrset *dnsv1alpha2.ClusterRRset, ttlPolicy TTLPolicy, allowLuaRecords bool) error {
+	allErrs := validateRRsetSpec(clusterrrset.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateLuaRecords(clusterrrset.Spec.Type, allowLuaRecords, field.NewPath("spec", "type"))...)
+	allErrs = append(allErrs, ttlPolicy.validateTTL(clusterrrset.Spec.TTL, field.NewPath("spec", "ttl"))...)
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(dnsv1alpha2.GroupVersion.WithKind("ClusterRRset").GroupKind(), clusterrrset.Name, allErrs)
+}
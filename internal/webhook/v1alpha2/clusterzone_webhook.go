@@ -0,0 +1,62 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+package v1alpha2
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+// SetupClusterZoneWebhookWithManager registers the webhook for ClusterZone in the manager.
+func SetupClusterZoneWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &dnsv1alpha2.ClusterZone{}).
+		WithValidator(&ClusterZoneCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-dns-cav-enablers-ob-v1alpha2-clusterzone,mutating=false,failurePolicy=fail,sideEffects=None,groups=dns.cav.enablers.ob,resources=clusterzones,verbs=create;update;delete,versions=v1alpha2,name=vclusterzone-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// ClusterZoneCustomValidator validates the kind of a ClusterZone, which is immutable.
+// A ClusterZone still referenced by RRsets or ClusterRRsets cannot be deleted, unless forced
+type ClusterZoneCustomValidator struct {
+	Client client.Reader
+}
+
+var _ admission.Validator[*dnsv1alpha2.ClusterZone] = &ClusterZoneCustomValidator{}
+
+// ValidateCreate implements admission.Validator so a webhook will be registered for the type ClusterZone.
+func (v *ClusterZoneCustomValidator) ValidateCreate(_ context.Context, clusterzone *dnsv1alpha2.ClusterZone) (admission.Warnings, error) {
+	return zoneWarnings(clusterzone.Spec), validateClusterZone(clusterzone, validateZoneSpec(clusterzone.Spec, field.NewPath("spec")))
+}
+
+// ValidateUpdate implements admission.Validator so a webhook will be registered for the type ClusterZone.
+func (v *ClusterZoneCustomValidator) ValidateUpdate(_ context.Context, oldClusterZone, clusterzone *dnsv1alpha2.ClusterZone) (admission.Warnings, error) {
+	return zoneWarnings(clusterzone.Spec), validateClusterZone(clusterzone, validateZoneSpecUpdate(oldClusterZone.Spec, clusterzone.Spec, field.NewPath("spec")))
+}
+
+// ValidateDelete implements admission.Validator so a webhook will be registered for the type ClusterZone.
+func (v *ClusterZoneCustomValidator) ValidateDelete(ctx context.Context, clusterzone *dnsv1alpha2.ClusterZone) (admission.Warnings, error) {
+	return nil, validateZoneDelete(ctx, v.Client, clusterzone, dnsv1alpha2.ZoneRef{Name: clusterzone.Name, Kind: "ClusterZone"})
+}
+
+func validateClusterZone(clusterzone *dnsv1alpha2.ClusterZone, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(dnsv1alpha2.GroupVersion.WithKind("ClusterZone").GroupKind(), clusterzone.Name, allErrs)
+}
@@ -0,0 +1,332 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package v1alpha2
+
+import (
+	"encoding/base64"
+	"maps"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+const (
+	aliasWarning = "ALIAS records are only resolved when expand-alias and resolver are configured on PowerDNS"
+
+	maxHostnameLength = 253
+	maxLabelLength    = 63
+
+	svcbFormatMessage = "must be formatted as '<priority> <target> [<key>=<value> ...]'"
+	luaFormatMessage  = "must be formatted as '<type> \"<snippet>\"'"
+	caaFormatMessage  = "must be formatted as '<flag> <tag> \"<value>\"'"
+	luaNotAllowed     = "LUA records are not allowed, the operator must be started with --allow-lua-records"
+)
+
+var (
+	// hostnameLabelRegexp matches a single DNS label, underscores are accepted for service labels (e.g. _sip)
+	hostnameLabelRegexp = regexp.MustCompile(`^[A-Za-z0-9_]([A-Za-z0-9_-]*[A-Za-z0-9_])?$`)
+	// txtContentRegexp matches one or more double-quoted character-strings separated by whitespaces
+	txtContentRegexp = regexp.MustCompile(`^"(?:[^"\\]|\\.)*"(?:\s+"(?:[^"\\]|\\.)*")*$`)
+	// svcParamGenericKeyRegexp matches a SvcParamKey in its generic form (e.g. key65000)
+	svcParamGenericKeyRegexp = regexp.MustCompile(`^key[0-9]{1,5}$`)
+	// svcParamKeys are the SvcParamKeys registered by IANA (RFC 9460)
+	svcParamKeys = []string{"mandatory", "alpn", "no-default-alpn", "port", "ipv4hint", "ech", "ipv6hint", "dohpath", "ohttp"}
+	// luaEmbeddedTypes are the types of the records a LUA record can generate
+	luaEmbeddedTypes = []string{"A", "AAAA", "CAA", "CNAME", "HINFO", "HTTPS", "LOC", "MX", "NAPTR", "NS", "PTR", "SPF", "SRV", "SSHFP", "SVCB", "TXT"}
+	// caaTags are the CAA property tags accepted in the structured form (RFC 8659)
+	caaTags = []string{"issue", "issuewild", "iodef"}
+	// caaValueRegexp matches a single double-quoted character-string
+	caaValueRegexp = regexp.MustCompile(`^"(?:[^"\\]|\\.)*"$`)
+	// caaParameterRegexp matches a parameter of an issue or issuewild property (e.g. validationmethods=dns-01)
+	caaParameterRegexp = regexp.MustCompile(`^[A-Za-z0-9]+=[\x21-\x3A\x3C-\x7E]*$`)
+)
+
+// validateRRsetSpec checks the raw records and the structured records of a RRset
+func validateRRsetSpec(spec dnsv1alpha2.RRsetSpec, path *field.Path) field.ErrorList {
+	allErrs := validateRecords(spec.Type, spec.Records, path.Child("records"))
+	// An empty change is a deletion for PowerDNS, the records of the RRset would be silently removed
+	if len(spec.Records) == 0 && len(spec.MX) == 0 && len(spec.SRV) == 0 && len(spec.SVCB) == 0 && len(spec.CAA) == 0 {
+		allErrs = append(allErrs, field.Required(path.Child("records"), "at least one record is required"))
+	}
+	// Relative names are always within the zone, absolute names must be the zone apex or one of its subdomains
+	if strings.HasSuffix(spec.Name, ".") && !isInZone(spec.Name, spec.ZoneRef.Name) {
+		allErrs = append(allErrs, field.Invalid(path.Child("name"), spec.Name, "must be within the zone "+spec.ZoneRef.Name))
+	}
+	for i, mx := range spec.MX {
+		// A single dot is a null MX (RFC 7505)
+		if mx.Exchange != "." && !isHostname(mx.Exchange) {
+			allErrs = append(allErrs, field.Invalid(path.Child("mx").Index(i).Child("exchange"), mx.Exchange, "must be a valid hostname"))
+		}
+	}
+	for i, svcb := range spec.SVCB {
+		if svcb.Target != "." && !isHostname(svcb.Target) {
+			allErrs = append(allErrs, field.Invalid(path.Child("svcb").Index(i).Child("target"), svcb.Target, "must be a valid hostname"))
+		}
+		if msg := validateSvcParams(svcb.Priority, svcb.Params); msg != "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("svcb").Index(i).Child("params"), svcb.Params, msg))
+		}
+	}
+	for i, caa := range spec.CAA {
+		if !slices.Contains(caaTags, caa.Tag) {
+			allErrs = append(allErrs, field.NotSupported(path.Child("caa").Index(i).Child("tag"), caa.Tag, caaTags))
+		} else if msg := validateCAAValue(caa.Tag, caa.Value); msg != "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("caa").Index(i).Child("value"), caa.Value, msg))
+		}
+	}
+	// Structured records are rendered by the operator, only the disabled raw records are checked
+	for i, r := range spec.DisabledRecords {
+		if len(spec.Records) > 0 && !slices.Contains(spec.Records, r) {
+			allErrs = append(allErrs, field.Invalid(path.Child("disabledRecords").Index(i), r, "must be one of the records"))
+		}
+	}
+	for i, srv := range spec.SRV {
+		// A single dot means the service is not available (RFC 2782)
+		if srv.Target != "." && !isHostname(srv.Target) {
+			allErrs = append(allErrs, field.Invalid(path.Child("srv").Index(i).Child("target"), srv.Target, "must be a valid hostname"))
+		}
+	}
+	return allErrs
+}
+
+// rrsetWarnings returns the warnings about the RRset which cannot be verified by the webhook
+func rrsetWarnings(spec dnsv1alpha2.RRsetSpec) admission.Warnings {
+	if strings.EqualFold(spec.Type, "ALIAS") {
+		return admission.Warnings{aliasWarning}
+	}
+	return nil
+}
+
+// validateLuaRecords rejects the LUA RRsets when the LUA records are not allowed on the operator
+func validateLuaRecords(rrType string, allowLuaRecords bool, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if strings.EqualFold(rrType, "LUA") && !allowLuaRecords {
+		allErrs = append(allErrs, field.Forbidden(path, luaNotAllowed))
+	}
+	return allErrs
+}
+
+// validateRecords checks the content of each record according to the RRset type
+// Types without specific validation are accepted as is and left to PowerDNS
+func validateRecords(rrType string, records []string, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	// A name has a single ALIAS target
+	if strings.EqualFold(rrType, "ALIAS") && len(records) > 1 {
+		allErrs = append(allErrs, field.TooMany(path, len(records), 1))
+	}
+	for i, record := range records {
+		if msg := validateRecord(strings.ToUpper(rrType), record); msg != "" {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), record, msg))
+		}
+	}
+	return allErrs
+}
+
+// validateRecord returns a message describing why the record is invalid, or an empty string
+func validateRecord(rrType, record string) string {
+	switch rrType {
+	case "A":
+		addr, err := netip.ParseAddr(record)
+		if err != nil || !addr.Is4() {
+			return "must be a valid IPv4 address"
+		}
+	case "AAAA":
+		addr, err := netip.ParseAddr(record)
+		if err != nil || !addr.Is6() || addr.Is4In6() || addr.Zone() != "" {
+			return "must be a valid IPv6 address"
+		}
+	case "A_AAAA":
+		addr, err := netip.ParseAddr(record)
+		if err != nil || addr.Is4In6() || addr.Zone() != "" {
+			return "must be a valid IPv4 or IPv6 address"
+		}
+	case "CNAME", "NS", "ALIAS":
+		if !isHostname(record) {
+			return "must be a valid hostname"
+		}
+	case "MX":
+		fields := strings.Fields(record)
+		if len(fields) != 2 {
+			return "must be formatted as '<preference> <hostname>'"
+		}
+		if _, err := strconv.ParseUint(fields[0], 10, 16); err != nil {
+			return "preference must be an integer between 0 and 65535"
+		}
+		// A single dot is a null MX (RFC 7505)
+		if fields[1] != "." && !isHostname(fields[1]) {
+			return "exchange must be a valid hostname"
+		}
+	case "TXT":
+		if !txtContentRegexp.MatchString(record) {
+			return "must be enclosed in double quotes, with inner double quotes escaped"
+		}
+	case "SVCB", "HTTPS":
+		fields := strings.Fields(record)
+		if len(fields) < 2 {
+			return svcbFormatMessage
+		}
+		priority, err := strconv.ParseUint(fields[0], 10, 16)
+		if err != nil {
+			return "priority must be an integer between 0 and 65535"
+		}
+		// A single dot means the owner name of the record
+		if fields[1] != "." && !isHostname(fields[1]) {
+			return "target must be a valid hostname"
+		}
+		params := map[string]string{}
+		for _, param := range fields[2:] {
+			key, value, _ := strings.Cut(param, "=")
+			key = strings.ToLower(key)
+			if _, ok := params[key]; ok || key == "" {
+				return svcbFormatMessage + ", with unique keys"
+			}
+			params[key] = strings.Trim(value, `"`)
+		}
+		return validateSvcParams(uint16(priority), params)
+	case "CAA":
+		// Other tags than the structured ones are accepted (e.g. issuemail), only their format is checked
+		flag, rest, _ := strings.Cut(strings.TrimSpace(record), " ")
+		tag, value, _ := strings.Cut(strings.TrimSpace(rest), " ")
+		value = strings.TrimSpace(value)
+		if tag == "" || value == "" {
+			return caaFormatMessage
+		}
+		if _, err := strconv.ParseUint(flag, 10, 8); err != nil {
+			return "flag must be an integer between 0 and 255"
+		}
+		if !caaValueRegexp.MatchString(value) {
+			return "value must be enclosed in double quotes, with inner double quotes escaped"
+		}
+		if slices.Contains(caaTags, strings.ToLower(tag)) {
+			return validateCAAValue(strings.ToLower(tag), strings.NewReplacer(`\\`, `\`, `\"`, `"`).Replace(value[1:len(value)-1]))
+		}
+	case "LUA":
+		// The snippet is passed as is to PowerDNS, only the type of the generated records is checked
+		rrType, snippet, _ := strings.Cut(strings.TrimSpace(record), " ")
+		if !slices.Contains(luaEmbeddedTypes, strings.ToUpper(rrType)) {
+			return "type must be one of " + strings.Join(luaEmbeddedTypes, ", ")
+		}
+		if !txtContentRegexp.MatchString(strings.TrimSpace(snippet)) {
+			return luaFormatMessage
+		}
+	}
+	return ""
+}
+
+// validateSvcParams returns a message describing why the SvcParams of a SVCB or HTTPS record are invalid (RFC 9460),
+// or an empty string
+func validateSvcParams(priority uint16, params map[string]string) string {
+	// The alias mode only gives the target of the service
+	if priority == 0 && len(params) > 0 {
+		return "params are not allowed with priority 0 (alias mode)"
+	}
+	for _, key := range slices.Sorted(maps.Keys(params)) {
+		value := params[key]
+		switch key {
+		case "mandatory":
+			for _, k := range strings.Split(value, ",") {
+				if _, ok := params[k]; !ok || k == "mandatory" {
+					return "mandatory must list keys of the other params"
+				}
+			}
+		case "alpn":
+			if value == "" || slices.Contains(strings.Split(value, ","), "") {
+				return "alpn must be a comma-separated list of protocol identifiers"
+			}
+		case "no-default-alpn", "ohttp":
+			if value != "" {
+				return key + " must not have a value"
+			}
+			if _, ok := params["alpn"]; key == "no-default-alpn" && !ok {
+				return "no-default-alpn requires alpn"
+			}
+		case "port":
+			if _, err := strconv.ParseUint(value, 10, 16); err != nil {
+				return "port must be an integer between 0 and 65535"
+			}
+		case "ipv4hint", "ipv6hint":
+			for _, a := range strings.Split(value, ",") {
+				addr, err := netip.ParseAddr(a)
+				if err != nil || (key == "ipv4hint") != addr.Is4() {
+					return key + " must be a comma-separated list of IP addresses of its family"
+				}
+			}
+		case "ech":
+			if _, err := base64.StdEncoding.DecodeString(value); err != nil || value == "" {
+				return "ech must be base64 encoded"
+			}
+		case "dohpath":
+			if value == "" {
+				return "dohpath must not be empty"
+			}
+		default:
+			if !svcParamGenericKeyRegexp.MatchString(key) {
+				return "unknown key " + key + ", expected one of " + strings.Join(svcParamKeys, ", ") + " or keyNNNNN"
+			}
+			if n, _ := strconv.Atoi(strings.TrimPrefix(key, "key")); n > 65535 {
+				return "unknown key " + key + ", keyNNNNN must be lower than key65536"
+			}
+		}
+	}
+	return ""
+}
+
+// validateCAAValue returns a message describing why the value of a CAA property is invalid (RFC 8659), or an empty string
+func validateCAAValue(tag, value string) string {
+	switch tag {
+	case "issue", "issuewild":
+		// An empty issuer domain name (e.g. ";") forbids any issuer
+		domain, params, _ := strings.Cut(value, ";")
+		if domain = strings.TrimSpace(domain); domain != "" && !isHostname(domain) {
+			return "value must be an issuer domain name, optionally followed by '; <key>=<value>' parameters"
+		}
+		for _, param := range strings.Split(params, ";") {
+			if param = strings.TrimSpace(param); param != "" && !caaParameterRegexp.MatchString(param) {
+				return "parameters must be formatted as '<key>=<value>', separated by ';'"
+			}
+		}
+	case "iodef":
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "mailto" && u.Scheme != "http" && u.Scheme != "https") || (u.Opaque == "" && u.Host == "") {
+			return "value must be a mailto:, http: or https: URL"
+		}
+	}
+	return ""
+}
+
+// isHostname checks that name is a valid hostname, with or without a trailing dot
+// isInZone returns true if name is the zone apex or a subdomain of the zone
+func isInZone(name, zone string) bool {
+	n := strings.ToLower(strings.TrimSuffix(name, "."))
+	z := strings.ToLower(strings.TrimSuffix(zone, "."))
+	return n == z || strings.HasSuffix(n, "."+z)
+}
+
+func isHostname(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > maxHostnameLength {
+		return false
+	}
+	for _, label := range strings.Split(name, ".") {
+		if len(label) > maxLabelLength || !hostnameLabelRegexp.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,125 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+func TestValidateRecords(t *testing.T) {
+	var testCases = []struct {
+		description string
+		rrType      string
+		records     []string
+		expectedErr int
+	}{
+		{"Valid A records", "A", []string{"1.1.1.1", "192.168.0.1"}, 0},
+		{"Invalid A records", "A", []string{"1.1.1", "::1", "example.org."}, 3},
+		{"Valid dual-stack records", "A_AAAA", []string{"192.0.2.1", "2001:db8::1"}, 0},
+		{"Invalid dual-stack records", "A_AAAA", []string{"1.1.1", "::ffff:192.0.2.1", "fe80::1%eth0", "example.org."}, 4},
+		{"Lowercase type", "a", []string{"1.1.1.256"}, 1},
+		{"Valid AAAA records", "AAAA", []string{"::1", "2001:db8::1"}, 0},
+		{"Invalid AAAA records", "AAAA", []string{"1.1.1.1", "::ffff:1.1.1.1", "2001:db8::g"}, 3},
+		{"Valid CNAME record", "CNAME", []string{"www.example.org."}, 0},
+		{"Invalid CNAME record", "CNAME", []string{"www..example.org."}, 1},
+		{"Valid NS records", "NS", []string{"ns1.example.org.", "ns2.example.org"}, 0},
+		{"Invalid NS records", "NS", []string{"-ns1.example.org.", "ns 1.example.org."}, 2},
+		{"Valid MX records", "MX", []string{"10 mx1.example.org.", "0 ."}, 0},
+		{"Invalid MX records", "MX", []string{"mx1.example.org.", "70000 mx1.example.org.", "10 mx_1..example.org."}, 3},
+		{"Valid TXT records", "TXT", []string{`"v=spf1 -all"`, `"part1" "part2"`, `"escaped \" quote"`}, 0},
+		{"Invalid TXT records", "TXT", []string{`v=spf1 -all`, `"unterminated`, `"inner " quote"`}, 3},
+		{"Valid ALIAS record", "ALIAS", []string{"target.example.org."}, 0},
+		{"Invalid ALIAS record", "ALIAS", []string{"target..example.org."}, 1},
+		{"Multiple ALIAS records", "ALIAS", []string{"target1.example.org.", "target2.example.org."}, 1},
+		{"Valid HTTPS records", "HTTPS", []string{"0 svc.example.org.", "1 . alpn=h2,h3 port=8443 ipv4hint=192.0.2.1,192.0.2.2", `1 . alpn="h3" no-default-alpn ech="AEn+DQ==" key65000=x`}, 0},
+		{"Valid SVCB records", "SVCB", []string{"1 svc.example.org. mandatory=port port=53 ipv6hint=2001:db8::1"}, 0},
+		{"Invalid HTTPS records", "HTTPS", []string{"1", "70000 .", "1 svc..example.org.", "0 . alpn=h2", "1 . port=443 port=8443"}, 5},
+		{"Valid CAA records", "CAA", []string{`0 issue "letsencrypt.org"`, `0 issuewild ";"`, `128 iodef "mailto:security@example.org"`, `0 issue "ca.example.net; validationmethods=dns-01"`, `0 issuemail "ca.example.net"`}, 0},
+		{"Invalid CAA records", "CAA", []string{"0 issue", `256 issue "letsencrypt.org"`, "0 issue letsencrypt.org", `0 issue "letsencrypt..org"`, `0 iodef "ftp://example.org"`, `0 issue "ca.example.net; validationmethods"`}, 6},
+		{"Invalid SvcParams", "HTTPS", []string{"1 . unknown=1", "1 . port=http", "1 . ipv4hint=2001:db8::1", "1 . no-default-alpn", "1 . mandatory=alpn", "1 . ech=%", "1 . key70000=x"}, 7},
+		{"Valid LUA records", "LUA", []string{`A "ifportup(443, {'192.0.2.1', '192.0.2.2'})"`, `aaaa "pickrandom({'2001:db8::1'})"`}, 0},
+		{"Invalid LUA records", "LUA", []string{`LUA "return 1"`, `A`, `A ifportup(443, {'192.0.2.1'})`, `"A" "return 1"`}, 4},
+		{"Type without validation", "PTR", []string{"anything"}, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			errs := validateRecords(tc.rrType, tc.records, field.NewPath("spec", "records"))
+			if len(errs) != tc.expectedErr {
+				t.Errorf("got %d errors (%v), want %d", len(errs), errs, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateLuaRecords(t *testing.T) {
+	var testCases = []struct {
+		description     string
+		rrType          string
+		allowLuaRecords bool
+		expectedErr     int
+	}{
+		{"LUA allowed", "LUA", true, 0},
+		{"LUA not allowed", "LUA", false, 1},
+		{"Lowercase LUA not allowed", "lua", false, 1},
+		{"Other type", "A", false, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			errs := validateLuaRecords(tc.rrType, tc.allowLuaRecords, field.NewPath("spec", "type"))
+			if len(errs) != tc.expectedErr {
+				t.Errorf("got %d errors (%v), want %d", len(errs), errs, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateRRsetSpec(t *testing.T) {
+	var testCases = []struct {
+		description string
+		spec        dnsv1alpha2.RRsetSpec
+		expectedErr int
+	}{
+		{"Valid MX records", dnsv1alpha2.RRsetSpec{Type: "MX", MX: []dnsv1alpha2.MXRecord{{Preference: 10, Exchange: "mx1.example.org"}, {Exchange: "."}}}, 0},
+		{"Invalid MX records", dnsv1alpha2.RRsetSpec{Type: "MX", MX: []dnsv1alpha2.MXRecord{{Preference: 10, Exchange: "mx 1.example.org"}}}, 1},
+		{"Valid SRV records", dnsv1alpha2.RRsetSpec{Type: "SRV", SRV: []dnsv1alpha2.SRVRecord{{Priority: 1, Weight: 50, Port: 5060, Target: "sip.example.org."}}}, 0},
+		{"Invalid SRV records", dnsv1alpha2.RRsetSpec{Type: "SRV", SRV: []dnsv1alpha2.SRVRecord{{Target: "sip..example.org."}}}, 1},
+		{"Valid SVCB records", dnsv1alpha2.RRsetSpec{Type: "HTTPS", SVCB: []dnsv1alpha2.SVCBRecord{{Priority: 1, Target: ".", Params: map[string]string{"alpn": "h2,h3", "no-default-alpn": ""}}, {Target: "svc.example.org"}}}, 0},
+		{"Invalid SVCB records", dnsv1alpha2.RRsetSpec{Type: "HTTPS", SVCB: []dnsv1alpha2.SVCBRecord{{Priority: 1, Target: "svc..example.org", Params: map[string]string{"port": "-1"}}, {Target: ".", Params: map[string]string{"alpn": "h2"}}}}, 3},
+		{"Valid CAA records", dnsv1alpha2.RRsetSpec{Type: "CAA", CAA: []dnsv1alpha2.CAARecord{{Tag: "issue", Value: "letsencrypt.org"}, {Tag: "issuewild", Value: ";"}, {Flag: 128, Tag: "iodef", Value: "https://example.org/caa"}}}, 0},
+		{"Invalid CAA records", dnsv1alpha2.RRsetSpec{Type: "CAA", CAA: []dnsv1alpha2.CAARecord{{Tag: "issuer", Value: "letsencrypt.org"}, {Tag: "issue", Value: "lets encrypt"}, {Tag: "iodef", Value: "security@example.org"}}}, 3},
+		{"Invalid raw records", dnsv1alpha2.RRsetSpec{Type: "A", Records: []string{"1.1.1"}}, 1},
+		{"Empty records", dnsv1alpha2.RRsetSpec{Type: "A", Records: []string{}}, 1},
+		{"Empty structured records", dnsv1alpha2.RRsetSpec{Type: "MX", MX: []dnsv1alpha2.MXRecord{}}, 1},
+		{"Relative name", dnsv1alpha2.RRsetSpec{Name: "www", Type: "A", Records: []string{"1.1.1.1"}, ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org"}}, 0},
+		{"Absolute name within the zone", dnsv1alpha2.RRsetSpec{Name: "www.Example.org.", Type: "A", Records: []string{"1.1.1.1"}, ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org"}}, 0},
+		{"Zone apex name", dnsv1alpha2.RRsetSpec{Name: "example.org.", Type: "A", Records: []string{"1.1.1.1"}, ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org"}}, 0},
+		{"Name out of the zone", dnsv1alpha2.RRsetSpec{Name: "foo.other.com.", Type: "A", Records: []string{"1.1.1.1"}, ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org"}}, 1},
+		{"Disabled record", dnsv1alpha2.RRsetSpec{Type: "A", Records: []string{"1.1.1.1", "2.2.2.2"}, DisabledRecords: []string{"2.2.2.2"}}, 0},
+		{"Unknown disabled record", dnsv1alpha2.RRsetSpec{Type: "A", Records: []string{"1.1.1.1"}, DisabledRecords: []string{"2.2.2.2"}}, 1},
+		{"Name sharing the zone suffix", dnsv1alpha2.RRsetSpec{Name: "www.myexample.org.", Type: "A", Records: []string{"1.1.1.1"}, ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.org"}}, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			errs := validateRRsetSpec(tc.spec, field.NewPath("spec"))
+			if len(errs) != tc.expectedErr {
+				t.Errorf("got %d errors (%v), want %d", len(errs), errs, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+
+package v1alpha2
+
+import (
+	"context"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+// SetupRRsetWebhookWithManager registers the webhooks for RRset in the manager.
+func SetupRRsetWebhookWithManager(mgr ctrl.Manager, ttlPolicy TTLPolicy, suffixPolicy ZoneSuffixPolicy, allowLuaRecords bool) error {
+	return ctrl.NewWebhookManagedBy(mgr, &dnsv1alpha2.RRset{}).
+		WithDefaulter(&RRsetCustomDefaulter{TTLPolicy: ttlPolicy}).
+		WithValidator(&RRsetCustomValidator{TTLPolicy: ttlPolicy, SuffixPolicy: suffixPolicy, AllowLuaRecords: allowLuaRecords}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-dns-cav-enablers-ob-v1alpha2-rrset,mutating=true,failurePolicy=fail,sideEffects=None,groups=dns.cav.enablers.ob,resources=rrsets,verbs=create;update,versions=v1alpha2,name=mrrset-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// RRsetCustomDefaulter sets the default TTL of the TTL policy on a RRset without TTL
+type RRsetCustomDefaulter struct {
+	TTLPolicy TTLPolicy
+}
+
+var _ admission.Defaulter[*dnsv1alpha2.RRset] = &RRsetCustomDefaulter{}
+
+// Default implements admission.Defaulter so a webhook will be registered for the type RRset.
+func (d *RRsetCustomDefaulter) Default(_ context.Context, rrset *dnsv1alpha2.RRset) error {
+	d.TTLPolicy.defaultTTL(&rrset.Spec)
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-dns-cav-enablers-ob-v1alpha2-rrset,mutating=false,failurePolicy=fail,sideEffects=None,groups=dns.cav.enablers.ob,resources=rrsets,verbs=create;update,versions=v1alpha2,name=vrrset-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// RRsetCustomValidator validates the content of the records of a RRset according to its type, its TTL against the TTL policy
+// and its FQDN against the zone suffix policy, LUA RRsets are rejected unless AllowLuaRecords is set
+type RRsetCustomValidator struct {
+	TTLPolicy       TTLPolicy
+	SuffixPolicy    ZoneSuffixPolicy
+	AllowLuaRecords bool
+}
+
+var _ admission.Validator[*dnsv1alpha2.RRset] = &RRsetCustomValidator{}
+
+// ValidateCreate implements admission.Validator so a webhook will be registered for the type RRset.
+func (v *RRsetCustomValidator) ValidateCreate(_ context.Context, rrset *dnsv1alpha2.RRset) (admission.Warnings, error) {
+	return rrsetWarnings(rrset.Spec), validateRRset(rrset, v.TTLPolicy, v.AllowLuaRecords, v.SuffixPolicy.validateName(rrset.Namespace, getRRsetFQDN(rrset.Spec), field.NewPath("spec", "name"))...)
+}
+
+// ValidateUpdate implements admission.Validator so a webhook will be registered for the type RRset.
+func (v *RRsetCustomValidator) ValidateUpdate(_ context.Context, oldRRset, rrset *dnsv1alpha2.RRset) (admission.Warnings, error) {
+	// The FQDN is only checked when it changes (zoneRef), so that existing RRsets stay deletable
+	var suffixErrs field.ErrorList
+	if fqdn := getRRsetFQDN(rrset.Spec); !strings.EqualFold(fqdn, getRRsetFQDN(oldRRset.Spec)) {
+		suffixErrs = v.SuffixPolicy.validateName(rrset.Namespace, fqdn, field.NewPath("spec", "zoneRef", "name"))
+	}
+	return rrsetWarnings(rrset.Spec), validateRRset(rrset, v.TTLPolicy, v.AllowLuaRecords, suffixErrs...)
+}
+
+// ValidateDelete implements admission.Validator so a webhook will be registered for the type RRset.
+func (v *RRsetCustomValidator) ValidateDelete(_ context.Context, _ *dnsv1alpha2.RRset) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateRRset(rrset *dnsv1alpha2.RRset, ttlPolicy TTLPolicy, allowLuaRecords bool, suffixErrs ...*field.Error) error {
+	allErrs := validateRRsetSpec(rrset.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateLuaRecords(rrset.Spec.Type, allowLuaRecords, field.NewPath("spec", "type"))...)
+	allErrs = append(allErrs, ttlPolicy.validateTTL(rrset.Spec.TTL, field.NewPath("spec", "ttl"))...)
+	allErrs = append(allErrs, suffixErrs...)
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(dnsv1alpha2.GroupVersion.WithKind("RRset").GroupKind(), rrset.Name, allErrs)
+}
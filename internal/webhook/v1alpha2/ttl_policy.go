@@ -0,0 +1,62 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+package v1alpha2
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+// TTLPolicy bounds the TTL of the RRsets and ClusterRRsets, a zero value disables the bound or the default
+type TTLPolicy struct {
+	// Min is the lowest TTL accepted, in seconds
+	Min uint32
+	// Max is the highest TTL accepted, in seconds
+	Max uint32
+	// Default is the TTL set on the RRsets without TTL, in seconds
+	Default uint32
+}
+
+// Validate checks the consistency of the bounds and the default of the policy
+func (p TTLPolicy) Validate() error {
+	if p.Max != 0 && p.Min > p.Max {
+		return fmt.Errorf("TTL minimum %d is greater than TTL maximum %d", p.Min, p.Max)
+	}
+	if p.Default != 0 && len(p.validateTTL(p.Default, field.NewPath("ttl"))) > 0 {
+		return fmt.Errorf("default TTL %d is out of the TTL bounds", p.Default)
+	}
+	return nil
+}
+
+// defaultTTL sets the default TTL on a RRset without TTL
+func (p TTLPolicy) defaultTTL(spec *dnsv1alpha2.RRsetSpec) {
+	if spec.TTL == 0 && p.Default != 0 {
+		spec.TTL = p.Default
+	}
+}
+
+// validateTTL checks the TTL of a RRset against the bounds, a TTL left to 0 inherits the zone default and is not checked
+func (p TTLPolicy) validateTTL(ttl uint32, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if ttl == 0 {
+		return allErrs
+	}
+	if p.Min != 0 && ttl < p.Min {
+		allErrs = append(allErrs, field.Invalid(path, ttl, fmt.Sprintf("must be greater than or equal to %d", p.Min)))
+	}
+	if p.Max != 0 && ttl > p.Max {
+		allErrs = append(allErrs, field.Invalid(path, ttl, fmt.Sprintf("must be less than or equal to %d", p.Max)))
+	}
+	return allErrs
+}
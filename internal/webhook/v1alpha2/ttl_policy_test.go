@@ -0,0 +1,92 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+package v1alpha2
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+func TestTTLPolicyValidateTTL(t *testing.T) {
+	policy := TTLPolicy{Min: 60, Max: 86400}
+	var testCases = []struct {
+		description string
+		policy      TTLPolicy
+		ttl         uint32
+		expectedErr int
+	}{
+		{"TTL within bounds", policy, 300, 0},
+		{"TTL equal to the minimum", policy, 60, 0},
+		{"TTL below the minimum", policy, 30, 1},
+		{"TTL equal to the maximum", policy, 86400, 0},
+		{"TTL above the maximum", policy, 172800, 1},
+		{"TTL inherited from the zone", policy, 0, 0},
+		{"No bounds", TTLPolicy{}, 1, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			errs := tc.policy.validateTTL(tc.ttl, field.NewPath("spec", "ttl"))
+			if len(errs) != tc.expectedErr {
+				t.Errorf("got %d errors (%v), want %d", len(errs), errs, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestTTLPolicyDefaultTTL(t *testing.T) {
+	var testCases = []struct {
+		description string
+		policy      TTLPolicy
+		ttl         uint32
+		expected    uint32
+	}{
+		{"Default TTL on RRset without TTL", TTLPolicy{Default: 3600}, 0, 3600},
+		{"TTL of the RRset kept", TTLPolicy{Default: 3600}, 300, 300},
+		{"No default TTL", TTLPolicy{}, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			spec := dnsv1alpha2.RRsetSpec{TTL: tc.ttl}
+			tc.policy.defaultTTL(&spec)
+			if spec.TTL != tc.expected {
+				t.Errorf("got %v, want %v", spec.TTL, tc.expected)
+			}
+		})
+	}
+}
+
+func TestTTLPolicyValidate(t *testing.T) {
+	var testCases = []struct {
+		description string
+		policy      TTLPolicy
+		expectedErr bool
+	}{
+		{"Empty policy", TTLPolicy{}, false},
+		{"Consistent policy", TTLPolicy{Min: 60, Max: 86400, Default: 3600}, false},
+		{"Minimum greater than maximum", TTLPolicy{Min: 3600, Max: 60}, true},
+		{"Default below the minimum", TTLPolicy{Min: 60, Default: 30}, true},
+		{"Default above the maximum", TTLPolicy{Max: 3600, Default: 86400}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("got %v, want an error: %v", err, tc.expectedErr)
+			}
+		})
+	}
+}
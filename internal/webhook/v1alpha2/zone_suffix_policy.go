@@ -0,0 +1,82 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+package v1alpha2
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+// ZONE_SUFFIX_POLICY_ANY_NAMESPACE is the key of the suffixes applied to the namespaces absent from a ZoneSuffixPolicy
+const ZONE_SUFFIX_POLICY_ANY_NAMESPACE = "*"
+
+// ZoneSuffixPolicy restricts the domains the Zones and RRsets of a namespace can be created in,
+// it is indexed by namespace. A namespace absent from the policy (and without "*" entry) is not restricted.
+// ClusterZones and ClusterRRsets are not namespaced, they are not restricted.
+type ZoneSuffixPolicy map[string][]string
+
+// ParseZoneSuffixPolicy parses a comma-separated list of NAMESPACE=SUFFIX pairs
+// (e.g. "team-a=a.example.com,team-a=a.example.net,*=sandbox.example.com"), a namespace can be repeated
+func ParseZoneSuffixPolicy(value string) (ZoneSuffixPolicy, error) {
+	policy := ZoneSuffixPolicy{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		namespace, suffix, found := strings.Cut(pair, "=")
+		namespace = strings.TrimSpace(namespace)
+		suffix = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(suffix), "."))
+		if !found || namespace == "" || suffix == "" {
+			return nil, fmt.Errorf("invalid zone suffix definition %q, expected NAMESPACE=SUFFIX", pair)
+		}
+		if !isHostname(suffix) {
+			return nil, fmt.Errorf("invalid zone suffix %q for namespace %s", suffix, namespace)
+		}
+		policy[namespace] = append(policy[namespace], suffix)
+	}
+	return policy, nil
+}
+
+// suffixes returns the suffixes allowed in namespace, nil when the namespace is not restricted
+func (p ZoneSuffixPolicy) suffixes(namespace string) []string {
+	if suffixes, ok := p[namespace]; ok {
+		return suffixes
+	}
+	return p[ZONE_SUFFIX_POLICY_ANY_NAMESPACE]
+}
+
+// validateName checks that name (a zone or a RRset FQDN) is one of the suffixes allowed in namespace or one of their subdomains
+func (p ZoneSuffixPolicy) validateName(namespace, name string, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	suffixes := p.suffixes(namespace)
+	if suffixes == nil {
+		return allErrs
+	}
+	for _, suffix := range suffixes {
+		if isInZone(name, suffix) {
+			return allErrs
+		}
+	}
+	return append(allErrs, field.Forbidden(path, fmt.Sprintf("%s is not allowed in namespace %s, allowed suffixes: %s", name, namespace, strings.Join(suffixes, ", "))))
+}
+
+// getRRsetFQDN returns the FQDN of a RRset, relative names are completed with the name of the zone
+func getRRsetFQDN(spec dnsv1alpha2.RRsetSpec) string {
+	if strings.HasSuffix(spec.Name, ".") {
+		return spec.Name
+	}
+	return spec.Name + "." + spec.ZoneRef.Name
+}
@@ -0,0 +1,116 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+package v1alpha2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+func TestParseZoneSuffixPolicy(t *testing.T) {
+	var testCases = []struct {
+		description string
+		value       string
+		expected    ZoneSuffixPolicy
+		expectedErr bool
+	}{
+		{"Empty policy", "", ZoneSuffixPolicy{}, false},
+		{"Several suffixes", "team-a=a.example.com, team-a=A.example.net.,team-b=b.example.com", ZoneSuffixPolicy{"team-a": {"a.example.com", "a.example.net"}, "team-b": {"b.example.com"}}, false},
+		{"Any namespace", "*=sandbox.example.com", ZoneSuffixPolicy{"*": {"sandbox.example.com"}}, false},
+		{"Missing suffix", "team-a=", nil, true},
+		{"Missing namespace", "=a.example.com", nil, true},
+		{"Invalid suffix", "team-a=a..example.com", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, err := ParseZoneSuffixPolicy(tc.value)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("got %v, want an error: %v", err, tc.expectedErr)
+			}
+			if !cmp.Equal(result, tc.expected) {
+				t.Errorf("got %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestZoneSuffixPolicyZone(t *testing.T) {
+	policy := ZoneSuffixPolicy{"team-a": {"a.example.com"}, "*": {"sandbox.example.com"}}
+	var testCases = []struct {
+		description string
+		namespace   string
+		name        string
+		expectedErr bool
+	}{
+		{"Allowed suffix", "team-a", "a.example.com", false},
+		{"Subdomain of the allowed suffix", "team-a", "app.A.example.com", false},
+		{"Other team suffix", "team-a", "b.example.com", true},
+		{"Suffix without label boundary", "team-a", "evila.example.com", true},
+		{"Namespace without entry", "team-c", "app.sandbox.example.com", false},
+		{"Namespace without entry outside the default suffixes", "team-c", "b.example.com", true},
+	}
+
+	validator := &ZoneCustomValidator{SuffixPolicy: policy}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: tc.name, Namespace: tc.namespace}, Spec: dnsv1alpha2.ZoneSpec{Kind: "Native", Nameservers: []string{"ns1.example.org"}}}
+			_, err := validator.ValidateCreate(context.Background(), zone)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("got %v, want an error: %v", err, tc.expectedErr)
+			}
+		})
+	}
+
+	// Without policy, no namespace is restricted
+	zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "b.example.com", Namespace: "team-a"}, Spec: dnsv1alpha2.ZoneSpec{Kind: "Native", Nameservers: []string{"ns1.example.org"}}}
+	if _, err := (&ZoneCustomValidator{}).ValidateCreate(context.Background(), zone); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestZoneSuffixPolicyRRset(t *testing.T) {
+	policy := ZoneSuffixPolicy{"team-a": {"a.example.com"}}
+	var testCases = []struct {
+		description string
+		oldSpec     *dnsv1alpha2.RRsetSpec
+		spec        dnsv1alpha2.RRsetSpec
+		expectedErr bool
+	}{
+		{"Relative name in an allowed zone", nil, dnsv1alpha2.RRsetSpec{Type: "A", Name: "www", ZoneRef: dnsv1alpha2.ZoneRef{Name: "a.example.com", Kind: "ClusterZone"}, Records: []string{"192.0.2.1"}}, false},
+		{"Relative name in a denied zone", nil, dnsv1alpha2.RRsetSpec{Type: "A", Name: "www", ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.com", Kind: "ClusterZone"}, Records: []string{"192.0.2.1"}}, true},
+		{"Absolute name allowed in a parent zone", nil, dnsv1alpha2.RRsetSpec{Type: "A", Name: "www.a.example.com.", ZoneRef: dnsv1alpha2.ZoneRef{Name: "example.com", Kind: "ClusterZone"}, Records: []string{"192.0.2.1"}}, false},
+		{"Zone changed to a denied zone", &dnsv1alpha2.RRsetSpec{Type: "A", Name: "www", ZoneRef: dnsv1alpha2.ZoneRef{Name: "a.example.com", Kind: "ClusterZone"}, Records: []string{"192.0.2.1"}}, dnsv1alpha2.RRsetSpec{Type: "A", Name: "www", ZoneRef: dnsv1alpha2.ZoneRef{Name: "b.example.com", Kind: "ClusterZone"}, Records: []string{"192.0.2.1"}}, true},
+		{"Existing RRset in a denied zone updated", &dnsv1alpha2.RRsetSpec{Type: "A", Name: "www", ZoneRef: dnsv1alpha2.ZoneRef{Name: "b.example.com", Kind: "ClusterZone"}, Records: []string{"192.0.2.1"}}, dnsv1alpha2.RRsetSpec{Type: "A", Name: "www", ZoneRef: dnsv1alpha2.ZoneRef{Name: "b.example.com", Kind: "ClusterZone"}, Records: []string{"192.0.2.2"}}, false},
+	}
+
+	validator := &RRsetCustomValidator{SuffixPolicy: policy}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			rrset := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "www", Namespace: "team-a"}, Spec: tc.spec}
+			var err error
+			if tc.oldSpec == nil {
+				_, err = validator.ValidateCreate(context.Background(), rrset)
+			} else {
+				oldRRset := &dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "www", Namespace: "team-a"}, Spec: *tc.oldSpec}
+				_, err = validator.ValidateUpdate(context.Background(), oldRRset, rrset)
+			}
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("got %v, want an error: %v", err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+package v1alpha2
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+// SetupZoneWebhookWithManager registers the webhook for Zone in the manager.
+func SetupZoneWebhookWithManager(mgr ctrl.Manager, suffixPolicy ZoneSuffixPolicy) error {
+	return ctrl.NewWebhookManagedBy(mgr, &dnsv1alpha2.Zone{}).
+		WithValidator(&ZoneCustomValidator{SuffixPolicy: suffixPolicy, Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-dns-cav-enablers-ob-v1alpha2-zone,mutating=false,failurePolicy=fail,sideEffects=None,groups=dns.cav.enablers.ob,resources=zones,verbs=create;update;delete,versions=v1alpha2,name=vzone-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// ZoneCustomValidator validates the kind of a Zone, which is immutable, and its name against the zone suffix policy.
+// A Zone still referenced by RRsets cannot be deleted, unless forced
+type ZoneCustomValidator struct {
+	SuffixPolicy ZoneSuffixPolicy
+	Client       client.Reader
+}
+
+var _ admission.Validator[*dnsv1alpha2.Zone] = &ZoneCustomValidator{}
+
+// ValidateCreate implements admission.Validator so a webhook will be registered for the type Zone.
+func (v *ZoneCustomValidator) ValidateCreate(_ context.Context, zone *dnsv1alpha2.Zone) (admission.Warnings, error) {
+	allErrs := validateZoneSpec(zone.Spec, field.NewPath("spec"))
+	// The name of a Zone is immutable, it is only checked on creation so that existing Zones stay deletable
+	allErrs = append(allErrs, v.SuffixPolicy.validateName(zone.Namespace, zone.Name, field.NewPath("metadata", "name"))...)
+	return zoneWarnings(zone.Spec), validateZone(zone, allErrs)
+}
+
+// ValidateUpdate implements admission.Validator so a webhook will be registered for the type Zone.
+func (v *ZoneCustomValidator) ValidateUpdate(_ context.Context, oldZone, zone *dnsv1alpha2.Zone) (admission.Warnings, error) {
+	return zoneWarnings(zone.Spec), validateZone(zone, validateZoneSpecUpdate(oldZone.Spec, zone.Spec, field.NewPath("spec")))
+}
+
+// ValidateDelete implements admission.Validator so a webhook will be registered for the type Zone.
+func (v *ZoneCustomValidator) ValidateDelete(ctx context.Context, zone *dnsv1alpha2.Zone) (admission.Warnings, error) {
+	return nil, validateZoneDelete(ctx, v.Client, zone, dnsv1alpha2.ZoneRef{Name: zone.Name, Kind: "Zone"})
+}
+
+func validateZone(zone *dnsv1alpha2.Zone, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(dnsv1alpha2.GroupVersion.WithKind("Zone").GroupKind(), zone.Name, allErrs)
+}
@@ -0,0 +1,195 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/joeig/go-powerdns/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+const (
+	kindImmutableMessage    = "the kind of a zone cannot be changed after its creation, delete and recreate the zone instead"
+	manageNSWarning         = "spec.nameservers is only used on zone creation when spec.manageNS is false, the apex NS records are not reconciled afterwards"
+	reservedMetadataMessage = "this metadata kind is managed through a dedicated field of the zone or is read-only in PowerDNS API"
+	allowAXFRFromMessage    = "must be an IP address, a CIDR (e.g. \"192.0.2.0/24\") or \"AUTO-NS\""
+	allowAXFRFromConflict   = "ALLOW-AXFR-FROM is managed through spec.allowAXFRFrom, it cannot be set in both"
+	alsoNotifyMessage       = "must be an IP address with optional port (e.g. \"192.0.2.1\", \"192.0.2.1:5300\" or \"[2001:db8::1]:5300\")"
+	soaEditAPIConflict      = "SOA-EDIT-API is disabled when the serial is managed through spec.serialStrategy, it cannot be set in both"
+
+	// Zones with this annotation set to "true" are deleted even though RRsets still reference them
+	forceDeleteAnnotation = "dns.cav.enablers.ob/force-delete"
+	// RRsets created from spec.defaultRecords of a zone have this label set to "true"
+	defaultRecordLabel = "dns.cav.enablers.ob/default-record"
+)
+
+// zoneKinds are the kinds of zones supported by PowerDNS
+var zoneKinds = []string{
+	string(powerdns.NativeZoneKind),
+	string(powerdns.MasterZoneKind),
+	string(powerdns.SlaveZoneKind),
+	string(powerdns.ProducerZoneKind),
+	string(powerdns.ConsumerZoneKind),
+}
+
+// reservedMetadataKinds are the metadata kinds which cannot be set in spec.metadata
+var reservedMetadataKinds = []string{
+	string(powerdns.MetadataAlsoNotify),
+	string(powerdns.MetadataTSIGAllowAXFR),
+	string(powerdns.MetadataTSIGAllowDNSUpdate),
+	string(powerdns.MetadataNSEC3Param),
+	string(powerdns.MetadataSOAEditAPI),
+	string(powerdns.MetadataAPIRectify),
+	string(powerdns.MetadataPresigned),
+	string(powerdns.MetadataLuaAXFRScript),
+	"NSEC3NARROW",
+	"X-POWERDNS-OPERATOR",
+}
+
+// validateZoneSpec checks the kind, the SOA-EDIT-API along with the serial strategy, the also-notify addresses,
+// the networks allowed to transfer and the metadata of a zone
+func validateZoneSpec(spec dnsv1alpha2.ZoneSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if !slices.Contains(zoneKinds, spec.Kind) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("kind"), spec.Kind, zoneKinds))
+	}
+	serialStrategy := ptr.Deref(spec.SerialStrategy, dnsv1alpha2.SERIAL_STRATEGY_DEFAULT)
+	if serialStrategy != dnsv1alpha2.SERIAL_STRATEGY_DEFAULT && ptr.Deref(spec.SOAEditAPI, "") != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("soa_edit_api"), soaEditAPIConflict))
+	}
+	for i, address := range spec.AlsoNotify {
+		if !isAddressWithOptionalPort(address) {
+			allErrs = append(allErrs, field.Invalid(path.Child("alsoNotify").Index(i), address, alsoNotifyMessage))
+		}
+	}
+	for i, network := range spec.AllowAXFRFrom {
+		if !isAllowAXFRFromEntry(network) {
+			allErrs = append(allErrs, field.Invalid(path.Child("allowAXFRFrom").Index(i), network, allowAXFRFromMessage))
+		}
+	}
+	for kind := range spec.Metadata {
+		if slices.Contains(reservedMetadataKinds, kind) {
+			allErrs = append(allErrs, field.Forbidden(path.Child("metadata").Key(kind), reservedMetadataMessage))
+		}
+		if kind == string(powerdns.MetadataAllowAXFRFrom) && spec.AllowAXFRFrom != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("metadata").Key(kind), allowAXFRFromConflict))
+		}
+	}
+	return allErrs
+}
+
+// isAddressWithOptionalPort returns true if address is an IP address, optionally followed by a port
+// (IPv6 addresses are then enclosed in brackets)
+func isAddressWithOptionalPort(address string) bool {
+	if _, err := netip.ParseAddr(address); err == nil {
+		return true
+	}
+	addrPort, err := netip.ParseAddrPort(address)
+	return err == nil && addrPort.Port() != 0
+}
+
+// isAllowAXFRFromEntry returns true if entry is an IP address, a CIDR or the AUTO-NS token
+func isAllowAXFRFromEntry(entry string) bool {
+	if entry == dnsv1alpha2.ALLOW_AXFR_FROM_AUTO_NS {
+		return true
+	}
+	if _, err := netip.ParseAddr(entry); err == nil {
+		return true
+	}
+	_, err := netip.ParsePrefix(entry)
+	return err == nil
+}
+
+// zoneWarnings returns the warnings about settings of the zone which are accepted but likely unintended
+func zoneWarnings(spec dnsv1alpha2.ZoneSpec) admission.Warnings {
+	if len(spec.Nameservers) > 0 && !ptr.Deref(spec.ManageNS, true) {
+		return admission.Warnings{manageNSWarning}
+	}
+	return nil
+}
+
+// validateZoneSpecUpdate checks a zone on update, its kind is immutable
+func validateZoneSpecUpdate(oldSpec, spec dnsv1alpha2.ZoneSpec, path *field.Path) field.ErrorList {
+	allErrs := validateZoneSpec(spec, path)
+	if spec.Kind != oldSpec.Kind {
+		allErrs = append(allErrs, field.Forbidden(path.Child("kind"), kindImmutableMessage))
+	}
+	return allErrs
+}
+
+// validateZoneDelete rejects the deletion of a zone still referenced by RRsets or ClusterRRsets, unless it is
+// forced by the force-delete annotation. The RRsets are found through the field indexers of the controllers
+func validateZoneDelete(ctx context.Context, cl client.Reader, zone client.Object, zoneRef dnsv1alpha2.ZoneRef) error {
+	if zone.GetAnnotations()[forceDeleteAnnotation] == "true" {
+		return nil
+	}
+	count, err := countZoneRRsets(ctx, cl, zone, zoneRef)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if count == 0 {
+		return nil
+	}
+	resource := dnsv1alpha2.GroupVersion.WithResource("zones").GroupResource()
+	if zoneRef.Kind == "ClusterZone" {
+		resource = dnsv1alpha2.GroupVersion.WithResource("clusterzones").GroupResource()
+	}
+	return apierrors.NewForbidden(resource, zone.GetName(),
+		fmt.Errorf("%d RRsets/ClusterRRsets still reference the zone, delete them first or set the %s annotation to \"true\"", count, forceDeleteAnnotation))
+}
+
+// countZoneRRsets returns the number of RRsets and ClusterRRsets referencing a zone which are not being deleted,
+// RRsets can only reference a Zone of their namespace. The RRsets created from spec.defaultRecords of the zone
+// are not counted, they are deleted with it
+func countZoneRRsets(ctx context.Context, cl client.Reader, zone client.Object, zoneRef dnsv1alpha2.ZoneRef) (int, error) {
+	zoneRefKey := dnsv1alpha2.ZoneRefKey(zoneRef)
+	count := 0
+	var rrsets dnsv1alpha2.RRsetList
+	listOptions := []client.ListOption{client.MatchingFields{"RRset.ZoneRef": zoneRefKey}}
+	if zoneRef.Kind == "Zone" {
+		listOptions = append(listOptions, client.InNamespace(zone.GetNamespace()))
+	}
+	if err := cl.List(ctx, &rrsets, listOptions...); err != nil {
+		return 0, err
+	}
+	for _, rrset := range rrsets.Items {
+		if rrset.DeletionTimestamp.IsZero() && !isDefaultRecord(&rrset, zone) {
+			count++
+		}
+	}
+	var clusterRRsets dnsv1alpha2.ClusterRRsetList
+	if err := cl.List(ctx, &clusterRRsets, client.MatchingFields{"ClusterRRset.ZoneRef": zoneRefKey}); err != nil {
+		return 0, err
+	}
+	for _, clusterRRset := range clusterRRsets.Items {
+		if clusterRRset.DeletionTimestamp.IsZero() && !isDefaultRecord(&clusterRRset, zone) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// isDefaultRecord returns true if the RRset was created from spec.defaultRecords of the zone. The zone is set as
+// controller of every RRset it reconciles, the default records are told apart by their label
+func isDefaultRecord(rrset client.Object, zone client.Object) bool {
+	return rrset.GetLabels()[defaultRecordLabel] == "true" && metav1.IsControlledBy(rrset, zone)
+}
@@ -0,0 +1,213 @@
+/*
+ * Software Name : PowerDNS-Operator
+ *
+ * SPDX-FileCopyrightText: Copyright (c) PowerDNS-Operator contributors
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange Business Services SA
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * This software is distributed under the Apache 2.0 License,
+ * see the "LICENSE" file for more details
+ */
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dnsv1alpha2 "github.com/powerdns-operator/powerdns-operator/api/v1alpha2"
+)
+
+func TestValidateZoneSpec(t *testing.T) {
+	var testCases = []struct {
+		description string
+		kind        string
+		alsoNotify  []string
+		axfrFrom    []string
+		metadata    map[string][]string
+		expectedErr int
+	}{
+		{"Native zone", "Native", nil, nil, nil, 0},
+		{"Producer zone", "Producer", nil, nil, nil, 0},
+		{"Unknown kind", "Primary", nil, nil, nil, 1},
+		{"Lowercase kind", "native", nil, nil, nil, 1},
+		{"Also-notify", "Master", []string{"192.0.2.1", "192.0.2.2:5300", "2001:db8::1", "[2001:db8::2]:5300"}, nil, nil, 0},
+		{"Invalid also-notify", "Master", []string{"ns1.example.org", "192.0.2.1:", "192.0.2.1:0", "[2001:db8::1]", "192.0.2.0/24"}, nil, nil, 5},
+		{"Metadata", "Native", nil, nil, map[string][]string{"ALLOW-AXFR-FROM": {"192.0.2.0/24"}, "SOA-EDIT": {"INCEPTION-INCREMENT"}}, 0},
+		{"Reserved metadata", "Native", nil, nil, map[string][]string{"TSIG-ALLOW-AXFR": {"key"}, "PRESIGNED": {"1"}, "ALSO-NOTIFY": {"192.0.2.1"}}, 3},
+		{"Allow-AXFR-from", "Master", nil, []string{"192.0.2.0/24", "2001:db8::/32", "198.51.100.1", "AUTO-NS"}, nil, 0},
+		{"Invalid allow-AXFR-from", "Master", nil, []string{"auto-ns", "192.0.2.0/33", "ns1.example.org"}, nil, 3},
+		{"Allow-AXFR-from in metadata", "Master", nil, nil, map[string][]string{"ALLOW-AXFR-FROM": {"192.0.2.0/24"}}, 0},
+		{"Allow-AXFR-from in both", "Master", nil, []string{"AUTO-NS"}, map[string][]string{"ALLOW-AXFR-FROM": {"192.0.2.0/24"}}, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			errs := validateZoneSpec(dnsv1alpha2.ZoneSpec{Kind: tc.kind, AlsoNotify: tc.alsoNotify, AllowAXFRFrom: tc.axfrFrom, Metadata: tc.metadata}, field.NewPath("spec"))
+			if len(errs) != tc.expectedErr {
+				t.Errorf("got %d errors (%v), want %d", len(errs), errs, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateZoneSpecUpdate(t *testing.T) {
+	var testCases = []struct {
+		description string
+		oldKind     string
+		kind        string
+		expectedErr int
+	}{
+		{"Unchanged kind", "Native", "Native", 0},
+		{"Native to Master", "Native", "Master", 1},
+		{"Master to Slave", "Master", "Slave", 1},
+		{"Unknown kind", "Native", "Primary", 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			errs := validateZoneSpecUpdate(dnsv1alpha2.ZoneSpec{Kind: tc.oldKind}, dnsv1alpha2.ZoneSpec{Kind: tc.kind}, field.NewPath("spec"))
+			if len(errs) != tc.expectedErr {
+				t.Errorf("got %d errors (%v), want %d", len(errs), errs, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestZoneWarnings(t *testing.T) {
+	var testCases = []struct {
+		description string
+		spec        dnsv1alpha2.ZoneSpec
+		expected    int
+	}{
+		{"Managed NS", dnsv1alpha2.ZoneSpec{Kind: "Native", Nameservers: []string{"ns1.example.org"}}, 0},
+		{"Unmanaged NS without nameservers", dnsv1alpha2.ZoneSpec{Kind: "Native", ManageNS: ptr.To(false)}, 0},
+		{"Unmanaged NS with nameservers", dnsv1alpha2.ZoneSpec{Kind: "Native", Nameservers: []string{"ns1.example.org"}, ManageNS: ptr.To(false)}, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if warnings := zoneWarnings(tc.spec); len(warnings) != tc.expected {
+				t.Errorf("got %d warnings (%v), want %d", len(warnings), warnings, tc.expected)
+			}
+		})
+	}
+}
+
+func TestValidateZoneSerialStrategy(t *testing.T) {
+	var testCases = []struct {
+		description    string
+		serialStrategy *string
+		soaEditAPI     *string
+		expectedErr    bool
+	}{
+		{"Default serial strategy with soa_edit_api", nil, ptr.To("EPOCH"), false},
+		{"Explicit default serial strategy with soa_edit_api", ptr.To("Default"), ptr.To("INCREASE"), false},
+		{"Managed serial without soa_edit_api", ptr.To("Increment"), nil, false},
+		{"Managed serial with empty soa_edit_api", ptr.To("DateBased"), ptr.To(""), false},
+		{"Managed serial with soa_edit_api DEFAULT", ptr.To("Increment"), ptr.To("DEFAULT"), true},
+		{"Managed serial with soa_edit_api EPOCH", ptr.To("DateBased"), ptr.To("EPOCH"), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			spec := dnsv1alpha2.ZoneSpec{Kind: "Native", Nameservers: []string{"ns1.example.org"}, SerialStrategy: tc.serialStrategy, SOAEditAPI: tc.soaEditAPI}
+			zone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: "team-a"}, Spec: spec}
+			if _, err := (&ZoneCustomValidator{}).ValidateCreate(context.Background(), zone); (err != nil) != tc.expectedErr {
+				t.Errorf("Zone: got %v, want error %v", err, tc.expectedErr)
+			}
+			clusterZone := &dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: "example.org"}, Spec: spec}
+			if _, err := (&ClusterZoneCustomValidator{}).ValidateCreate(context.Background(), clusterZone); (err != nil) != tc.expectedErr {
+				t.Errorf("ClusterZone: got %v, want error %v", err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateZoneDelete(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = dnsv1alpha2.AddToScheme(scheme)
+	indexZoneRef := func(o client.Object) []string {
+		switch rrset := o.(type) {
+		case *dnsv1alpha2.RRset:
+			return []string{dnsv1alpha2.ZoneRefKey(rrset.Spec.ZoneRef)}
+		case *dnsv1alpha2.ClusterRRset:
+			return []string{dnsv1alpha2.ZoneRefKey(rrset.Spec.ZoneRef)}
+		}
+		return nil
+	}
+	zoneRef := dnsv1alpha2.ZoneRef{Name: "example.org", Kind: "Zone"}
+	clusterZoneRef := dnsv1alpha2.ZoneRef{Name: "example.com", Kind: "ClusterZone"}
+	ownedZoneRef := dnsv1alpha2.ZoneRef{Name: "example.net", Kind: "Zone"}
+	ownedClusterZoneRef := dnsv1alpha2.ZoneRef{Name: "example.net", Kind: "ClusterZone"}
+	ownerZone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.net", Namespace: "team-a", UID: "zone-uid"}}
+	reconciledZone := &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.net", Namespace: "team-e", UID: "reconciled-zone-uid"}}
+	ownerClusterZone := &dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: "example.net", UID: "clusterzone-uid"}}
+	controlledBy := func(kind string, uid types.UID) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{APIVersion: dnsv1alpha2.GroupVersion.String(), Kind: kind, Name: "example.net", UID: uid, Controller: ptr.To(true)}}
+	}
+	defaultRecord := map[string]string{defaultRecordLabel: "true"}
+	deleted := metav1.Now()
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(
+			&dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "www", Namespace: "team-a"}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: zoneRef}},
+			&dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "mail", Namespace: "team-a"}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: zoneRef}},
+			&dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "www", Namespace: "team-b"}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: zoneRef}},
+			&dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "deleted", Namespace: "team-c", DeletionTimestamp: &deleted, Finalizers: []string{"test"}}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: zoneRef}},
+			&dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "team-a"}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: clusterZoneRef}},
+			&dnsv1alpha2.ClusterRRset{ObjectMeta: metav1.ObjectMeta{Name: "www"}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: clusterZoneRef}},
+			&dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "default-mx", Namespace: "team-a", Labels: defaultRecord, OwnerReferences: controlledBy("Zone", ownerZone.UID)}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: ownedZoneRef}},
+			&dnsv1alpha2.ClusterRRset{ObjectMeta: metav1.ObjectMeta{Name: "default-mx", Labels: defaultRecord, OwnerReferences: controlledBy("ClusterZone", ownerClusterZone.UID)}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: ownedClusterZoneRef}},
+			// Reconciled RRsets are controlled by their zone, without being default records
+			&dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "www", Namespace: "team-e", OwnerReferences: controlledBy("Zone", reconciledZone.UID)}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: ownedZoneRef}},
+			&dnsv1alpha2.RRset{ObjectMeta: metav1.ObjectMeta{Name: "default-mx", Namespace: "team-e", Labels: defaultRecord, OwnerReferences: controlledBy("Zone", reconciledZone.UID)}, Spec: dnsv1alpha2.RRsetSpec{ZoneRef: ownedZoneRef}},
+		).
+		WithIndex(&dnsv1alpha2.RRset{}, "RRset.ZoneRef", indexZoneRef).
+		WithIndex(&dnsv1alpha2.ClusterRRset{}, "ClusterRRset.ZoneRef", indexZoneRef).
+		Build()
+	force := map[string]string{forceDeleteAnnotation: "true"}
+
+	var testCases = []struct {
+		description     string
+		zone            client.Object
+		zoneRef         dnsv1alpha2.ZoneRef
+		expectedBlocked int
+	}{
+		{"Zone with RRsets", &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: "team-a"}}, zoneRef, 2},
+		{"Zone with RRsets being deleted", &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: "team-c"}}, zoneRef, 0},
+		{"Zone without RRsets", &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: "team-d"}}, zoneRef, 0},
+		{"Forced deletion", &dnsv1alpha2.Zone{ObjectMeta: metav1.ObjectMeta{Name: "example.org", Namespace: "team-a", Annotations: force}}, zoneRef, 0},
+		{"ClusterZone with RRsets and ClusterRRsets", &dnsv1alpha2.ClusterZone{ObjectMeta: metav1.ObjectMeta{Name: "example.com"}}, clusterZoneRef, 2},
+		{"Zone with its own RRsets only", ownerZone, ownedZoneRef, 0},
+		{"ClusterZone with its own ClusterRRsets only", ownerClusterZone, ownedClusterZoneRef, 0},
+		{"Zone controlling reconciled RRsets", reconciledZone, ownedZoneRef, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := validateZoneDelete(context.Background(), cl, tc.zone, tc.zoneRef)
+			if (err != nil) != (tc.expectedBlocked > 0) {
+				t.Fatalf("got %v, want blocked by %d RRsets", err, tc.expectedBlocked)
+			}
+			if err == nil {
+				return
+			}
+			if !apierrors.IsForbidden(err) {
+				t.Errorf("got %v, want a Forbidden error", err)
+			}
+			if !strings.Contains(err.Error(), fmt.Sprintf("%d RRsets", tc.expectedBlocked)) {
+				t.Errorf("got %v, want the number of RRsets (%d) in the message", err, tc.expectedBlocked)
+			}
+		})
+	}
+}